@@ -132,7 +132,7 @@ func (s *InterchainAccountsParamsTestSuite) TestHostEnabledParam() {
 
 			msg := hosttypes.MsgUpdateParams{
 				Signer: authority.String(),
-				Params: hosttypes.NewParams(false, []string{hosttypes.AllowAllHostMsgs}),
+				Params: hosttypes.NewParams(false, []string{hosttypes.AllowAllHostMsgs}, nil),
 			}
 			s.ExecuteAndPassGovV1Proposal(ctx, &msg, chainB, chainBUser)
 		} else {
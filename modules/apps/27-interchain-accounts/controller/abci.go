@@ -0,0 +1,20 @@
+package controller
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+)
+
+// EndBlocker reopens the channels of interchain accounts which were closed by a packet timeout and sends the pending
+// transactions of interchain accounts whose channel has been reopened. Both operations are performed at the end of the
+// block as ORDERED channels are only closed, and channels are only set to OPEN, once the packet and handshake
+// callbacks have returned.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if !k.GetParams(ctx).ControllerEnabled {
+		return
+	}
+
+	k.ReopenFlaggedChannels(ctx)
+	k.FlushPendingTxs(ctx)
+}
@@ -17,6 +17,10 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryInterchainAccountIndexes(),
+		GetCmdQueryExecutionResult(),
+		GetCmdQueryExecutionResults(),
+		GetCmdQueryPendingTxs(),
 		GetCmdParams(),
 	)
 
@@ -36,6 +40,8 @@ func NewTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
 		newSendTxCmd(),
+		newReopenChannelCmd(),
+		newTransferOwnershipCmd(),
 	)
 
 	return cmd
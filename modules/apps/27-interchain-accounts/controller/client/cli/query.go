@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -26,10 +27,16 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 				return err
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryInterchainAccountRequest{
 				Owner:        args[0],
 				ConnectionId: args[1],
+				AccountIndex: accountIndex,
 			}
 
 			res, err := queryClient.InterchainAccount(cmd.Context(), req)
@@ -41,7 +48,170 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, zero for the default interchain account of the owner")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccountIndexes returns the command handler for querying the account indexes of an owner.
+func GetCmdQueryInterchainAccountIndexes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account-indexes [owner]",
+		Short:   "Query the account indexes of the interchain accounts registered by a given owner",
+		Long:    "Query the controller submodule for the account indexes of the interchain accounts registered by a given owner",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts controller account-indexes cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountIndexesRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.InterchainAccountIndexes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account indexes")
+
+	return cmd
+}
+
+// GetCmdQueryExecutionResult returns the command handler for querying the execution result of a packet.
+func GetCmdQueryExecutionResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execution-result [connection-id] [port-id] [sequence]",
+		Short:   "Query the execution result of an interchain accounts packet",
+		Long:    "Query the controller submodule for the execution result stored for the interchain accounts packet sent with the given sequence",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query interchain-accounts controller execution-result connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryExecutionResultRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+				Sequence:     sequence,
+			}
+
+			res, err := queryClient.ExecutionResult(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExecutionResults returns the command handler for querying the execution results of an interchain account.
+func GetCmdQueryExecutionResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "execution-results [connection-id] [port-id]",
+		Short:   "Query the execution results of an interchain account",
+		Long:    "Query the controller submodule for the execution results stored for an interchain account, ordered by packet sequence",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller execution-results connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryExecutionResultsRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.ExecutionResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution results")
+
+	return cmd
+}
+
+// GetCmdQueryPendingTxs returns the command handler for querying the pending transactions of an interchain account.
+func GetCmdQueryPendingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-txs [connection-id] [port-id]",
+		Short:   "Query the pending transactions of an interchain account",
+		Long:    "Query the controller submodule for the transactions queued while the channel of an interchain account is closed, ordered by submission",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts controller pending-txs connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryPendingTxsRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.PendingTxs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending transactions")
 
 	return cmd
 }
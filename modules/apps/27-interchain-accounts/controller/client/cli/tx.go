@@ -24,6 +24,10 @@ const (
 	// The channel ordering
 	flagOrdering              = "ordering"
 	flagRelativePacketTimeout = "relative-packet-timeout"
+	// The account index of the interchain account of the owner
+	flagAccountIndex = "account-index"
+	// The account index under which the new owner controls the interchain account
+	flagNewAccountIndex = "new-account-index"
 )
 
 func newRegisterInterchainAccountCmd() *cobra.Command {
@@ -35,7 +39,8 @@ connection id from the source chain. Connection identifier should be for the sou
 and the interchain account will be created on the counterparty chain. Callers are expected to 
 provide the appropriate application version string via {version} flag and the desired ordering
 via the {ordering} flag. Generates a new port identifier using the provided owner string, binds to the port identifier and claims 
-the associated capability.`),
+the associated capability. Additional interchain accounts of the same owner may be registered by providing an account index 
+via the {account-index} flag.`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -55,7 +60,13 @@ the associated capability.`),
 				return err
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, version, order)
+			msg.AccountIndex = accountIndex
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -63,6 +74,7 @@ the associated capability.`),
 
 	cmd.Flags().String(flagVersion, "", "Controller chain channel version")
 	cmd.Flags().String(flagOrdering, channeltypes.ORDERED.String(), fmt.Sprintf("Channel ordering, can be one of: %s", strings.Join(connectiontypes.SupportedOrderings, ", ")))
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, zero for the default interchain account of the owner")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -106,13 +118,99 @@ appropriate relative timeoutTimestamp must be provided with flag {relative-packe
 				return err
 			}
 
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeoutTimestamp, icaMsgData)
+			msg.AccountIndex = accountIndex
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, zero for the default interchain account of the owner")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newReopenChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen-channel [connection-id]",
+		Short: "Reopen the closed channel of an interchain account on the provided connection.",
+		Long: strings.TrimSpace(`Initiates a new channel handshake for an interchain account whose active channel 
+has been closed. The version and ordering of the closed channel are reused. An account index other than 
+zero may be provided via the {account-index} flag.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReopenChannel(owner, connectionID)
+			msg.AccountIndex = accountIndex
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, zero for the default interchain account of the owner")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func newTransferOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [connection-id] [new-owner]",
+		Short: "Transfer the ownership of an interchain account on the provided connection to a new owner.",
+		Long: strings.TrimSpace(`Assigns the interchain account of the signer on the provided connection to the new owner. 
+The interchain account address on the host chain is unchanged. An account index other than zero may be provided 
+via the {account-index} flag and the account index under which the new owner controls the interchain account 
+may be provided via the {new-account-index} flag. Pending transactions of the interchain account are discarded.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			newOwner := args[1]
+			owner := clientCtx.GetFromAddress().String()
+
+			accountIndex, err := cmd.Flags().GetUint64(flagAccountIndex)
+			if err != nil {
+				return err
+			}
+
+			newAccountIndex, err := cmd.Flags().GetUint64(flagNewAccountIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferAccountOwnership(owner, connectionID, newOwner)
+			msg.AccountIndex = accountIndex
+			msg.NewAccountIndex = newAccountIndex
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagAccountIndex, 0, "Account index of the interchain account, zero for the default interchain account of the owner")
+	cmd.Flags().Uint64(flagNewAccountIndex, 0, "Account index under which the new owner controls the interchain account")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
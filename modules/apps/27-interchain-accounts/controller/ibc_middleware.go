@@ -198,6 +198,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	im.keeper.OnAcknowledgementPacket(ctx, connectionID, packet, acknowledgement)
+
 	// call underlying app's OnAcknowledgementPacket callback.
 	if im.app != nil && im.keeper.IsMiddlewareEnabled(ctx, packet.GetSourcePort(), connectionID) {
 		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
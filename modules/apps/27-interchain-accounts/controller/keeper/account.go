@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/ibc-go/v8/internal/logging"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -35,6 +36,10 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner,
 		return err
 	}
 
+	if _, found := k.GetAccountOwnership(ctx, connectionID, portID); found {
+		return errorsmod.Wrapf(types.ErrAccountOwnershipTransferred, "interchain account on port %s cannot be registered by its previous owner", portID)
+	}
+
 	if k.IsMiddlewareDisabled(ctx, portID, connectionID) && !k.IsActiveChannelClosed(ctx, connectionID, portID) {
 		return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel is already active or a handshake is in flight")
 	}
@@ -90,3 +95,115 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID,
 
 	return channelOpenInitResponse.ChannelId, nil
 }
+
+// reopenChannel initiates a new channel handshake for the interchain account associated with the provided connectionID
+// and portID whose active channel has been closed. The version and ordering of the closed channel are reused such
+// that the new channel is opened using the same metadata, and the host chain regains access to the same interchain account.
+func (k Keeper) reopenChannel(ctx sdk.Context, connectionID, portID string) (string, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return "", errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "active channel %s must be %s to be reopened, got %s", activeChannelID, channeltypes.CLOSED, channel.State)
+	}
+
+	return k.registerInterchainAccount(ctx, connectionID, portID, channel.Version, channel.Ordering)
+}
+
+// ReopenFlaggedChannels initiates a new channel handshake for each interchain account whose ORDERED channel was closed
+// by a packet timeout while automatic channel reopening was enabled. Every handshake is executed in a cached context
+// such that a failure to reopen one channel is logged and does not affect the others.
+func (k Keeper) ReopenFlaggedChannels(ctx sdk.Context) {
+	for _, account := range k.getAndDeleteFlaggedAccounts(ctx, types.ReopenChannelKeyPrefix) {
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		channelID, err := k.reopenChannel(cacheCtx, account.connectionID, account.portID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to reopen interchain account channel", "connection-id", account.connectionID, "port-id", account.portID, "error", err.Error())
+			continue
+		}
+
+		writeFn()
+
+		k.Logger(ctx).Info("reopening interchain account channel", "connection-id", account.connectionID, "port-id", account.portID, "channel-id", channelID)
+	}
+}
+
+// ResolveControllerPortID returns the controller port identifier of the interchain account controlled over the
+// provided connectionID by the provided owner under the provided account index. This is the port identifier built
+// from the owner and account index, unless the owner has been transferred the ownership of an interchain account
+// under this account index. An error is returned if the ownership of the interchain account on the port identifier
+// built from the owner and account index has been transferred away.
+func (k Keeper) ResolveControllerPortID(ctx sdk.Context, connectionID, owner string, accountIndex uint64) (string, error) {
+	ownerPortID, err := icatypes.NewControllerPortIDWithAccountIndex(owner, accountIndex)
+	if err != nil {
+		return "", err
+	}
+
+	if portID, found := k.getOwnedAccountPortID(ctx, connectionID, ownerPortID); found {
+		return portID, nil
+	}
+
+	if ownership, found := k.GetAccountOwnership(ctx, connectionID, ownerPortID); found {
+		return "", errorsmod.Wrapf(types.ErrAccountOwnershipTransferred, "interchain account on port %s is owned by %s", ownerPortID, ownership.Owner)
+	}
+
+	return ownerPortID, nil
+}
+
+// transferAccountOwnership assigns the interchain account associated with the provided connectionID and portID to the
+// new owner under the new account index. The port identifier, and thus the interchain account address on the host
+// chain, is unchanged. The new owner must not already control an interchain account under the new account index.
+// Pending transactions submitted by the previous owner are deleted.
+func (k Keeper) transferAccountOwnership(ctx sdk.Context, connectionID, portID, newOwner string, newAccountIndex uint64) error {
+	if _, found := k.GetInterchainAccountAddress(ctx, connectionID, portID); !found {
+		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on connection %s for port %s", connectionID, portID)
+	}
+
+	if k.IsMiddlewareEnabled(ctx, portID, connectionID) {
+		return errorsmod.Wrapf(icatypes.ErrInvalidChannelFlow, "interchain account on port %s is controlled by an underlying application and cannot be transferred", portID)
+	}
+
+	newOwnerPortID, err := icatypes.NewControllerPortIDWithAccountIndex(newOwner, newAccountIndex)
+	if err != nil {
+		return err
+	}
+
+	// transferring the interchain account back to the owner encoded in its port identifier requires no ownership
+	if newOwnerPortID != portID {
+		if _, found := k.getOwnedAccountPortID(ctx, connectionID, newOwnerPortID); found {
+			return errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "new owner already controls an interchain account with account index %d", newAccountIndex)
+		}
+
+		// the port of the new owner may be bound for interchain accounts registered on other connections,
+		// only an interchain account registered by the new owner on this connection conflicts with the transfer
+		if _, found := k.GetInterchainAccountAddress(ctx, connectionID, newOwnerPortID); found {
+			return errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "new owner already controls an interchain account with account index %d on connection %s", newAccountIndex, connectionID)
+		}
+
+		if _, found := k.GetActiveChannelID(ctx, connectionID, newOwnerPortID); found {
+			return errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "new owner already has an active channel for account index %d on connection %s", newAccountIndex, connectionID)
+		}
+	}
+
+	k.DeleteAccountOwnership(ctx, connectionID, portID)
+	if newOwnerPortID != portID {
+		if err := k.SetAccountOwnership(ctx, types.NewAccountOwnership(connectionID, portID, newOwner, newAccountIndex)); err != nil {
+			return err
+		}
+	}
+
+	// pending transactions were submitted by the previous owner and must not be sent once the account is transferred
+	for _, pendingTx := range k.GetPendingTxs(ctx, connectionID, portID) {
+		k.DeletePendingTx(ctx, connectionID, portID, pendingTx.Index)
+	}
+
+	return nil
+}
@@ -118,3 +118,57 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), pathAToC.EndpointA.ConnectionID, owner, string(icatypes.ModuleCdc.MustMarshalJSON(metadata)))
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestReopenFlaggedChannels() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expReopened bool
+	}{
+		{
+			"success: closed channel is reopened",
+			func() {},
+			true,
+		},
+		{
+			"success: open channel is not reopened",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetReopenChannelFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.ReopenFlaggedChannels(suite.chainA.GetContext())
+
+			// the flag is always consumed
+			suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasReopenChannelFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+
+			channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channeltypes.FormatChannelIdentifier(1))
+			suite.Require().Equal(tc.expReopened, found)
+			if tc.expReopened {
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(path.EndpointA.GetChannel().Version, channel.Version)
+			}
+		})
+	}
+}
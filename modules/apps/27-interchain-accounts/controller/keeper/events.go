@@ -30,3 +30,19 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet channeltypes.Packet, ack e
 		),
 	)
 }
+
+// EmitAccountOwnershipTransferredEvent emits an event signalling that the ownership of an interchain account has been
+// transferred to a new owner.
+func EmitAccountOwnershipTransferredEvent(ctx sdk.Context, connectionID, portID, previousOwner, newOwner string, newAccountIndex uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeAccountOwnershipTransferred,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(icatypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(icatypes.AttributeKeyPreviousOwner, previousOwner),
+			sdk.NewAttribute(icatypes.AttributeKeyNewOwner, newOwner),
+			sdk.NewAttribute(icatypes.AttributeKeyNewAccountIndex, fmt.Sprintf("%d", newAccountIndex)),
+		),
+	)
+}
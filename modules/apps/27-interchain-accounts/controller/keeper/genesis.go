@@ -40,6 +40,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.ControllerGe
 		keeper.SetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId, acc.AccountAddress)
 	}
 
+	for _, result := range state.ExecutionResults {
+		keeper.SetExecutionResult(ctx, result)
+	}
+
+	for _, pendingTx := range state.PendingTxs {
+		keeper.SetPendingTx(ctx, pendingTx)
+
+		// pending transactions are sent at the end of the block if the active channel is open
+		keeper.SetFlushPendingTxsFlag(ctx, pendingTx.ConnectionId, pendingTx.PortId)
+	}
+
+	for _, ownership := range state.AccountOwnerships {
+		if err := keeper.SetAccountOwnership(ctx, ownership); err != nil {
+			panic(fmt.Errorf("could not set account ownership: %v", err))
+		}
+	}
+
 	keeper.SetParams(ctx, state.Params)
 }
 
@@ -50,5 +67,8 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.ControllerGenesi
 		keeper.GetAllInterchainAccounts(ctx),
 		keeper.GetAllPorts(ctx),
 		keeper.GetParams(ctx),
+		keeper.GetAllExecutionResults(ctx),
+		keeper.GetAllPendingTxs(ctx),
+		keeper.GetAllAccountOwnerships(ctx),
 	)
 }
@@ -53,6 +53,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Ports: ports,
+		ExecutionResults: []types.ExecutionResult{
+			{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+				ChannelId:    ibctesting.FirstChannelID,
+				Sequence:     1,
+				Success:      true,
+			},
+		},
+		PendingTxs: []types.PendingTx{
+			types.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}, 100),
+		},
+		AccountOwnerships: []types.AccountOwnership{
+			types.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 1),
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -78,6 +93,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			suite.Require().True(found)
 			suite.Require().Equal(interchainAccAddr.String(), accountAdrr)
 
+			executionResult, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetExecutionResult(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID, 1)
+			suite.Require().True(found)
+			suite.Require().Equal(genesisState.ExecutionResults[0], executionResult)
+
+			pendingTxs := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+			suite.Require().Equal(genesisState.PendingTxs, pendingTxs)
+			suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.HasFlushPendingTxsFlag(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID))
+
+			ownership, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAccountOwnership(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+			suite.Require().True(found)
+			suite.Require().Equal(genesisState.AccountOwnerships[0], ownership)
+
+			portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ResolveControllerPortID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, "new-owner", 1)
+			suite.Require().NoError(err)
+			suite.Require().Equal(TestPortID, portID)
+
 			expParams := types.NewParams(false)
 			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 			suite.Require().Equal(expParams, params)
@@ -106,6 +137,22 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	executionResult := types.ExecutionResult{
+		ConnectionId: ibctesting.FirstConnectionID,
+		PortId:       TestPortID,
+		ChannelId:    path.EndpointA.ChannelID,
+		Sequence:     1,
+		Success:      true,
+	}
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetExecutionResult(suite.chainA.GetContext(), executionResult)
+
+	pendingTx := types.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}, 100)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+
+	ownership := types.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 1)
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwnership(suite.chainA.GetContext(), ownership)
+	suite.Require().NoError(err)
+
 	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAControllerKeeper)
 
 	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -117,6 +164,12 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
 
+	suite.Require().Equal([]types.ExecutionResult{executionResult}, genesisState.GetExecutionResults())
+
+	suite.Require().Equal([]types.PendingTx{pendingTx}, genesisState.GetPendingTxs())
+
+	suite.Require().Equal([]types.AccountOwnership{ownership}, genesisState.GetAccountOwnerships())
+
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
 }
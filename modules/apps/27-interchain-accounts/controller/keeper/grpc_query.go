@@ -2,14 +2,19 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/store/prefix"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -22,8 +27,12 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
+	portID, err := k.ResolveControllerPortID(ctx, req.ConnectionId, req.Owner, req.AccountIndex)
 	if err != nil {
+		if errors.Is(err, types.ErrAccountOwnershipTransferred) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
 		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
 	}
 
@@ -37,6 +46,145 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// InterchainAccountIndexes implements the Query/InterchainAccountIndexes gRPC method
+func (k Keeper) InterchainAccountIndexes(goCtx context.Context, req *types.QueryInterchainAccountIndexesRequest) (*types.QueryInterchainAccountIndexesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	portIDPrefix, err := icatypes.NewControllerPortID(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), icatypes.KeyPort(portIDPrefix))
+
+	var accountIndexes []uint64
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		// the port prefix of the owner is shared with other owners whose address starts with it
+		owner, accountIndex, err := icatypes.ParseControllerPortID(portIDPrefix + string(key))
+		if err != nil || owner != req.Owner {
+			return false, nil
+		}
+
+		if accumulate {
+			accountIndexes = append(accountIndexes, accountIndex)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountIndexesResponse{
+		AccountIndexes: accountIndexes,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ExecutionResult implements the Query/ExecutionResult gRPC method
+func (k Keeper) ExecutionResult(goCtx context.Context, req *types.QueryExecutionResultRequest) (*types.QueryExecutionResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateAccountIdentifiers(req.ConnectionId, req.PortId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	result, found := k.GetExecutionResult(ctx, req.ConnectionId, req.PortId, req.Sequence)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "execution result not found for port %s on connection %s with sequence %d", req.PortId, req.ConnectionId, req.Sequence)
+	}
+
+	return &types.QueryExecutionResultResponse{
+		ExecutionResult: &result,
+	}, nil
+}
+
+// ExecutionResults implements the Query/ExecutionResults gRPC method
+func (k Keeper) ExecutionResults(goCtx context.Context, req *types.QueryExecutionResultsRequest) (*types.QueryExecutionResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateAccountIdentifiers(req.ConnectionId, req.PortId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyExecutionResultPrefix(req.ConnectionId, req.PortId))
+
+	var results []types.ExecutionResult
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var result types.ExecutionResult
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionResultsResponse{
+		ExecutionResults: results,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// PendingTxs implements the Query/PendingTxs gRPC method
+func (k Keeper) PendingTxs(goCtx context.Context, req *types.QueryPendingTxsRequest) (*types.QueryPendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateAccountIdentifiers(req.ConnectionId, req.PortId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPendingTxPrefix(req.ConnectionId, req.PortId))
+
+	var pendingTxs []types.PendingTx
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingTx types.PendingTx
+		if err := k.cdc.Unmarshal(value, &pendingTx); err != nil {
+			return err
+		}
+
+		pendingTxs = append(pendingTxs, pendingTx)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingTxsResponse{
+		PendingTxs: pendingTxs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// validateAccountIdentifiers validates the connection and port identifiers of an interchain account query
+func validateAccountIdentifiers(connectionID, portID string) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -1,7 +1,12 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -32,6 +37,13 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"account address not found for account index",
+			func() {
+				req.AccountIndex = 1
+			},
+			false,
+		},
 		{
 			"invalid connection, account address not found",
 			func() {
@@ -75,6 +87,379 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryInterchainAccountIndexes() {
+	var (
+		req            *types.QueryInterchainAccountIndexesRequest
+		expIndexes     []uint64
+		expNextKeyNull bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expIndexes = []uint64{0, 1}
+				expNextKeyNull = false
+			},
+			true,
+		},
+		{
+			"success: owner without interchain accounts",
+			func() {
+				req.Owner = "unregistered-owner"
+				expIndexes = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"empty owner address",
+			func() {
+				req.Owner = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			for _, accountIndex := range []uint64{2, 0, 1} {
+				msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion, channeltypes.ORDERED)
+				msg.AccountIndex = accountIndex
+
+				_, err := msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			}
+
+			// the controller port of an owner sharing the same prefix must not be returned
+			msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress+"1", TestVersion, channeltypes.ORDERED)
+			_, err := msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountIndexesRequest{
+				Owner: TestOwnerAddress,
+			}
+			expIndexes = []uint64{0, 1, 2}
+			expNextKeyNull = true
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountIndexes(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expIndexes, res.AccountIndexes)
+				suite.Require().Equal(expNextKeyNull, res.Pagination.NextKey == nil)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExecutionResult() {
+	var req *types.QueryExecutionResultRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"execution result not found",
+			func() {
+				req.Sequence = 2
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expResult := types.ExecutionResult{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+				ChannelId:    ibctesting.FirstChannelID,
+				Sequence:     1,
+				Success:      true,
+			}
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetExecutionResult(suite.chainA.GetContext(), expResult)
+
+			req = &types.QueryExecutionResultRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+				Sequence:     1,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ExecutionResult(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expResult, res.ExecutionResult)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryExecutionResults() {
+	var (
+		req            *types.QueryExecutionResultsRequest
+		expSequences   []uint64
+		expNextKeyNull bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expSequences = []uint64{1, 2}
+				expNextKeyNull = false
+			},
+			true,
+		},
+		{
+			"success: interchain account without execution results",
+			func() {
+				req.ConnectionId = "connection-1"
+				expSequences = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// sequences are stored out of order and across byte boundaries to ensure results are ordered numerically
+			for _, sequence := range []uint64{256, 1, 2} {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetExecutionResult(suite.chainA.GetContext(), types.ExecutionResult{
+					ConnectionId: ibctesting.FirstConnectionID,
+					PortId:       TestPortID,
+					ChannelId:    ibctesting.FirstChannelID,
+					Sequence:     sequence,
+					Success:      true,
+				})
+			}
+
+			req = &types.QueryExecutionResultsRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+			}
+			expSequences = []uint64{1, 2, 256}
+			expNextKeyNull = true
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ExecutionResults(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var sequences []uint64
+				for _, result := range res.ExecutionResults {
+					sequences = append(sequences, result.Sequence)
+				}
+
+				suite.Require().Equal(expSequences, sequences)
+				suite.Require().Equal(expNextKeyNull, res.Pagination.NextKey == nil)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPendingTxs() {
+	var (
+		req            *types.QueryPendingTxsRequest
+		expIndexes     []uint64
+		expNextKeyNull bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expIndexes = []uint64{1, 2}
+				expNextKeyNull = false
+			},
+			true,
+		},
+		{
+			"success: interchain account without pending transactions",
+			func() {
+				req.ConnectionId = "connection-1"
+				expIndexes = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+
+			// indexes are stored out of order and across byte boundaries to ensure pending transactions are ordered numerically
+			for _, index := range []uint64{256, 1, 2} {
+				pendingTx := types.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, index, packetData, 100)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+			}
+
+			req = &types.QueryPendingTxsRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+			}
+			expIndexes = []uint64{1, 2, 256}
+			expNextKeyNull = true
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.PendingTxs(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				var indexes []uint64
+				for _, pendingTx := range res.PendingTxs {
+					indexes = append(indexes, pendingTx.Index)
+				}
+
+				suite.Require().Equal(expIndexes, indexes)
+				suite.Require().Equal(expNextKeyNull, res.Pagination.NextKey == nil)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
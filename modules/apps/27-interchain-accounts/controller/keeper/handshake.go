@@ -128,6 +128,11 @@ func (k Keeper) OnChanOpenAck(
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
 
+	// the channel is only set to OPEN once the callback returns, pending transactions are sent at the end of the block
+	if count, _ := k.getPendingTxQueueInfo(ctx, metadata.ControllerConnectionId, portID); count > 0 {
+		k.SetFlushPendingTxsFlag(ctx, metadata.ControllerConnectionId, portID)
+	}
+
 	return nil
 }
 
@@ -276,6 +276,281 @@ func (k Keeper) DeleteMiddlewareEnabled(ctx sdk.Context, portID, connectionID st
 	store.Delete(icatypes.KeyIsMiddlewareEnabled(portID, connectionID))
 }
 
+// GetExecutionResult retrieves the execution result stored for the packet sent with the provided sequence by the
+// interchain account associated with the provided connectionID and portID
+func (k Keeper) GetExecutionResult(ctx sdk.Context, connectionID, portID string, sequence uint64) (types.ExecutionResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionResult(connectionID, portID, sequence))
+	if bz == nil {
+		return types.ExecutionResult{}, false
+	}
+
+	var result types.ExecutionResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return result, true
+}
+
+// GetAllExecutionResults returns a list of all stored execution results
+func (k Keeper) GetAllExecutionResults(ctx sdk.Context) []types.ExecutionResult {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ExecutionResultKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var results []types.ExecutionResult
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.ExecutionResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// SetExecutionResult stores the execution result, keyed by its connectionID, portID and packet sequence
+func (k Keeper) SetExecutionResult(ctx sdk.Context, result types.ExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&result)
+	store.Set(types.KeyExecutionResult(result.ConnectionId, result.PortId, result.Sequence), bz)
+}
+
+// pruneExecutionResults deletes the oldest execution results stored for the interchain account associated with the
+// provided connectionID and portID until at most maxResults remain
+func (k Keeper) pruneExecutionResults(ctx sdk.Context, connectionID, portID string, maxResults uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyExecutionResultPrefix(connectionID, portID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	// the iterator must be closed before deleting from the store
+	if err := iterator.Close(); err != nil {
+		panic(err)
+	}
+
+	if uint64(len(keys)) <= maxResults {
+		return
+	}
+
+	// keys are ordered by ascending packet sequence
+	for _, key := range keys[:uint64(len(keys))-maxResults] {
+		store.Delete(key)
+	}
+}
+
+// GetPendingTxs returns the pending transactions queued for the interchain account associated with the provided
+// connectionID and portID in the order in which they were submitted
+func (k Keeper) GetPendingTxs(ctx sdk.Context, connectionID, portID string) []types.PendingTx {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPendingTxPrefix(connectionID, portID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingTxs []types.PendingTx
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingTx types.PendingTx
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingTx)
+
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+
+	return pendingTxs
+}
+
+// GetAllPendingTxs returns a list of all pending transactions
+func (k Keeper) GetAllPendingTxs(ctx sdk.Context) []types.PendingTx {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingTxKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingTxs []types.PendingTx
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingTx types.PendingTx
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingTx)
+
+		pendingTxs = append(pendingTxs, pendingTx)
+	}
+
+	return pendingTxs
+}
+
+// SetPendingTx stores the pending transaction, keyed by its connectionID, portID and queue index
+func (k Keeper) SetPendingTx(ctx sdk.Context, pendingTx types.PendingTx) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingTx)
+	store.Set(types.KeyPendingTx(pendingTx.ConnectionId, pendingTx.PortId, pendingTx.Index), bz)
+}
+
+// DeletePendingTx deletes the pending transaction stored with the provided queue index
+func (k Keeper) DeletePendingTx(ctx sdk.Context, connectionID, portID string, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingTx(connectionID, portID, index))
+}
+
+// getPendingTxQueueInfo returns the number of pending transactions queued for the interchain account associated with
+// the provided connectionID and portID, together with the index to be assigned to the next pending transaction
+func (k Keeper) getPendingTxQueueInfo(ctx sdk.Context, connectionID, portID string) (uint64, uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPendingTxPrefix(connectionID, portID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var count, lastIndex uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		lastIndex = sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPendingTxPrefix(connectionID, portID)):])
+	}
+
+	return count, lastIndex + 1
+}
+
+// SetReopenChannelFlag flags the channel of the interchain account associated with the provided connectionID and
+// portID to be reopened at the end of the block
+func (k Keeper) SetReopenChannelFlag(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyReopenChannel(connectionID, portID), []byte{byte(1)})
+}
+
+// HasReopenChannelFlag returns true if the channel of the interchain account associated with the provided
+// connectionID and portID is flagged to be reopened at the end of the block, otherwise false
+func (k Keeper) HasReopenChannelFlag(ctx sdk.Context, connectionID, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyReopenChannel(connectionID, portID))
+}
+
+// DeleteReopenChannelFlag deletes the flag used to reopen the channel of the interchain account associated with the
+// provided connectionID and portID
+func (k Keeper) DeleteReopenChannelFlag(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyReopenChannel(connectionID, portID))
+}
+
+// SetFlushPendingTxsFlag flags the pending transactions of the interchain account associated with the provided
+// connectionID and portID to be sent at the end of the block
+func (k Keeper) SetFlushPendingTxsFlag(ctx sdk.Context, connectionID, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFlushPendingTxs(connectionID, portID), []byte{byte(1)})
+}
+
+// HasFlushPendingTxsFlag returns true if the pending transactions of the interchain account associated with the
+// provided connectionID and portID are flagged to be sent at the end of the block, otherwise false
+func (k Keeper) HasFlushPendingTxsFlag(ctx sdk.Context, connectionID, portID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyFlushPendingTxs(connectionID, portID))
+}
+
+// GetAccountOwnership returns the ownership of the interchain account associated with the provided connectionID and
+// portID if it has been transferred away from the owner encoded in the port identifier
+func (k Keeper) GetAccountOwnership(ctx sdk.Context, connectionID, portID string) (types.AccountOwnership, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAccountOwnership(connectionID, portID))
+	if bz == nil {
+		return types.AccountOwnership{}, false
+	}
+
+	var ownership types.AccountOwnership
+	k.cdc.MustUnmarshal(bz, &ownership)
+	return ownership, true
+}
+
+// GetAllAccountOwnerships returns a list of all interchain account ownerships
+func (k Keeper) GetAllAccountOwnerships(ctx sdk.Context) []types.AccountOwnership {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AccountOwnershipKeyPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var ownerships []types.AccountOwnership
+	for ; iterator.Valid(); iterator.Next() {
+		var ownership types.AccountOwnership
+		k.cdc.MustUnmarshal(iterator.Value(), &ownership)
+
+		ownerships = append(ownerships, ownership)
+	}
+
+	return ownerships
+}
+
+// SetAccountOwnership stores the interchain account ownership, keyed by its connectionID and portID, and indexes it
+// by the port identifier built from its owner and account index
+func (k Keeper) SetAccountOwnership(ctx sdk.Context, ownership types.AccountOwnership) error {
+	ownerPortID, err := ownership.OwnerPortID()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&ownership)
+	store.Set(types.KeyAccountOwnership(ownership.ConnectionId, ownership.PortId), bz)
+	store.Set(types.KeyOwnedAccount(ownership.ConnectionId, ownerPortID), []byte(ownership.PortId))
+
+	return nil
+}
+
+// DeleteAccountOwnership deletes the ownership of the interchain account associated with the provided connectionID
+// and portID, together with its index
+func (k Keeper) DeleteAccountOwnership(ctx sdk.Context, connectionID, portID string) {
+	ownership, found := k.GetAccountOwnership(ctx, connectionID, portID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAccountOwnership(connectionID, portID))
+
+	// the owner port identifier was valid when the ownership was stored
+	ownerPortID, err := ownership.OwnerPortID()
+	if err == nil {
+		store.Delete(types.KeyOwnedAccount(connectionID, ownerPortID))
+	}
+}
+
+// getOwnedAccountPortID returns the port identifier of the transferred interchain account controlled over the provided
+// connectionID by the owner and account index from which the provided owner port identifier is built
+func (k Keeper) getOwnedAccountPortID(ctx sdk.Context, connectionID, ownerPortID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOwnedAccount(connectionID, ownerPortID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// flaggedAccount identifies an interchain account by its connection and controller port identifiers
+type flaggedAccount struct {
+	connectionID string
+	portID       string
+}
+
+// getAndDeleteFlaggedAccounts returns the interchain accounts flagged under the provided key prefix.
+// The flags are deleted from the store as they are returned.
+func (k Keeper) getAndDeleteFlaggedAccounts(ctx sdk.Context, keyPrefix string) []flaggedAccount {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(keyPrefix+"/"))
+
+	var (
+		keys     [][]byte
+		accounts []flaggedAccount
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		keys = append(keys, iterator.Key())
+		accounts = append(accounts, flaggedAccount{connectionID: keySplit[1], portID: keySplit[2]})
+	}
+
+	// the iterator must be closed before deleting from the store
+	if err := iterator.Close(); err != nil {
+		panic(err)
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return accounts
+}
+
 // GetAuthority returns the ica/controller submodule's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -28,7 +28,7 @@ func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
 func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.ResolveControllerPortID(ctx, msg.ConnectionId, msg.Owner, msg.AccountIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -57,11 +57,21 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	portID, err := s.ResolveControllerPortID(ctx, msg.ConnectionId, msg.Owner, msg.AccountIndex)
 	if err != nil {
 		return nil, err
 	}
 
+	// packet data submitted while the active channel is closed is queued if enabled and sent once a new channel is open,
+	// packet data is also queued while pending transactions remain in order to preserve the submission order
+	if s.shouldQueueTx(ctx, msg.ConnectionId, portID) {
+		if _, err := s.enqueuePendingTx(ctx, msg.ConnectionId, portID, msg.PacketData, msg.RelativeTimeout); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSendTxResponse{Queued: true}, nil
+	}
+
 	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
 	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
 	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
@@ -73,6 +83,71 @@ func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.M
 	return &types.MsgSendTxResponse{Sequence: seq}, nil
 }
 
+// ReopenChannel defines a rpc handler for MsgReopenChannel
+func (s msgServer) ReopenChannel(goCtx context.Context, msg *types.MsgReopenChannel) (*types.MsgReopenChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := s.ResolveControllerPortID(ctx, msg.ConnectionId, msg.Owner, msg.AccountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.reopenChannel(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		s.Logger(ctx).Error("error reopening interchain account channel", "error", err.Error())
+		return nil, err
+	}
+
+	// the channel is being reopened, it must not be reopened again at the end of the block
+	s.DeleteReopenChannelFlag(ctx, msg.ConnectionId, portID)
+
+	s.Logger(ctx).Info("successfully initiated interchain account channel reopening", "channel-id", channelID)
+
+	return &types.MsgReopenChannelResponse{
+		ChannelId: channelID,
+		PortId:    portID,
+	}, nil
+}
+
+// TransferAccountOwnership defines a rpc handler for MsgTransferAccountOwnership
+func (s msgServer) TransferAccountOwnership(goCtx context.Context, msg *types.MsgTransferAccountOwnership) (*types.MsgTransferAccountOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := s.ResolveControllerPortID(ctx, msg.ConnectionId, msg.Owner, msg.AccountIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.transferAccountOwnership(ctx, msg.ConnectionId, portID, msg.NewOwner, msg.NewAccountIndex); err != nil {
+		s.Logger(ctx).Error("error transferring interchain account ownership", "error", err.Error())
+		return nil, err
+	}
+
+	EmitAccountOwnershipTransferredEvent(ctx, msg.ConnectionId, portID, msg.Owner, msg.NewOwner, msg.NewAccountIndex)
+
+	s.Logger(ctx).Info("successfully transferred interchain account ownership", "port-id", portID, "new-owner", msg.NewOwner)
+
+	return &types.MsgTransferAccountOwnershipResponse{
+		PortId: portID,
+	}, nil
+}
+
+// shouldQueueTx returns true if packet data submitted for the interchain account associated with the provided
+// connectionID and portID must be added to its queue of pending transactions rather than sent, otherwise false
+func (s msgServer) shouldQueueTx(ctx sdk.Context, connectionID, portID string) bool {
+	params := s.GetParams(ctx)
+	if !params.ControllerEnabled || params.MaxPendingTxs == 0 {
+		return false
+	}
+
+	if s.IsActiveChannelClosed(ctx, connectionID, portID) {
+		return true
+	}
+
+	count, _ := s.getPendingTxQueueInfo(ctx, connectionID, portID)
+	return count > 0
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ica/controller submodule's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
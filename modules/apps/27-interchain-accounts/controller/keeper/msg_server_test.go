@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -48,6 +49,13 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 				msg.Owner = "<invalid-owner>"
 			},
 		},
+		{
+			"success: non-zero account index",
+			true,
+			func() {
+				msg.AccountIndex = 3
+			},
+		},
 		{
 			"empty address invalid",
 			false,
@@ -88,6 +96,10 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount_MsgServer() {
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expectedChannelID, res.ChannelId)
 
+				expPortID, err := icatypes.NewControllerPortIDWithAccountIndex(msg.Owner, msg.AccountIndex)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPortID, res.PortId)
+
 				events := ctx.EventManager().Events()
 				suite.Require().Len(events, 2)
 				suite.Require().Equal(events[0].Type, channeltypes.EventTypeChannelOpenInit)
@@ -136,6 +148,12 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 			},
 			false,
 		},
+		{
+			"failure - active channel does not exist for account index", func() {
+				msg.AccountIndex = 1
+			},
+			false,
+		},
 		{
 			"failure - controller module does not own capability for this channel", func() {
 				msg.Owner = "invalid-owner"
@@ -207,6 +225,440 @@ func (suite *KeeperTestSuite) TestSubmitTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitTxPendingQueue() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSendTx
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expQueued bool
+		expErr    error
+	}{
+		{
+			"success: active channel is open, transaction is sent",
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"success: active channel is closed, transaction is queued",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			true,
+			nil,
+		},
+		{
+			"success: pending transactions remain, transaction is queued to preserve submission order",
+			func() {
+				pendingTx := types.NewPendingTx(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, 1, msg.PacketData, msg.RelativeTimeout)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+			},
+			true,
+			nil,
+		},
+		{
+			"success: queue disabled, transaction is sent to the closed channel and fails",
+			func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxPendingTxs = 0
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			false,
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: pending transaction queue is full",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+				for i := uint64(1); i <= 2; i++ {
+					pendingTx := types.NewPendingTx(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, i, msg.PacketData, msg.RelativeTimeout)
+					suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+				}
+			},
+			false,
+			types.ErrPendingTxQueueFull,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+			params.MaxPendingTxs = 2
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: []byte("data"),
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Minute.Nanoseconds()), packetData)
+
+			tc.malleate() // malleate mutates test data
+
+			pendingTxs := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(ctx, msg)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expQueued, res.Queued)
+
+			if tc.expQueued {
+				suite.Require().Zero(res.Sequence)
+
+				queuedTxs := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(ctx, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().Len(queuedTxs, len(pendingTxs)+1)
+
+				queuedTx := queuedTxs[len(queuedTxs)-1]
+				suite.Require().Equal(uint64(len(queuedTxs)), queuedTx.Index)
+				suite.Require().Equal(msg.PacketData, queuedTx.PacketData)
+				suite.Require().Equal(msg.RelativeTimeout, queuedTx.RelativeTimeout)
+			} else {
+				suite.Require().Equal(uint64(1), res.Sequence)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenChannel_MsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgReopenChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: active channel is not closed",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.OPEN })
+			},
+			icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"failure: active channel not found for account index",
+			func() {
+				msg.AccountIndex = 1
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+		{
+			"failure: active channel not found for connection",
+			func() {
+				msg.ConnectionId = "connection-100"
+			},
+			icatypes.ErrActiveChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			closedChannel := path.EndpointA.GetChannel()
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetReopenChannelFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			msg = types.NewMsgReopenChannel(TestOwnerAddress, path.EndpointA.ConnectionID)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ReopenChannel(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(1), res.ChannelId)
+				suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.PortId)
+
+				channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(ctx, res.PortId, res.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(closedChannel.Version, channel.Version)
+				suite.Require().Equal(closedChannel.Ordering, channel.Ordering)
+
+				suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasReopenChannelFlag(ctx, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferAccountOwnership_MsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgTransferAccountOwnership
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: ownership transferred again by the new owner",
+			func() {
+				ownership := types.NewAccountOwnership(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, msg.NewOwner, 0)
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwnership(suite.chainA.GetContext(), ownership)
+				suite.Require().NoError(err)
+
+				msg.Owner = msg.NewOwner
+				msg.NewOwner = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			},
+			nil,
+		},
+		{
+			"success: ownership transferred back to the owner encoded in the port identifier",
+			func() {
+				ownership := types.NewAccountOwnership(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, msg.NewOwner, 0)
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwnership(suite.chainA.GetContext(), ownership)
+				suite.Require().NoError(err)
+
+				msg.Owner, msg.NewOwner = msg.NewOwner, TestOwnerAddress
+				msg.NewAccountIndex = 0
+			},
+			nil,
+		},
+		{
+			"failure: ownership has been transferred away from the owner",
+			func() {
+				ownership := types.NewAccountOwnership(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, msg.NewOwner, 0)
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwnership(suite.chainA.GetContext(), ownership)
+				suite.Require().NoError(err)
+			},
+			types.ErrAccountOwnershipTransferred,
+		},
+		{
+			"failure: interchain account not found for account index",
+			func() {
+				msg.AccountIndex = 1
+			},
+			icatypes.ErrInterchainAccountNotFound,
+		},
+		{
+			"failure: interchain account is controlled by an underlying application",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+			},
+			icatypes.ErrInvalidChannelFlow,
+		},
+		{
+			"success: port of the new owner is bound for an interchain account on another connection",
+			func() {
+				newOwnerPortID, err := icatypes.NewControllerPortIDWithAccountIndex(msg.NewOwner, msg.NewAccountIndex)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), newOwnerPortID)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-10", newOwnerPortID, ibctesting.TestAccAddress)
+			},
+			nil,
+		},
+		{
+			"failure: new owner already controls an interchain account with the account index on the connection",
+			func() {
+				newOwnerPortID, err := icatypes.NewControllerPortIDWithAccountIndex(msg.NewOwner, msg.NewAccountIndex)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, newOwnerPortID, ibctesting.TestAccAddress)
+			},
+			icatypes.ErrAccountAlreadyExist,
+		},
+		{
+			"failure: new owner already has an active channel for the account index on the connection",
+			func() {
+				newOwnerPortID, err := icatypes.NewControllerPortIDWithAccountIndex(msg.NewOwner, msg.NewAccountIndex)
+				suite.Require().NoError(err)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, newOwnerPortID, path.EndpointA.ChannelID)
+			},
+			icatypes.ErrAccountAlreadyExist,
+		},
+		{
+			"failure: new owner already controls a transferred interchain account with the account index",
+			func() {
+				ownership := types.NewAccountOwnership(path.EndpointA.ConnectionID, icatypes.ControllerPortPrefix+"other", msg.NewOwner, msg.NewAccountIndex)
+				err := suite.chainA.GetSimApp().ICAControllerKeeper.SetAccountOwnership(suite.chainA.GetContext(), ownership)
+				suite.Require().NoError(err)
+			},
+			icatypes.ErrAccountAlreadyExist,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// the interchain account is not controlled by an underlying application
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+			pendingTx := types.NewPendingTx(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, 1, packetData, 100)
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+
+			msg = types.NewMsgTransferAccountOwnership(TestOwnerAddress, path.EndpointA.ConnectionID, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String())
+			msg.NewAccountIndex = 1
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.TransferAccountOwnership(ctx, msg)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, res.PortId)
+
+			// the interchain account is controlled by the new owner under the new account index
+			portID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ResolveControllerPortID(ctx, path.EndpointA.ConnectionID, msg.NewOwner, msg.NewAccountIndex)
+			suite.Require().NoError(err)
+			suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
+
+			// the previous owner no longer controls the interchain account
+			portID, err = suite.chainA.GetSimApp().ICAControllerKeeper.ResolveControllerPortID(ctx, path.EndpointA.ConnectionID, msg.Owner, msg.AccountIndex)
+			if err != nil {
+				suite.Require().ErrorIs(err, types.ErrAccountOwnershipTransferred)
+			} else {
+				suite.Require().NotEqual(path.EndpointA.ChannelConfig.PortID, portID)
+			}
+
+			// no ownership is stored once the interchain account is transferred back to the owner encoded in the port identifier
+			_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAccountOwnership(ctx, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().Equal(msg.NewOwner != TestOwnerAddress, found)
+
+			// pending transactions submitted by the previous owner are deleted
+			suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(ctx, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+
+			expEvent := sdk.NewEvent(
+				icatypes.EventTypeAccountOwnershipTransferred,
+				sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+				sdk.NewAttribute(icatypes.AttributeKeyConnectionID, path.EndpointA.ConnectionID),
+				sdk.NewAttribute(icatypes.AttributeKeyPortID, path.EndpointA.ChannelConfig.PortID),
+				sdk.NewAttribute(icatypes.AttributeKeyPreviousOwner, msg.Owner),
+				sdk.NewAttribute(icatypes.AttributeKeyNewOwner, msg.NewOwner),
+				sdk.NewAttribute(icatypes.AttributeKeyNewAccountIndex, strconv.FormatUint(msg.NewAccountIndex, 10)),
+			)
+			suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterMultipleInterchainAccounts_MsgServer() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	accountIndexes := []uint64{0, 1, 2}
+	addresses := make(map[string]struct{}, len(accountIndexes))
+	for _, accountIndex := range accountIndexes {
+		// each interchain account of the owner is registered over its own channel on the same connection
+		indexPath := NewICAPath(suite.chainA, suite.chainB)
+		indexPath.EndpointA.ClientID = path.EndpointA.ClientID
+		indexPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+		indexPath.EndpointB.ClientID = path.EndpointB.ClientID
+		indexPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+		msg := types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, TestVersion, channeltypes.ORDERED)
+		msg.AccountIndex = accountIndex
+
+		res, err := msgServer.RegisterInterchainAccount(suite.chainA.GetContext(), msg)
+		suite.Require().NoError(err)
+
+		suite.chainA.NextBlock()
+
+		indexPath.EndpointA.ChannelID = res.ChannelId
+		indexPath.EndpointA.ChannelConfig.PortID = res.PortId
+
+		suite.Require().NoError(indexPath.EndpointB.ChanOpenTry())
+		suite.Require().NoError(indexPath.EndpointA.ChanOpenAck())
+		suite.Require().NoError(indexPath.EndpointB.ChanOpenConfirm())
+
+		queryRes, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(suite.chainA.GetContext(), &types.QueryInterchainAccountRequest{
+			Owner:        TestOwnerAddress,
+			ConnectionId: path.EndpointA.ConnectionID,
+			AccountIndex: accountIndex,
+		})
+		suite.Require().NoError(err)
+
+		hostAddress, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, res.PortId)
+		suite.Require().True(found)
+		suite.Require().Equal(hostAddress, queryRes.Address)
+
+		addresses[queryRes.Address] = struct{}{}
+	}
+
+	// every account index is associated with a distinct interchain account
+	suite.Require().Len(addresses, len(accountIndexes))
+
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccountIndexes(suite.chainA.GetContext(), &types.QueryInterchainAccountIndexesRequest{Owner: TestOwnerAddress})
+	suite.Require().NoError(err)
+	suite.Require().Equal(accountIndexes, res.AccountIndexes)
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
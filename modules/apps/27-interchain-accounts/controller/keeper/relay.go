@@ -50,6 +50,17 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 		return 0, errorsmod.Wrap(err, "invalid interchain account packet data")
 	}
 
+	if icaPacketData.Type == icatypes.QUERY {
+		metadata, err := k.getAppMetadata(ctx, portID, activeChannelID)
+		if err != nil {
+			return 0, err
+		}
+
+		if !metadata.SupportsQueries() {
+			return 0, errorsmod.Wrapf(icatypes.ErrUnsupported, "channel %s does not support query packets, tx type %s", activeChannelID, metadata.TxType)
+		}
+	}
+
 	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, portID, activeChannelID, clienttypes.ZeroHeight(), timeoutTimestamp, icaPacketData.GetBytes())
 	if err != nil {
 		return 0, err
@@ -58,8 +69,110 @@ func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketDa
 	return sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
-func (Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+// OnAcknowledgementPacket stores the execution result decoded from the acknowledgement if storing execution results
+// is enabled in the controller submodule parameters. The oldest results of the interchain account are pruned once the
+// configured maximum is exceeded. Acknowledgements which cannot be decoded are logged and otherwise ignored, such that
+// packet acknowledgement is never blocked by the execution result history.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, connectionID string, packet channeltypes.Packet, acknowledgement []byte) {
+	maxResults := k.GetParams(ctx).MaxExecutionResults
+	if maxResults == 0 {
+		return
+	}
+
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal interchain accounts packet data", "sequence", packet.GetSequence(), "error", err)
+		return
+	}
+
+	result, err := types.NewExecutionResult(connectionID, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), data.Type, acknowledgement, ctx.BlockHeight())
+	if err != nil {
+		k.Logger(ctx).Error("failed to decode interchain accounts execution result", "sequence", packet.GetSequence(), "error", err)
+		return
+	}
+
+	k.SetExecutionResult(ctx, result)
+	k.pruneExecutionResults(ctx, connectionID, packet.GetSourcePort(), maxResults)
+}
+
+// OnTimeoutPacket flags the active channel of the interchain account for reopening if automatic channel reopening is
+// enabled in the controller submodule parameters. The underlying channel end is closed after the timeout callback
+// due to the semantics of ORDERED channels, a new channel handshake is therefore initiated at the end of the block.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if !k.GetParams(ctx).AutoReopenChannels {
+		return nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found || channel.Ordering != channeltypes.ORDERED {
+		return nil
+	}
+
+	connectionID, err := k.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	// only the active channel is reopened, timeouts of packets sent on previously closed channels are ignored
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, packet.GetSourcePort()); !found || activeChannelID != packet.GetSourceChannel() {
+		return nil
+	}
+
+	k.SetReopenChannelFlag(ctx, connectionID, packet.GetSourcePort())
+
 	return nil
 }
+
+// enqueuePendingTx adds the packet data to the queue of pending transactions of the interchain account associated with
+// the provided connectionID and portID. The pending transaction is sent once the active channel of the interchain account
+// is open, using a timeout computed from the block time at which it is sent and the provided relative timeout.
+// An error is returned if the maximum number of pending transactions configured in the controller submodule parameters
+// has been reached.
+func (k Keeper) enqueuePendingTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeout uint64) (uint64, error) {
+	count, index := k.getPendingTxQueueInfo(ctx, connectionID, portID)
+	if maxPendingTxs := k.GetParams(ctx).MaxPendingTxs; count >= maxPendingTxs {
+		return 0, errorsmod.Wrapf(types.ErrPendingTxQueueFull, "maximum of %d pending transactions reached on connection %s for port %s", maxPendingTxs, connectionID, portID)
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(err, "invalid interchain account packet data")
+	}
+
+	k.SetPendingTx(ctx, types.NewPendingTx(connectionID, portID, index, icaPacketData, relativeTimeout))
+
+	// pending transactions queued while the active channel is open are sent at the end of the block
+	if _, found := k.GetOpenActiveChannel(ctx, connectionID, portID); found {
+		k.SetFlushPendingTxsFlag(ctx, connectionID, portID)
+	}
+
+	return index, nil
+}
+
+// FlushPendingTxs sends the pending transactions of each interchain account whose active channel has been opened with
+// pending transactions queued. Pending transactions are sent in the order in which they were submitted and are removed
+// from the queue whether or not they are sent successfully. Failures to send a pending transaction are logged.
+func (k Keeper) FlushPendingTxs(ctx sdk.Context) {
+	for _, account := range k.getAndDeleteFlaggedAccounts(ctx, types.FlushPendingTxsKeyPrefix) {
+		// pending transactions remain queued if the active channel has been closed again
+		if _, found := k.GetOpenActiveChannel(ctx, account.connectionID, account.portID); !found {
+			continue
+		}
+
+		for _, pendingTx := range k.GetPendingTxs(ctx, account.connectionID, account.portID) {
+			k.DeletePendingTx(ctx, pendingTx.ConnectionId, pendingTx.PortId, pendingTx.Index)
+
+			cacheCtx, writeFn := ctx.CacheContext()
+
+			absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + pendingTx.RelativeTimeout
+			sequence, err := k.sendTx(cacheCtx, pendingTx.ConnectionId, pendingTx.PortId, pendingTx.PacketData, absoluteTimeout)
+			if err != nil {
+				k.Logger(ctx).Error("failed to send pending interchain account transaction", "connection-id", pendingTx.ConnectionId, "port-id", pendingTx.PortId, "index", pendingTx.Index, "error", err.Error())
+				continue
+			}
+
+			writeFn()
+
+			k.Logger(ctx).Info("sent pending interchain account transaction", "connection-id", pendingTx.ConnectionId, "port-id", pendingTx.PortId, "index", pendingTx.Index, "sequence", sequence)
+		}
+	}
+}
@@ -78,6 +78,44 @@ func (suite *KeeperTestSuite) TestSendTx() {
 			},
 			true,
 		},
+		{
+			"success with query packet",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) {
+					metadata, err := icatypes.MetadataFromVersion(channel.Version)
+					suite.Require().NoError(err)
+
+					metadata.TxType = icatypes.TxTypeSDKMultiMsgQuery
+					channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				})
+
+				data, err := icatypes.SerializeCosmosQuery(suite.chainB.GetSimApp().AppCodec(), []icatypes.QueryRequest{
+					icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", nil),
+				}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.QUERY,
+					Data: data,
+				}
+			},
+			true,
+		},
+		{
+			"query packet on channel without query support",
+			func() {
+				data, err := icatypes.SerializeCosmosQuery(suite.chainB.GetSimApp().AppCodec(), []icatypes.QueryRequest{
+					icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", nil),
+				}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+
+				packetData = icatypes.InterchainAccountPacketData{
+					Type: icatypes.QUERY,
+					Data: data,
+				}
+			},
+			false,
+		},
 		{
 			"data is nil",
 			func() {
@@ -169,19 +207,152 @@ func (suite *KeeperTestSuite) TestSendTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var (
+		path            *ibctesting.Path
+		maxResults      uint64
+		sequences       []uint64
+		acknowledgement []byte
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expSequences []uint64
+	}{
+		{
+			"success: execution result stored",
+			func() {},
+			[]uint64{1},
+		},
+		{
+			"success: error acknowledgement stored",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement(icatypes.ErrUnknownDataType).Acknowledgement()
+			},
+			[]uint64{1},
+		},
+		{
+			"success: oldest execution results pruned",
+			func() {
+				maxResults = 2
+				sequences = []uint64{1, 2, 3}
+			},
+			[]uint64{2, 3},
+		},
+		{
+			"execution results disabled",
+			func() {
+				maxResults = 0
+			},
+			nil,
+		},
+		{
+			"invalid acknowledgement is not stored",
+			func() {
+				acknowledgement = []byte("invalid")
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			maxResults = 10
+			sequences = []uint64{1}
+			acknowledgement = channeltypes.NewResultAcknowledgement([]byte{}).Acknowledgement()
+
+			tc.malleate() // malleate mutates test data
+
+			params := types.DefaultParams()
+			params.MaxExecutionResults = maxResults
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+
+			for _, sequence := range sequences {
+				packet := channeltypes.NewPacket(
+					packetData.GetBytes(),
+					sequence,
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					clienttypes.NewHeight(0, 100),
+					0,
+				)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), ibctesting.FirstConnectionID, packet, acknowledgement)
+			}
+
+			var storedSequences []uint64
+			for _, result := range suite.chainA.GetSimApp().ICAControllerKeeper.GetAllExecutionResults(suite.chainA.GetContext()) {
+				suite.Require().Equal(ibctesting.FirstConnectionID, result.ConnectionId)
+				suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, result.PortId)
+				suite.Require().Equal(path.EndpointA.ChannelID, result.ChannelId)
+
+				storedSequences = append(storedSequences, result.Sequence)
+			}
+
+			suite.Require().Equal(tc.expSequences, storedSequences)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var path *ibctesting.Path
 
 	testCases := []struct {
-		msg      string
-		malleate func()
-		expPass  bool
+		msg           string
+		malleate      func()
+		expReopenFlag bool
 	}{
 		{
-			"success",
+			"success: automatic channel reopening disabled",
 			func() {},
+			false,
+		},
+		{
+			"success: active channel flagged for reopening",
+			func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.AutoReopenChannels = true
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
 			true,
 		},
+		{
+			"success: channel is not the active channel",
+			func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.AutoReopenChannels = true
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, "channel-100")
+			},
+			false,
+		},
+		{
+			"success: channel is not ORDERED",
+			func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.AutoReopenChannels = true
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.Ordering = channeltypes.UNORDERED })
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -210,11 +381,75 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			)
 
 			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+			suite.Require().NoError(err)
 
-			if tc.expPass {
-				suite.Require().NoError(err)
+			hasFlag := suite.chainA.GetSimApp().ICAControllerKeeper.HasReopenChannelFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().Equal(tc.expReopenFlag, hasFlag)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFlushPendingTxs() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expFlushed bool
+	}{
+		{
+			"success: pending transactions are sent",
+			func() {},
+			true,
+		},
+		{
+			"success: pending transactions remain queued while the active channel is closed",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			validPacketData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+			invalidPacketData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}
+
+			// a pending transaction failing to be sent does not prevent subsequent pending transactions from being sent
+			for i, packetData := range []icatypes.InterchainAccountPacketData{validPacketData, invalidPacketData, validPacketData} {
+				pendingTx := types.NewPendingTx(path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, uint64(i+1), packetData, 100)
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingTx(suite.chainA.GetContext(), pendingTx)
+			}
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetFlushPendingTxsFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().ICAControllerKeeper.FlushPendingTxs(suite.chainA.GetContext())
+
+			suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasFlushPendingTxsFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+
+			pendingTxs := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			nextSequence, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+
+			if tc.expFlushed {
+				suite.Require().Empty(pendingTxs)
+				suite.Require().Equal(uint64(3), nextSequence)
 			} else {
-				suite.Require().Error(err)
+				suite.Require().Len(pendingTxs, 3)
+				suite.Require().Equal(uint64(1), nextSequence)
 			}
 		})
 	}
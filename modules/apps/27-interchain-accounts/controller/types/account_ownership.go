@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewAccountOwnership creates a new AccountOwnership instance
+func NewAccountOwnership(connectionID, portID, owner string, accountIndex uint64) AccountOwnership {
+	return AccountOwnership{
+		ConnectionId: connectionID,
+		PortId:       portID,
+		Owner:        owner,
+		AccountIndex: accountIndex,
+	}
+}
+
+// OwnerPortID returns the controller port identifier built from the owner and account index of the AccountOwnership
+func (o AccountOwnership) OwnerPortID() (string, error) {
+	return icatypes.NewControllerPortIDWithAccountIndex(o.Owner, o.AccountIndex)
+}
+
+// Validate performs basic validation of the AccountOwnership
+func (o AccountOwnership) Validate() error {
+	if err := host.ConnectionIdentifierValidator(o.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(o.PortId); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(o.Owner) == "" {
+		return fmt.Errorf("account ownership owner cannot be empty")
+	}
+
+	ownerPortID, err := o.OwnerPortID()
+	if err != nil {
+		return err
+	}
+
+	if ownerPortID == o.PortId {
+		return fmt.Errorf("account ownership owner cannot be the owner encoded in port %s", o.PortId)
+	}
+
+	return nil
+}
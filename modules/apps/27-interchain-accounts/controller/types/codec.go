@@ -12,6 +12,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegisterInterchainAccount{},
 		&MsgSendTx{},
+		&MsgReopenChannel{},
+		&MsgTransferAccountOwnership{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
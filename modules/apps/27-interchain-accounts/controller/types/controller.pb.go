@@ -5,7 +5,10 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -27,6 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// max_execution_results defines the maximum number of execution results stored for each interchain account.
+	// The oldest results are pruned once the limit is exceeded. The zero value disables storing execution results.
+	MaxExecutionResults uint64 `protobuf:"varint,2,opt,name=max_execution_results,json=maxExecutionResults,proto3" json:"max_execution_results,omitempty"`
+	// auto_reopen_channels enables reopening the ordered channel of an interchain account at the end of the block in
+	// which it was closed by a packet timeout, reusing the version and ordering of the closed channel.
+	AutoReopenChannels bool `protobuf:"varint,3,opt,name=auto_reopen_channels,json=autoReopenChannels,proto3" json:"auto_reopen_channels,omitempty"`
+	// max_pending_txs defines the maximum number of MsgSendTx packet data queued for each interchain account while its
+	// channel is closed. Queued packet data is sent once a new channel is open. The zero value disables queueing.
+	MaxPendingTxs uint64 `protobuf:"varint,4,opt,name=max_pending_txs,json=maxPendingTxs,proto3" json:"max_pending_txs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,8 +81,316 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxExecutionResults() uint64 {
+	if m != nil {
+		return m.MaxExecutionResults
+	}
+	return 0
+}
+
+func (m *Params) GetAutoReopenChannels() bool {
+	if m != nil {
+		return m.AutoReopenChannels
+	}
+	return false
+}
+
+func (m *Params) GetMaxPendingTxs() uint64 {
+	if m != nil {
+		return m.MaxPendingTxs
+	}
+	return 0
+}
+
+// ExecutionResult defines the result of an interchain accounts packet executed on the host chain, as decoded from
+// the acknowledgement received by the controller chain.
+type ExecutionResult struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence     uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// success is true if the host chain wrote a successful acknowledgement.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// tx_msg_data contains the message responses of a successfully executed transaction packet.
+	TxMsgData *types.TxMsgData `protobuf:"bytes,6,opt,name=tx_msg_data,json=txMsgData,proto3" json:"tx_msg_data,omitempty"`
+	// query_response contains the query responses of a successfully executed query packet.
+	QueryResponse *types1.CosmosQueryResponse `protobuf:"bytes,7,opt,name=query_response,json=queryResponse,proto3" json:"query_response,omitempty"`
+	// codespace of the error returned by the host chain, empty if not included in the error acknowledgement.
+	Codespace string `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the ABCI code of the error returned by the host chain.
+	Code uint32 `protobuf:"varint,9,opt,name=code,proto3" json:"code,omitempty"`
+	// error is the error string of the error acknowledgement.
+	Error string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the controller chain block height at which the acknowledgement was received.
+	Height int64 `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{1}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetTxMsgData() *types.TxMsgData {
+	if m != nil {
+		return m.TxMsgData
+	}
+	return nil
+}
+
+func (m *ExecutionResult) GetQueryResponse() *types1.CosmosQueryResponse {
+	if m != nil {
+		return m.QueryResponse
+	}
+	return nil
+}
+
+func (m *ExecutionResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ExecutionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PendingTx defines interchain accounts packet data submitted through MsgSendTx while the channel of the interchain
+// account was closed, which is sent once a new channel is open.
+type PendingTx struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// index defines the position of the pending transaction in the queue of the interchain account.
+	Index      uint64                             `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	PacketData types1.InterchainAccountPacketData `protobuf:"bytes,4,opt,name=packet_data,json=packetData,proto3" json:"packet_data"`
+	// relative_timeout is added to the block time at which the packet is sent.
+	RelativeTimeout uint64 `protobuf:"varint,5,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{2}
+}
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingTx) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingTx) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PendingTx) GetPacketData() types1.InterchainAccountPacketData {
+	if m != nil {
+		return m.PacketData
+	}
+	return types1.InterchainAccountPacketData{}
+}
+
+func (m *PendingTx) GetRelativeTimeout() uint64 {
+	if m != nil {
+		return m.RelativeTimeout
+	}
+	return 0
+}
+
+// AccountOwnership defines the owner of an interchain account whose ownership was transferred away from the owner
+// encoded in its controller port identifier. The interchain account keeps its controller port identifier, and thus
+// its address on the host chain, and is controlled by the given owner under the given account index.
+type AccountOwnership struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	Owner        string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	AccountIndex uint64 `protobuf:"varint,4,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+}
+
+func (m *AccountOwnership) Reset()         { *m = AccountOwnership{} }
+func (m *AccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*AccountOwnership) ProtoMessage()    {}
+func (*AccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_177fd0fec5eb3400, []int{3}
+}
+func (m *AccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountOwnership.Merge(m, src)
+}
+func (m *AccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountOwnership proto.InternalMessageInfo
+
+func (m *AccountOwnership) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AccountOwnership) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *AccountOwnership) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AccountOwnership) GetAccountIndex() uint64 {
+	if m != nil {
+		return m.AccountIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
+	proto.RegisterType((*ExecutionResult)(nil), "ibc.applications.interchain_accounts.controller.v1.ExecutionResult")
+	proto.RegisterType((*PendingTx)(nil), "ibc.applications.interchain_accounts.controller.v1.PendingTx")
+	proto.RegisterType((*AccountOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.AccountOwnership")
 }
 
 func init() {
@@ -78,21 +398,51 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0xd4, 0x32, 0x94,
-	0x19, 0xc4, 0x5e, 0x31, 0xb2, 0x54, 0xf6, 0xd5, 0x6a, 0x0f, 0x39, 0xbe, 0xc8, 0xe7, 0x44, 0xe2,
-	0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x04, 0x1d, 0x92, 0x21, 0xe3, 0xe9, 0xd3,
-	0x7d, 0xfa, 0xbf, 0xf2, 0x99, 0x1c, 0x82, 0x6d, 0xdb, 0x40, 0x68, 0x33, 0x71, 0x14, 0xa0, 0x98,
-	0x7d, 0xc2, 0x93, 0xa5, 0xb8, 0xb7, 0x88, 0xdc, 0xc5, 0x2c, 0x80, 0x1c, 0x73, 0xe2, 0x10, 0x7c,
-	0x82, 0x7e, 0x3d, 0xbb, 0x4c, 0x9b, 0x38, 0x73, 0xb5, 0x21, 0x87, 0x66, 0x2e, 0x31, 0x0b, 0x12,
-	0x33, 0x7b, 0xeb, 0xd7, 0x77, 0xdb, 0x72, 0xb5, 0xb3, 0xc9, 0x36, 0x52, 0xd5, 0x65, 0x35, 0xa1,
-	0xbd, 0x8f, 0xd6, 0x05, 0x7f, 0xb8, 0x51, 0xb7, 0xea, 0xfe, 0xea, 0xf5, 0x7a, 0x22, 0x2f, 0x17,
-	0xf0, 0xf4, 0xfe, 0x35, 0x68, 0x75, 0x1e, 0xb4, 0xfa, 0x19, 0xb4, 0xfa, 0x1c, 0x75, 0x71, 0x1e,
-	0x75, 0xf1, 0x3d, 0xea, 0xe2, 0x6d, 0x77, 0xa4, 0x7c, 0xea, 0x9c, 0x41, 0x6e, 0x00, 0x59, 0x1a,
-	0x16, 0x20, 0x87, 0xf5, 0x91, 0xa1, 0x7f, 0x84, 0x86, 0x0f, 0x5d, 0xf0, 0xf2, 0xd7, 0x2a, 0xb0,
-	0xd9, 0xd6, 0xd3, 0xc2, 0x7a, 0x29, 0x33, 0x7f, 0xb4, 0x5e, 0xdc, 0xea, 0xbf, 0xef, 0xe1, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x7d, 0x5b, 0xa9, 0x45, 0x26, 0x01, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xfb, 0x34,
+	0x18, 0x6e, 0xd6, 0xae, 0x5b, 0xdd, 0x95, 0x0d, 0x53, 0x20, 0x9a, 0xa0, 0x54, 0x9d, 0x84, 0xca,
+	0xa1, 0x09, 0x2d, 0x48, 0x70, 0xe0, 0xc2, 0xba, 0x1d, 0x7a, 0x40, 0x94, 0x68, 0x27, 0x2e, 0x91,
+	0xe3, 0xbc, 0x4a, 0xcd, 0x12, 0x3b, 0x8b, 0x9d, 0x92, 0x7d, 0x06, 0x2e, 0x7c, 0x12, 0xbe, 0x05,
+	0xd2, 0x8e, 0x3b, 0x72, 0x42, 0x68, 0xfb, 0x10, 0x5c, 0x7f, 0xb2, 0x9d, 0xfe, 0xd1, 0x4f, 0x3b,
+	0x4c, 0xda, 0xcd, 0xcf, 0xf3, 0xd8, 0xcf, 0xfb, 0xfa, 0x7d, 0x2c, 0xa3, 0x39, 0x8b, 0xa8, 0x4f,
+	0xf2, 0x3c, 0x65, 0x94, 0x28, 0x26, 0xb8, 0xf4, 0x19, 0x57, 0x50, 0xd0, 0x15, 0x61, 0x3c, 0x24,
+	0x94, 0x8a, 0x92, 0x2b, 0xe9, 0x53, 0xc1, 0x55, 0x21, 0xd2, 0x14, 0x0a, 0x7f, 0x3d, 0xdd, 0x43,
+	0x5e, 0x5e, 0x08, 0x25, 0xf0, 0x8c, 0x45, 0xd4, 0xdb, 0x37, 0xf1, 0x5e, 0x30, 0xf1, 0xf6, 0x8e,
+	0xad, 0xa7, 0xe7, 0xfd, 0x44, 0x24, 0xc2, 0x1c, 0xf7, 0xf5, 0xca, 0x3a, 0x9d, 0x5f, 0x50, 0x21,
+	0x33, 0x21, 0xfd, 0x88, 0x48, 0xf0, 0x49, 0x44, 0x99, 0xbf, 0x9e, 0x46, 0xa0, 0xc8, 0xd4, 0x80,
+	0x7a, 0xd3, 0xb7, 0xaf, 0xea, 0x79, 0x3d, 0xf5, 0x73, 0x42, 0x6f, 0x41, 0xd9, 0x53, 0xa3, 0xbf,
+	0x1d, 0xd4, 0x5e, 0x92, 0x82, 0x64, 0x12, 0x4f, 0x10, 0xde, 0x35, 0x13, 0x02, 0x27, 0x51, 0x0a,
+	0xb1, 0xeb, 0x0c, 0x9d, 0xf1, 0x71, 0xf0, 0xe1, 0x4e, 0xb9, 0xb6, 0x02, 0x9e, 0xa1, 0x8f, 0x33,
+	0x52, 0x85, 0x50, 0x01, 0x2d, 0x75, 0xbd, 0xb0, 0x00, 0x59, 0xa6, 0x4a, 0xba, 0x07, 0x43, 0x67,
+	0xdc, 0x0a, 0x3e, 0xca, 0x48, 0x75, 0xbd, 0xd1, 0x02, 0x2b, 0xe1, 0xaf, 0x51, 0x9f, 0x94, 0x4a,
+	0x84, 0x05, 0x88, 0x1c, 0x78, 0x48, 0x57, 0x84, 0x73, 0x48, 0xa5, 0xdb, 0x34, 0x45, 0xb0, 0xd6,
+	0x02, 0x23, 0xcd, 0x6b, 0x05, 0x7f, 0x89, 0x4e, 0x75, 0x95, 0x1c, 0x78, 0xcc, 0x78, 0x12, 0xaa,
+	0x4a, 0xba, 0x2d, 0xe3, 0xdf, 0xcb, 0x48, 0xb5, 0xb4, 0xec, 0x4d, 0x25, 0x47, 0x7f, 0x35, 0xd1,
+	0xe9, 0x7b, 0xe5, 0xf0, 0x05, 0xea, 0x51, 0xc1, 0x39, 0x50, 0xd3, 0x1e, 0xb3, 0x77, 0xe9, 0x04,
+	0x27, 0x3b, 0x72, 0x11, 0xe3, 0x4f, 0xd1, 0x51, 0x2e, 0x0a, 0xa5, 0xe5, 0x03, 0x23, 0xb7, 0x35,
+	0x5c, 0xc4, 0xf8, 0x73, 0x84, 0xea, 0xfe, 0xb4, 0xd6, 0x34, 0x5a, 0xa7, 0x66, 0x16, 0x31, 0x3e,
+	0x47, 0xc7, 0x12, 0xee, 0x4a, 0xe0, 0x14, 0xea, 0x8e, 0xb6, 0x18, 0xbb, 0xe8, 0x48, 0x96, 0x94,
+	0x82, 0x94, 0xee, 0xa1, 0xb9, 0xd9, 0x06, 0xe2, 0x39, 0xea, 0xaa, 0x2a, 0xcc, 0x64, 0x12, 0xc6,
+	0x44, 0x11, 0xb7, 0x3d, 0x74, 0xc6, 0xdd, 0xd9, 0x85, 0x67, 0xf3, 0xf5, 0x74, 0xbe, 0x9e, 0x89,
+	0xb4, 0xce, 0xd7, 0xbb, 0xa9, 0x7e, 0x92, 0xc9, 0x15, 0x51, 0x24, 0xe8, 0xa8, 0xcd, 0x12, 0x53,
+	0xf4, 0xc1, 0x5d, 0x09, 0xc5, 0xbd, 0x9e, 0x78, 0x2e, 0xb8, 0x04, 0xf7, 0xc8, 0xf8, 0xfc, 0xe0,
+	0xbd, 0xea, 0xc5, 0xad, 0xa7, 0xde, 0xdc, 0xd4, 0xfb, 0x45, 0x9b, 0x04, 0xb5, 0x47, 0xd0, 0xbb,
+	0xdb, 0x87, 0xf8, 0x33, 0xd4, 0xa1, 0x22, 0x06, 0x99, 0x13, 0x0a, 0xee, 0x71, 0x7d, 0xfb, 0x0d,
+	0x81, 0x31, 0x6a, 0x69, 0xe0, 0x76, 0x86, 0xce, 0xb8, 0x17, 0x98, 0x35, 0xee, 0xa3, 0x43, 0x28,
+	0x0a, 0x51, 0xb8, 0xc8, 0xec, 0xb6, 0x00, 0x7f, 0x82, 0xda, 0x2b, 0x60, 0xc9, 0x4a, 0xb9, 0xdd,
+	0xa1, 0x33, 0x6e, 0x06, 0x35, 0x1a, 0xfd, 0xef, 0xa0, 0xce, 0x36, 0xbf, 0x37, 0x46, 0xd5, 0x47,
+	0x87, 0x8c, 0xc7, 0x50, 0x99, 0x94, 0x5a, 0x81, 0x05, 0xf8, 0x16, 0x75, 0xed, 0x53, 0xb7, 0xb3,
+	0x6e, 0x99, 0x19, 0x5d, 0xbd, 0x7a, 0x46, 0x8b, 0x2d, 0xfd, 0xa3, 0x65, 0x97, 0xc6, 0x4c, 0x27,
+	0x70, 0xd9, 0x7a, 0xf8, 0xf7, 0x8b, 0x46, 0x80, 0xf2, 0x2d, 0x83, 0xbf, 0x42, 0x67, 0x05, 0xa4,
+	0x44, 0xb1, 0x35, 0x84, 0x8a, 0x65, 0x20, 0x4a, 0x65, 0xb2, 0x6f, 0x05, 0xa7, 0x1b, 0xfe, 0xc6,
+	0xd2, 0xa3, 0x3f, 0x1c, 0x74, 0x56, 0x5b, 0xfe, 0xfc, 0x3b, 0x87, 0x42, 0xae, 0x58, 0xfe, 0xf6,
+	0x01, 0x08, 0x6d, 0x55, 0x3f, 0x53, 0x0b, 0xb4, 0x67, 0x7d, 0xa1, 0xd0, 0x8e, 0xc7, 0xbe, 0xd3,
+	0x93, 0x9a, 0x5c, 0x68, 0xee, 0xf2, 0xb7, 0x87, 0xa7, 0x81, 0xf3, 0xf8, 0x34, 0x70, 0xfe, 0x7b,
+	0x1a, 0x38, 0x7f, 0x3e, 0x0f, 0x1a, 0x8f, 0xcf, 0x83, 0xc6, 0x3f, 0xcf, 0x83, 0xc6, 0xaf, 0xcb,
+	0x84, 0xa9, 0x55, 0x19, 0x79, 0x54, 0x64, 0x7e, 0xfd, 0x01, 0xb1, 0x88, 0x4e, 0x12, 0xe1, 0xaf,
+	0xbf, 0xf7, 0x33, 0x11, 0x97, 0x29, 0x48, 0xfd, 0xe1, 0x48, 0x7f, 0xf6, 0xdd, 0x64, 0x37, 0xc4,
+	0xc9, 0x4b, 0xff, 0xa3, 0xba, 0xcf, 0x41, 0x46, 0x6d, 0xf3, 0xe7, 0x7c, 0xf3, 0x6e, 0x00, 0x8a,
+	0xde, 0x4e, 0x27, 0x5f, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +465,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPendingTxs != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxPendingTxs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AutoReopenChannels {
+		i--
+		if m.AutoReopenChannels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExecutionResults != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MaxExecutionResults))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -128,70 +498,390 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintController(dAtA []byte, offset int, v uint64) int {
-	offset -= sovController(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ControllerEnabled {
-		n += 2
+	if m.Height != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x58
 	}
-	return n
-}
-
-func sovController(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozController(x uint64) (n int) {
-	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowController
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Code != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.QueryResponse != nil {
+		{
+			size, err := m.QueryResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
+			i -= size
+			i = encodeVarintController(dAtA, i, uint64(size))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TxMsgData != nil {
+		{
+			size, err := m.TxMsgData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintController(dAtA, i, uint64(size))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RelativeTimeout != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.RelativeTimeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.PacketData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Index != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintController(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ControllerEnabled {
+		n += 2
+	}
+	if m.MaxExecutionResults != 0 {
+		n += 1 + sovController(uint64(m.MaxExecutionResults))
+	}
+	if m.AutoReopenChannels {
+		n += 2
+	}
+	if m.MaxPendingTxs != 0 {
+		n += 1 + sovController(uint64(m.MaxPendingTxs))
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovController(uint64(m.Sequence))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.TxMsgData != nil {
+		l = m.TxMsgData.Size()
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.QueryResponse != nil {
+		l = m.QueryResponse.Size()
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovController(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovController(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PendingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovController(uint64(m.Index))
+	}
+	l = m.PacketData.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.RelativeTimeout != 0 {
+		n += 1 + sovController(uint64(m.RelativeTimeout))
+	}
+	return n
+}
+
+func (m *AccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovController(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowController
 				}
@@ -206,6 +896,773 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionResults", wireType)
+			}
+			m.MaxExecutionResults = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionResults |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopenChannels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopenChannels = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingTxs", wireType)
+			}
+			m.MaxPendingTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxMsgData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxMsgData == nil {
+				m.TxMsgData = &types.TxMsgData{}
+			}
+			if err := m.TxMsgData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryResponse == nil {
+				m.QueryResponse = &types1.CosmosQueryResponse{}
+			}
+			if err := m.QueryResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeout", wireType)
+			}
+			m.RelativeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelativeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -7,4 +7,7 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrInvalidQueryAcknowledgement = errorsmod.Register(SubModuleName, 3, "invalid query acknowledgement")
+	ErrPendingTxQueueFull          = errorsmod.Register(SubModuleName, 4, "pending transaction queue is full")
+	ErrAccountOwnershipTransferred = errorsmod.Register(SubModuleName, 5, "interchain account ownership has been transferred")
 )
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	// errorAckWithCodespaceRegex matches error acknowledgements created using channeltypes.NewErrorAcknowledgementWithCodespace
+	errorAckWithCodespaceRegex = regexp.MustCompile(`^ABCI error: ([^/]*)/(\d+): `)
+	// errorAckRegex matches error acknowledgements created using channeltypes.NewErrorAcknowledgement
+	errorAckRegex = regexp.MustCompile(`^ABCI code: (\d+): `)
+)
+
+// NewExecutionResult decodes the acknowledgement written by the host chain for an interchain accounts packet of the
+// provided type into an ExecutionResult. The message responses of successfully executed transactions are decoded into
+// TxMsgData and the query responses of successfully executed queries are decoded into a CosmosQueryResponse.
+// The codespace and code of error acknowledgements are parsed from the error string when present.
+func NewExecutionResult(connectionID, portID, channelID string, sequence uint64, packetType icatypes.Type, acknowledgement []byte, height int64) (ExecutionResult, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return ExecutionResult{}, errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal acknowledgement: %v", err)
+	}
+
+	result := ExecutionResult{
+		ConnectionId: connectionID,
+		PortId:       portID,
+		ChannelId:    channelID,
+		Sequence:     sequence,
+		Success:      ack.Success(),
+		Height:       height,
+	}
+
+	if !ack.Success() {
+		result.Error = ack.GetError()
+		result.Codespace, result.Code = parseErrorAcknowledgement(result.Error)
+		return result, nil
+	}
+
+	switch packetType {
+	case icatypes.EXECUTE_TX:
+		var txMsgData sdk.TxMsgData
+		if err := proto.Unmarshal(ack.GetResult(), &txMsgData); err != nil {
+			return ExecutionResult{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal TxMsgData: %v", err)
+		}
+
+		result.TxMsgData = &txMsgData
+	case icatypes.QUERY:
+		var queryResponse icatypes.CosmosQueryResponse
+		if err := proto.Unmarshal(ack.GetResult(), &queryResponse); err != nil {
+			return ExecutionResult{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal CosmosQueryResponse: %v", err)
+		}
+
+		result.QueryResponse = &queryResponse
+	default:
+		return ExecutionResult{}, errorsmod.Wrapf(icatypes.ErrUnknownDataType, "unsupported packet type %s", packetType)
+	}
+
+	return result, nil
+}
+
+// parseErrorAcknowledgement returns the codespace and ABCI code included in the error string of an error
+// acknowledgement. The zero values are returned if they cannot be parsed from the error string.
+func parseErrorAcknowledgement(ackError string) (string, uint32) {
+	if matches := errorAckWithCodespaceRegex.FindStringSubmatch(ackError); matches != nil {
+		code, err := strconv.ParseUint(matches[2], 10, 32)
+		if err != nil {
+			return "", 0
+		}
+
+		return matches[1], uint32(code)
+	}
+
+	if matches := errorAckRegex.FindStringSubmatch(ackError); matches != nil {
+		code, err := strconv.ParseUint(matches[1], 10, 32)
+		if err != nil {
+			return "", 0
+		}
+
+		return "", uint32(code)
+	}
+
+	return "", 0
+}
+
+// Validate performs basic validation of the ExecutionResult identifiers
+func (r ExecutionResult) Validate() error {
+	if err := host.ConnectionIdentifierValidator(r.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(r.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+
+	if r.Sequence == 0 {
+		return fmt.Errorf("execution result sequence cannot be zero")
+	}
+
+	if r.Success && (r.Code != 0 || r.Error != "") {
+		return fmt.Errorf("successful execution result cannot contain an error")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestNewExecutionResult(t *testing.T) {
+	msgResponse, err := proto.Marshal(&govtypesv1.MsgSubmitProposalResponse{ProposalId: 1})
+	require.NoError(t, err)
+
+	txMsgData := sdk.TxMsgData{
+		MsgResponses: []*codectypes.Any{{TypeUrl: sdk.MsgTypeURL(&govtypesv1.MsgSubmitProposalResponse{}), Value: msgResponse}},
+	}
+	txMsgDataBz, err := proto.Marshal(&txMsgData)
+	require.NoError(t, err)
+
+	queryResponse := icatypes.CosmosQueryResponse{Responses: [][]byte{[]byte("response")}, Height: 10}
+	queryResponseBz, err := proto.Marshal(&queryResponse)
+	require.NoError(t, err)
+
+	var (
+		packetType      icatypes.Type
+		acknowledgement []byte
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult types.ExecutionResult
+		expErr    error
+	}{
+		{
+			"success: transaction executed",
+			func() {},
+			types.ExecutionResult{Success: true, TxMsgData: &txMsgData},
+			nil,
+		},
+		{
+			"success: queries executed",
+			func() {
+				packetType = icatypes.QUERY
+				acknowledgement = channeltypes.NewResultAcknowledgement(queryResponseBz).Acknowledgement()
+			},
+			types.ExecutionResult{Success: true, QueryResponse: &queryResponse},
+			nil,
+		},
+		{
+			"success: error acknowledgement with code",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement(ibcerrors.ErrUnauthorized).Acknowledgement()
+			},
+			types.ExecutionResult{
+				Code:  ibcerrors.ErrUnauthorized.ABCICode(),
+				Error: "ABCI code: 2: error handling packet: see events for details",
+			},
+			nil,
+		},
+		{
+			"success: error acknowledgement with codespace and code",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgementWithCodespace(ibcerrors.ErrUnauthorized).Acknowledgement()
+			},
+			types.ExecutionResult{
+				Codespace: ibcerrors.ErrUnauthorized.Codespace(),
+				Code:      ibcerrors.ErrUnauthorized.ABCICode(),
+				Error:     "ABCI error: ibc/2: error handling packet: see events for details",
+			},
+			nil,
+		},
+		{
+			"success: error acknowledgement without code",
+			func() {
+				acknowledgement = channeltypes.Acknowledgement{
+					Response: &channeltypes.Acknowledgement_Error{Error: "failed"},
+				}.Acknowledgement()
+			},
+			types.ExecutionResult{Error: "failed"},
+			nil,
+		},
+		{
+			"failure: acknowledgement is not valid json",
+			func() {
+				acknowledgement = []byte("invalid")
+			},
+			types.ExecutionResult{},
+			ibcerrors.ErrUnknownRequest,
+		},
+		{
+			"failure: result is not TxMsgData",
+			func() {
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement()
+			},
+			types.ExecutionResult{},
+			ibcerrors.ErrInvalidType,
+		},
+		{
+			"failure: unspecified packet type",
+			func() {
+				packetType = icatypes.UNSPECIFIED
+			},
+			types.ExecutionResult{},
+			icatypes.ErrUnknownDataType,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			packetType = icatypes.EXECUTE_TX
+			acknowledgement = channeltypes.NewResultAcknowledgement(txMsgDataBz).Acknowledgement()
+
+			tc.malleate()
+
+			result, err := types.NewExecutionResult(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix+"owner", ibctesting.FirstChannelID, 1, packetType, acknowledgement, 10)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+
+				expResult := tc.expResult
+				expResult.ConnectionId = ibctesting.FirstConnectionID
+				expResult.PortId = icatypes.ControllerPortPrefix + "owner"
+				expResult.ChannelId = ibctesting.FirstChannelID
+				expResult.Sequence = 1
+				expResult.Height = 10
+
+				require.Equal(t, expResult, result)
+				require.NoError(t, result.Validate())
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// SubModuleName defines the interchain accounts controller module name
 	SubModuleName = "icacontroller"
@@ -9,4 +15,72 @@ const (
 
 	// ParamsKey is the store key for the interchain accounts controller parameters
 	ParamsKey = "params"
+
+	// ExecutionResultKeyPrefix defines the key prefix used to store execution results
+	ExecutionResultKeyPrefix = "executionResult"
+
+	// PendingTxKeyPrefix defines the key prefix used to store pending transactions
+	PendingTxKeyPrefix = "pendingTx"
+
+	// ReopenChannelKeyPrefix defines the key prefix used to flag interchain accounts whose channel is reopened
+	// at the end of the block
+	ReopenChannelKeyPrefix = "reopenChannel"
+
+	// FlushPendingTxsKeyPrefix defines the key prefix used to flag interchain accounts whose pending transactions
+	// are sent at the end of the block
+	FlushPendingTxsKeyPrefix = "flushPendingTxs"
+
+	// AccountOwnershipKeyPrefix defines the key prefix used to store the ownership of transferred interchain accounts
+	AccountOwnershipKeyPrefix = "accountOwnership"
+
+	// OwnedAccountKeyPrefix defines the key prefix used to index transferred interchain accounts by their new owner
+	OwnedAccountKeyPrefix = "ownedAccount"
 )
+
+// KeyExecutionResultPrefix creates and returns the key prefix under which the execution results of the interchain
+// account associated with the provided connection and port identifiers are stored
+func KeyExecutionResultPrefix(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ExecutionResultKeyPrefix, connectionID, portID))
+}
+
+// KeyExecutionResult creates and returns a new key used to store the execution result of the packet with the
+// provided sequence. The sequence is big endian encoded so that results are iterated in sequence order
+func KeyExecutionResult(connectionID, portID string, sequence uint64) []byte {
+	return append(KeyExecutionResultPrefix(connectionID, portID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyPendingTxPrefix creates and returns the key prefix under which the pending transactions of the interchain account
+// associated with the provided connection and port identifiers are stored
+func KeyPendingTxPrefix(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", PendingTxKeyPrefix, connectionID, portID))
+}
+
+// KeyPendingTx creates and returns a new key used to store the pending transaction with the provided queue index.
+// The index is big endian encoded so that pending transactions are iterated in queue order
+func KeyPendingTx(connectionID, portID string, index uint64) []byte {
+	return append(KeyPendingTxPrefix(connectionID, portID), sdk.Uint64ToBigEndian(index)...)
+}
+
+// KeyReopenChannel creates and returns a new key used to flag the interchain account associated with the provided
+// connection and port identifiers for channel reopening
+func KeyReopenChannel(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ReopenChannelKeyPrefix, connectionID, portID))
+}
+
+// KeyFlushPendingTxs creates and returns a new key used to flag the interchain account associated with the provided
+// connection and port identifiers for sending its pending transactions
+func KeyFlushPendingTxs(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", FlushPendingTxsKeyPrefix, connectionID, portID))
+}
+
+// KeyAccountOwnership creates and returns a new key used to store the ownership of the interchain account associated
+// with the provided connection and port identifiers
+func KeyAccountOwnership(connectionID, portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AccountOwnershipKeyPrefix, connectionID, portID))
+}
+
+// KeyOwnedAccount creates and returns a new key used to store the port identifier of the interchain account controlled
+// over the provided connection by the owner and account index from which the provided owner port identifier is built
+func KeyOwnedAccount(connectionID, ownerPortID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", OwnedAccountKeyPrefix, connectionID, ownerPortID))
+}
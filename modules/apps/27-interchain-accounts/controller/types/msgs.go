@@ -19,10 +19,14 @@ const MaximumOwnerLength = 2048 // maximum length of the owner in bytes (value c
 var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
+	_ sdk.Msg = (*MsgReopenChannel)(nil)
+	_ sdk.Msg = (*MsgTransferAccountOwnership)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
+	_ sdk.HasValidateBasic = (*MsgReopenChannel)(nil)
+	_ sdk.HasValidateBasic = (*MsgTransferAccountOwnership)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
 
@@ -92,6 +96,69 @@ func (msg MsgSendTx) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgReopenChannel creates a new instance of MsgReopenChannel
+func NewMsgReopenChannel(owner, connectionID string) *MsgReopenChannel {
+	return &MsgReopenChannel{
+		Owner:        owner,
+		ConnectionId: connectionID,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReopenChannel) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	return nil
+}
+
+// NewMsgTransferAccountOwnership creates a new instance of MsgTransferAccountOwnership
+func NewMsgTransferAccountOwnership(owner, connectionID, newOwner string) *MsgTransferAccountOwnership {
+	return &MsgTransferAccountOwnership{
+		Owner:        owner,
+		ConnectionId: connectionID,
+		NewOwner:     newOwner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgTransferAccountOwnership) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if strings.TrimSpace(msg.NewOwner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "new owner address cannot be empty")
+	}
+
+	if len(msg.NewOwner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "new owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	if msg.Owner == msg.NewOwner && msg.AccountIndex == msg.NewAccountIndex {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "new owner and account index must differ from the current owner and account index")
+	}
+
+	return nil
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
 func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
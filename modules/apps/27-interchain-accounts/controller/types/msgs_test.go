@@ -231,6 +231,170 @@ func TestMsgSendTxGetSigners(t *testing.T) {
 	require.Equal(t, expSigner.Bytes(), signers[0])
 }
 
+func TestMsgReopenChannelValidateBasic(t *testing.T) {
+	var msg *types.MsgReopenChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: non-zero account index",
+			func() {
+				msg.AccountIndex = 1
+			},
+			true,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msg = types.NewMsgReopenChannel(ibctesting.TestAccAddress, ibctesting.FirstConnectionID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgReopenChannelGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgReopenChannel(ibctesting.TestAccAddress, ibctesting.FirstConnectionID)
+
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+	signers, _, err := encodingConfig.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, expSigner.Bytes(), signers[0])
+}
+
+func TestMsgTransferAccountOwnershipValidateBasic(t *testing.T) {
+	var msg *types.MsgTransferAccountOwnership
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: transfer to another account index of the same owner",
+			func() {
+				msg.NewOwner = msg.Owner
+				msg.NewAccountIndex = 1
+			},
+			true,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+		{
+			"new owner address is empty",
+			func() {
+				msg.NewOwner = ""
+			},
+			false,
+		},
+		{
+			"new owner address is too long",
+			func() {
+				msg.NewOwner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+		{
+			"new owner and account index are unchanged",
+			func() {
+				msg.NewOwner = msg.Owner
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msg = types.NewMsgTransferAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "new-owner")
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgTransferAccountOwnershipGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgTransferAccountOwnership(ibctesting.TestAccAddress, ibctesting.FirstConnectionID, "new-owner")
+
+	encodingConfig := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+	signers, _, err := encodingConfig.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, expSigner.Bytes(), signers[0])
+}
+
 // TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
 func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	testCases := []struct {
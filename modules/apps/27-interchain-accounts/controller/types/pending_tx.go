@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewPendingTx creates a new PendingTx instance
+func NewPendingTx(connectionID, portID string, index uint64, packetData icatypes.InterchainAccountPacketData, relativeTimeout uint64) PendingTx {
+	return PendingTx{
+		ConnectionId:    connectionID,
+		PortId:          portID,
+		Index:           index,
+		PacketData:      packetData,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+// Validate performs basic validation of the PendingTx
+func (p PendingTx) Validate() error {
+	if err := host.ConnectionIdentifierValidator(p.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return err
+	}
+
+	if err := p.PacketData.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if p.RelativeTimeout == 0 {
+		return fmt.Errorf("pending transaction relative timeout cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// DeserializeQueryAcknowledgement decodes the acknowledgement of an interchain accounts query packet into the
+// query responses returned by the host chain. An error is returned if the acknowledgement is an error
+// acknowledgement or if its result cannot be decoded.
+func DeserializeQueryAcknowledgement(acknowledgement []byte) (icatypes.CosmosQueryResponse, error) {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return icatypes.CosmosQueryResponse{}, errorsmod.Wrapf(ErrInvalidQueryAcknowledgement, "cannot unmarshal acknowledgement: %v", err)
+	}
+
+	if !ack.Success() {
+		return icatypes.CosmosQueryResponse{}, errorsmod.Wrapf(ErrInvalidQueryAcknowledgement, "query packet failed on host chain: %s", ack.GetError())
+	}
+
+	var queryResponse icatypes.CosmosQueryResponse
+	if err := proto.Unmarshal(ack.GetResult(), &queryResponse); err != nil {
+		return icatypes.CosmosQueryResponse{}, errorsmod.Wrapf(ErrInvalidQueryAcknowledgement, "cannot unmarshal CosmosQueryResponse: %v", err)
+	}
+
+	return queryResponse, nil
+}
+
+// UnmarshalQueryResponses unmarshals the query responses into the provided response types. The response types
+// must be provided in the same order as the query requests sent to the host chain.
+func UnmarshalQueryResponses(cdc codec.BinaryCodec, queryResponse icatypes.CosmosQueryResponse, responses ...proto.Message) error {
+	if len(responses) != len(queryResponse.Responses) {
+		return errorsmod.Wrapf(ErrInvalidQueryAcknowledgement, "expected %d response types, got %d", len(queryResponse.Responses), len(responses))
+	}
+
+	for i, response := range responses {
+		if err := cdc.Unmarshal(queryResponse.Responses[i], response); err != nil {
+			return errorsmod.Wrapf(ErrInvalidQueryAcknowledgement, "cannot unmarshal query response at index %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+func TestDeserializeQueryAcknowledgement(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	balance := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	balanceResponse, err := cdc.Marshal(&banktypes.QueryBalanceResponse{Balance: &balance})
+	require.NoError(t, err)
+
+	queryResponse := icatypes.CosmosQueryResponse{
+		Responses: [][]byte{balanceResponse},
+		Height:    10,
+	}
+
+	queryResponseBz, err := proto.Marshal(&queryResponse)
+	require.NoError(t, err)
+
+	var acknowledgement []byte
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: error acknowledgement",
+			func() {
+				acknowledgement = channeltypes.NewErrorAcknowledgement(ibcerrors.ErrUnauthorized).Acknowledgement()
+			},
+			types.ErrInvalidQueryAcknowledgement,
+		},
+		{
+			"failure: acknowledgement is not valid json",
+			func() {
+				acknowledgement = []byte("invalid")
+			},
+			types.ErrInvalidQueryAcknowledgement,
+		},
+		{
+			"failure: result is not a query response",
+			func() {
+				acknowledgement = channeltypes.NewResultAcknowledgement([]byte("invalid")).Acknowledgement()
+			},
+			types.ErrInvalidQueryAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			acknowledgement = channeltypes.NewResultAcknowledgement(queryResponseBz).Acknowledgement()
+
+			tc.malleate()
+
+			res, err := types.DeserializeQueryAcknowledgement(acknowledgement)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, queryResponse, res)
+
+				var response banktypes.QueryBalanceResponse
+				require.NoError(t, types.UnmarshalQueryResponses(cdc, res, &response))
+				require.Equal(t, balance, *response.Balance)
+
+				// the number of response types must match the number of query responses
+				err = types.UnmarshalQueryResponses(cdc, res, &response, &response)
+				require.ErrorIs(t, err, types.ErrInvalidQueryAcknowledgement)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
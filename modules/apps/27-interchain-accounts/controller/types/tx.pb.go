@@ -37,6 +37,10 @@ type MsgRegisterInterchainAccount struct {
 	ConnectionId string      `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
 	Version      string      `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
 	Ordering     types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// account_index optionally distinguishes multiple interchain accounts of the same owner. It is included in the
+	// controller port identifier, from which the interchain account address is derived. The zero value refers to the
+	// owner's default interchain account.
+	AccountIndex uint64 `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -119,6 +123,8 @@ type MsgSendTx struct {
 	// Relative timeout timestamp provided will be added to the current block time during transaction execution.
 	// The timeout timestamp must be non-zero.
 	RelativeTimeout uint64 `protobuf:"varint,4,opt,name=relative_timeout,json=relativeTimeout,proto3" json:"relative_timeout,omitempty"`
+	// account_index identifies the interchain account of the owner the packet is sent from.
+	AccountIndex uint64 `protobuf:"varint,5,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
 }
 
 func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
@@ -157,6 +163,9 @@ var xxx_messageInfo_MsgSendTx proto.InternalMessageInfo
 // MsgSendTxResponse defines the response for MsgSendTx
 type MsgSendTxResponse struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// queued is true if the packet data was added to the pending transaction queue of the interchain account as its
+	// channel is closed, in which case no packet has been sent.
+	Queued bool `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
 }
 
 func (m *MsgSendTxResponse) Reset()         { *m = MsgSendTxResponse{} }
@@ -192,6 +201,170 @@ func (m *MsgSendTxResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSendTxResponse proto.InternalMessageInfo
 
+// MsgReopenChannel defines the payload for Msg/ReopenChannel
+type MsgReopenChannel struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// account_index identifies the interchain account of the owner whose channel is reopened.
+	AccountIndex uint64 `protobuf:"varint,3,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+}
+
+func (m *MsgReopenChannel) Reset()         { *m = MsgReopenChannel{} }
+func (m *MsgReopenChannel) String() string { return proto.CompactTextString(m) }
+func (*MsgReopenChannel) ProtoMessage()    {}
+func (*MsgReopenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{4}
+}
+func (m *MsgReopenChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReopenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReopenChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReopenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReopenChannel.Merge(m, src)
+}
+func (m *MsgReopenChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReopenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReopenChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReopenChannel proto.InternalMessageInfo
+
+// MsgReopenChannelResponse defines the response for Msg/ReopenChannel
+type MsgReopenChannelResponse struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgReopenChannelResponse) Reset()         { *m = MsgReopenChannelResponse{} }
+func (m *MsgReopenChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReopenChannelResponse) ProtoMessage()    {}
+func (*MsgReopenChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{5}
+}
+func (m *MsgReopenChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReopenChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReopenChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReopenChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReopenChannelResponse.Merge(m, src)
+}
+func (m *MsgReopenChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReopenChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReopenChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReopenChannelResponse proto.InternalMessageInfo
+
+// MsgTransferAccountOwnership defines the payload for Msg/TransferAccountOwnership
+type MsgTransferAccountOwnership struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// account_index identifies the interchain account of the owner whose ownership is transferred.
+	AccountIndex uint64 `protobuf:"varint,3,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	// new_owner defines the owner controlling the interchain account after the transfer.
+	NewOwner string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// new_account_index defines the account index under which the new owner controls the interchain account.
+	NewAccountIndex uint64 `protobuf:"varint,5,opt,name=new_account_index,json=newAccountIndex,proto3" json:"new_account_index,omitempty"`
+}
+
+func (m *MsgTransferAccountOwnership) Reset()         { *m = MsgTransferAccountOwnership{} }
+func (m *MsgTransferAccountOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAccountOwnership) ProtoMessage()    {}
+func (*MsgTransferAccountOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{6}
+}
+func (m *MsgTransferAccountOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAccountOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAccountOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAccountOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAccountOwnership.Merge(m, src)
+}
+func (m *MsgTransferAccountOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAccountOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAccountOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAccountOwnership proto.InternalMessageInfo
+
+// MsgTransferAccountOwnershipResponse defines the response for Msg/TransferAccountOwnership
+type MsgTransferAccountOwnershipResponse struct {
+	// port_id defines the controller port identifier of the interchain account, which is unchanged by the transfer.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgTransferAccountOwnershipResponse) Reset()         { *m = MsgTransferAccountOwnershipResponse{} }
+func (m *MsgTransferAccountOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferAccountOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferAccountOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{7}
+}
+func (m *MsgTransferAccountOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferAccountOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferAccountOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferAccountOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferAccountOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferAccountOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferAccountOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferAccountOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferAccountOwnershipResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines the payload for Msg/UpdateParams
 type MsgUpdateParams struct {
 	// signer address
@@ -206,7 +379,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{4}
+	return fileDescriptor_7def041328c84a30, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +416,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{5}
+	return fileDescriptor_7def041328c84a30, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +450,10 @@ func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
 	proto.RegisterType((*MsgSendTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTx")
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
+	proto.RegisterType((*MsgReopenChannel)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgReopenChannel")
+	proto.RegisterType((*MsgReopenChannelResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgReopenChannelResponse")
+	proto.RegisterType((*MsgTransferAccountOwnership)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferAccountOwnership")
+	proto.RegisterType((*MsgTransferAccountOwnershipResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgTransferAccountOwnershipResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParamsResponse")
 }
@@ -286,49 +463,60 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xdf, 0xd4, 0x78, 0x10, 0xf0, 0xd0, 0x98, 0xc6, 0x66, 0xc5, 0x84, 0x78,
-	0x69, 0xa6, 0xb3, 0x93, 0x61, 0xa4, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xbc, 0x19, 0x4f, 0xc6, 0x83,
-	0xf1, 0xe0, 0x07, 0xe0, 0x23, 0x70, 0xf7, 0x03, 0xc8, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0xc0, 0xcd,
-	0xcf, 0x60, 0xf6, 0x4f, 0xb7, 0x28, 0x48, 0xb0, 0x70, 0xdb, 0xdf, 0x6f, 0xe6, 0x79, 0x7e, 0xcf,
-	0xf3, 0xcc, 0xec, 0x80, 0xfb, 0xbc, 0x4d, 0x10, 0x0e, 0x82, 0x0e, 0x27, 0xd8, 0x70, 0x29, 0x34,
-	0xe2, 0xc2, 0x50, 0x45, 0x36, 0x30, 0x17, 0x2d, 0x4c, 0x88, 0xec, 0x0a, 0xa3, 0x11, 0x91, 0xc2,
-	0x28, 0xd9, 0xe9, 0x50, 0x85, 0xc2, 0x0a, 0x32, 0x5b, 0x30, 0x50, 0xd2, 0x48, 0xbb, 0xca, 0xdb,
-	0x04, 0x1e, 0x05, 0xc3, 0x13, 0xc0, 0xb0, 0x0f, 0x86, 0x61, 0xc5, 0x99, 0x66, 0x92, 0xc9, 0x18,
-	0x8e, 0xa2, 0xaf, 0x84, 0xc9, 0xb9, 0x75, 0x26, 0x19, 0x61, 0x05, 0x05, 0x98, 0x6c, 0x52, 0x93,
-	0xa2, 0x56, 0x06, 0x10, 0x7f, 0x44, 0x4d, 0x42, 0x32, 0x43, 0xa4, 0xf6, 0xa5, 0x46, 0xbe, 0x66,
-	0xd1, 0xba, 0xaf, 0x59, 0xba, 0x70, 0x35, 0x62, 0x27, 0x52, 0x51, 0x44, 0x36, 0xb0, 0x10, 0xb4,
-	0x13, 0xc3, 0x93, 0xcf, 0x64, 0x4b, 0xf9, 0xb3, 0x05, 0x2e, 0x37, 0x34, 0x73, 0x29, 0xe3, 0xda,
-	0x50, 0x55, 0xcf, 0xa6, 0x3f, 0x4c, 0x86, 0xdb, 0xd3, 0x60, 0x58, 0xbe, 0x12, 0x54, 0x15, 0xad,
-	0x79, 0x6b, 0x61, 0xcc, 0x4d, 0x0a, 0xfb, 0x1a, 0xf8, 0x97, 0x48, 0x21, 0x28, 0x89, 0x44, 0xb7,
-	0xb8, 0x57, 0xfc, 0x27, 0x5e, 0x9d, 0xe8, 0x37, 0xeb, 0x9e, 0x5d, 0x04, 0x23, 0x21, 0x55, 0x9a,
-	0x4b, 0x51, 0x1c, 0x8a, 0x97, 0x7b, 0xa5, 0x7d, 0x07, 0x8c, 0x4a, 0xe5, 0x51, 0xc5, 0x05, 0x2b,
-	0xe6, 0xe7, 0xad, 0x85, 0xc9, 0xaa, 0x03, 0xa3, 0x93, 0x88, 0xb4, 0xc2, 0x9e, 0xc0, 0xb0, 0x02,
-	0x9f, 0x44, 0x9b, 0xdc, 0x6c, 0x6f, 0x6d, 0xf2, 0xdd, 0xf6, 0x5c, 0xee, 0xed, 0xe1, 0xce, 0x62,
-	0x22, 0xa3, 0xec, 0x81, 0xeb, 0xa7, 0x89, 0x77, 0xa9, 0x0e, 0xa4, 0xd0, 0xd4, 0xbe, 0x02, 0x40,
-	0xca, 0x1a, 0x69, 0x4d, 0x9c, 0x8c, 0xa5, 0x9d, 0xba, 0x67, 0xcf, 0x80, 0x91, 0x40, 0x2a, 0xd3,
-	0xf7, 0x51, 0x88, 0xca, 0xba, 0x57, 0xcb, 0x47, 0xf3, 0xca, 0x3f, 0x2c, 0x30, 0xd6, 0xd0, 0xec,
-	0x29, 0x15, 0xde, 0xda, 0xd6, 0x79, 0x02, 0xd9, 0x04, 0xe3, 0xc9, 0xe9, 0xb7, 0x3c, 0x6c, 0x70,
-	0x1c, 0xca, 0x78, 0x75, 0x15, 0x9e, 0xe9, 0x0e, 0x86, 0x15, 0x78, 0xcc, 0x5f, 0x33, 0x26, 0x5b,
-	0xc5, 0x06, 0x2f, 0xe7, 0x77, 0xbf, 0xcd, 0xe5, 0x5c, 0x10, 0x64, 0x1d, 0xfb, 0x06, 0xf8, 0x4f,
-	0xd1, 0x0e, 0x36, 0x3c, 0xa4, 0x2d, 0xc3, 0x7d, 0x2a, 0xbb, 0x26, 0xce, 0x3a, 0xef, 0x4e, 0xf5,
-	0xfa, 0x6b, 0x49, 0xfb, 0x58, 0xac, 0xb7, 0xc1, 0xff, 0x99, 0xdf, 0x2c, 0x43, 0x07, 0x8c, 0x6a,
-	0xfa, 0xb2, 0x4b, 0x05, 0xa1, 0xb1, 0xf5, 0xbc, 0x9b, 0xd5, 0x69, 0x4e, 0x9f, 0x2c, 0x30, 0xd5,
-	0xd0, 0xec, 0x59, 0xe0, 0x61, 0x43, 0x9b, 0x58, 0x61, 0x5f, 0xdb, 0x97, 0x40, 0x41, 0x73, 0xd6,
-	0x8f, 0x2b, 0xad, 0xec, 0x75, 0x50, 0x08, 0xe2, 0x1d, 0x71, 0x50, 0xe3, 0xd5, 0x1a, 0xfc, 0xfb,
-	0x3f, 0x11, 0x26, 0x33, 0x52, 0xef, 0x29, 0x5f, 0x6d, 0xaa, 0x67, 0x26, 0x1d, 0x55, 0x9e, 0x05,
-	0x33, 0xbf, 0xa9, 0xea, 0x79, 0xaa, 0xbe, 0xcf, 0x83, 0xa1, 0x86, 0x66, 0xf6, 0x17, 0x0b, 0xcc,
-	0xfe, 0xf9, 0x17, 0x68, 0x0e, 0xa2, 0xed, 0xb4, 0x7b, 0xe9, 0xac, 0x5f, 0x34, 0x63, 0x76, 0x4a,
-	0x1f, 0x2c, 0x50, 0x48, 0x2f, 0xea, 0x83, 0x01, 0x87, 0x24, 0x70, 0xe7, 0xd1, 0xb9, 0xe0, 0x99,
-	0xa0, 0x6d, 0x0b, 0x4c, 0xfc, 0x72, 0x23, 0x56, 0x06, 0xe4, 0x3d, 0x4a, 0xe2, 0x3c, 0xbe, 0x00,
-	0x92, 0x9e, 0x44, 0x67, 0xf8, 0xcd, 0xe1, 0xce, 0xa2, 0xb5, 0xfc, 0x62, 0x77, 0xbf, 0x64, 0xed,
-	0xed, 0x97, 0xac, 0xef, 0xfb, 0x25, 0xeb, 0xe3, 0x41, 0x29, 0xb7, 0x77, 0x50, 0xca, 0x7d, 0x3d,
-	0x28, 0xe5, 0x9e, 0x37, 0x19, 0x37, 0x1b, 0xdd, 0x36, 0x24, 0xd2, 0x47, 0xe9, 0x5b, 0xcb, 0xdb,
-	0x64, 0x89, 0x49, 0x14, 0xde, 0x43, 0xbe, 0xf4, 0xba, 0x1d, 0xaa, 0xa3, 0x57, 0x5c, 0xa3, 0xea,
-	0xdd, 0xa5, 0xbe, 0x8e, 0xa5, 0x93, 0x1e, 0x70, 0xf3, 0x3a, 0xa0, 0xba, 0x5d, 0x88, 0x5f, 0xdf,
-	0x9b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x6a, 0x51, 0xfa, 0xbd, 0x06, 0x00, 0x00,
+	// 839 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x34, 0x8e, 0x6b, 0xbf, 0xa4, 0x75, 0xbb, 0xaa, 0xc8, 0x76, 0x0b, 0x6e, 0x70, 0x39,
+	0x84, 0x48, 0xd9, 0x95, 0x0d, 0x02, 0x64, 0xc4, 0xa1, 0x8d, 0x39, 0x58, 0x60, 0xc5, 0x5a, 0x82,
+	0x88, 0xb8, 0x58, 0xeb, 0xd9, 0x61, 0x3d, 0xd4, 0x9e, 0xd9, 0xee, 0x8c, 0xd7, 0xe1, 0x86, 0x7a,
+	0xe2, 0x84, 0x90, 0xe0, 0x07, 0x54, 0xe2, 0x0f, 0xf4, 0x47, 0x20, 0xd1, 0x63, 0x4f, 0x88, 0x13,
+	0xaa, 0x92, 0x43, 0x7f, 0x02, 0x57, 0x34, 0xbb, 0xe3, 0xb5, 0xdb, 0x38, 0x56, 0x70, 0xdc, 0xdb,
+	0xbe, 0x37, 0xf3, 0xbe, 0xf7, 0x7d, 0xdf, 0x9b, 0x1d, 0x0d, 0x7c, 0x4a, 0x7b, 0xd8, 0xf1, 0xc2,
+	0x70, 0x40, 0xb1, 0x27, 0x29, 0x67, 0xc2, 0xa1, 0x4c, 0x92, 0x08, 0xf7, 0x3d, 0xca, 0xba, 0x1e,
+	0xc6, 0x7c, 0xc4, 0xa4, 0x70, 0x30, 0x67, 0x32, 0xe2, 0x83, 0x01, 0x89, 0x9c, 0xb8, 0xe6, 0xc8,
+	0x63, 0x3b, 0x8c, 0xb8, 0xe4, 0x46, 0x9d, 0xf6, 0xb0, 0x3d, 0x5b, 0x6c, 0xcf, 0x29, 0xb6, 0xa7,
+	0xc5, 0x76, 0x5c, 0xb3, 0x6e, 0x05, 0x3c, 0xe0, 0x49, 0xb9, 0xa3, 0xbe, 0x52, 0x24, 0xeb, 0xc3,
+	0x0b, 0xd1, 0x88, 0x6b, 0x4e, 0xe8, 0xe1, 0x87, 0x44, 0xea, 0xaa, 0xfd, 0x25, 0xc8, 0x4f, 0x23,
+	0x0d, 0xb2, 0x85, 0xb9, 0x18, 0x72, 0xe1, 0x0c, 0x45, 0xa0, 0xd6, 0x87, 0x22, 0xd0, 0x0b, 0xef,
+	0x2a, 0x74, 0xcc, 0x23, 0xe2, 0xe0, 0xbe, 0xc7, 0x18, 0x19, 0x24, 0xe5, 0xe9, 0x67, 0xba, 0xa5,
+	0xfa, 0x02, 0xc1, 0xdb, 0x6d, 0x11, 0xb8, 0x24, 0xa0, 0x42, 0x92, 0xa8, 0x95, 0x75, 0xbf, 0x9f,
+	0x36, 0x37, 0x6e, 0xc1, 0x3a, 0x1f, 0x33, 0x12, 0x99, 0x68, 0x1b, 0xed, 0x94, 0xdc, 0x34, 0x30,
+	0xee, 0xc1, 0x35, 0xcc, 0x19, 0x23, 0x58, 0x91, 0xee, 0x52, 0xdf, 0xbc, 0x92, 0xac, 0x6e, 0x4e,
+	0x93, 0x2d, 0xdf, 0x30, 0xe1, 0x6a, 0x4c, 0x22, 0x41, 0x39, 0x33, 0xd7, 0x92, 0xe5, 0x49, 0x68,
+	0x7c, 0x04, 0x45, 0x1e, 0xf9, 0x24, 0xa2, 0x2c, 0x30, 0xf3, 0xdb, 0x68, 0xe7, 0x7a, 0xdd, 0xb2,
+	0xd5, 0x24, 0x14, 0x57, 0x7b, 0x42, 0x30, 0xae, 0xd9, 0x07, 0x6a, 0x93, 0x9b, 0xed, 0x55, 0x6d,
+	0xb5, 0x29, 0x5d, 0xca, 0x7c, 0x72, 0x6c, 0xae, 0x6f, 0xa3, 0x9d, 0xbc, 0xbb, 0xa9, 0x93, 0x2d,
+	0x95, 0x6b, 0x5c, 0xff, 0xe9, 0xc9, 0xdd, 0xdc, 0xe3, 0x97, 0x4f, 0x77, 0x53, 0xae, 0x55, 0x1f,
+	0xde, 0x5b, 0xa4, 0xd0, 0x25, 0x22, 0xe4, 0x4c, 0x10, 0xe3, 0x1d, 0x00, 0xdd, 0x5a, 0x09, 0x4a,
+	0xe5, 0x96, 0x74, 0xa6, 0xe5, 0x1b, 0x5b, 0x70, 0x35, 0xe4, 0x91, 0x9c, 0x8a, 0x2d, 0xa8, 0xb0,
+	0xe5, 0x37, 0xf2, 0xaa, 0x5f, 0xf5, 0xd7, 0x2b, 0x50, 0x6a, 0x8b, 0xe0, 0x2b, 0xc2, 0xfc, 0xc3,
+	0xe3, 0xcb, 0xb8, 0xf6, 0x10, 0x36, 0xd2, 0x23, 0xd2, 0xf5, 0x3d, 0xe9, 0x25, 0xce, 0x6d, 0xd4,
+	0x9b, 0xf6, 0x85, 0x0e, 0x6a, 0x5c, 0xb3, 0xcf, 0xe8, 0xeb, 0x24, 0x60, 0x4d, 0x4f, 0x7a, 0x0f,
+	0xf2, 0xcf, 0xfe, 0xb9, 0x9b, 0x73, 0x21, 0xcc, 0x32, 0xc6, 0xfb, 0x70, 0x23, 0x22, 0x03, 0x4f,
+	0xd2, 0x98, 0x74, 0x25, 0x1d, 0x12, 0x3e, 0x92, 0xc9, 0x40, 0xf2, 0x6e, 0x79, 0x92, 0x3f, 0x4c,
+	0xd3, 0xcb, 0x79, 0xdf, 0x86, 0x9b, 0x99, 0x29, 0x99, 0xd1, 0x16, 0x14, 0x05, 0x79, 0x34, 0x22,
+	0x0c, 0x93, 0xc4, 0x9f, 0xbc, 0x9b, 0xc5, 0xc6, 0x5b, 0x50, 0x78, 0x34, 0x22, 0x23, 0x92, 0x7a,
+	0x53, 0x74, 0x75, 0xa4, 0x4d, 0x7e, 0x8c, 0xe0, 0x46, 0x32, 0x4b, 0x1e, 0x12, 0xb6, 0x9f, 0x8e,
+	0xe6, 0x32, 0x5e, 0x9f, 0xd1, 0xb4, 0x76, 0x01, 0x4d, 0x47, 0x60, 0xbe, 0xce, 0x61, 0x45, 0x67,
+	0xe8, 0x2f, 0x04, 0x77, 0xda, 0x22, 0x38, 0x8c, 0x3c, 0x26, 0xbe, 0x23, 0x91, 0x1e, 0xe0, 0x81,
+	0xea, 0x2a, 0xfa, 0x34, 0x7c, 0xd3, 0x4a, 0x8d, 0x3b, 0x50, 0x62, 0x64, 0xdc, 0x4d, 0x7b, 0xe4,
+	0x13, 0x94, 0x22, 0x23, 0xe3, 0x84, 0x80, 0xb1, 0x0b, 0x37, 0xd5, 0xe2, 0xbc, 0x33, 0x50, 0x66,
+	0x64, 0x7c, 0x7f, 0x91, 0x65, 0x4d, 0xb8, 0xb7, 0x40, 0x57, 0xe6, 0xde, 0x8c, 0x3d, 0x68, 0x8e,
+	0x3d, 0xbf, 0x21, 0x28, 0xb7, 0x45, 0xf0, 0x75, 0xe8, 0x7b, 0x92, 0x74, 0xbc, 0xc8, 0x1b, 0x0a,
+	0x75, 0x5e, 0x04, 0x0d, 0xa6, 0x9e, 0xe8, 0xc8, 0x38, 0x82, 0x42, 0x98, 0xec, 0x48, 0xdc, 0xd8,
+	0xa8, 0x37, 0xec, 0xff, 0x7f, 0xd3, 0xdb, 0x69, 0x0f, 0xfd, 0xdb, 0x68, 0xbc, 0x46, 0x79, 0xa2,
+	0x4d, 0xb7, 0xaa, 0xde, 0x86, 0xad, 0xd7, 0x58, 0x4d, 0x04, 0xd5, 0xff, 0x2d, 0xc0, 0x5a, 0x5b,
+	0x04, 0xc6, 0x9f, 0x08, 0x6e, 0x9f, 0x7f, 0xc5, 0x76, 0x96, 0xe1, 0xb6, 0xe8, 0x4a, 0xb3, 0x8e,
+	0x56, 0x8d, 0x98, 0x8d, 0xe8, 0x67, 0x04, 0x05, 0x7d, 0xc7, 0x7d, 0xb6, 0x64, 0x93, 0xb4, 0xdc,
+	0xfa, 0xfc, 0x52, 0xe5, 0x19, 0xa1, 0xdf, 0x11, 0x5c, 0x7b, 0xf5, 0x3e, 0x68, 0x2e, 0x2d, 0x7e,
+	0x06, 0xc5, 0xfa, 0x72, 0x15, 0x28, 0x19, 0xcb, 0x3f, 0x10, 0x98, 0xe7, 0xfe, 0xd6, 0x07, 0x4b,
+	0xb6, 0x3a, 0x0f, 0xd0, 0xfa, 0x66, 0xc5, 0x80, 0x99, 0x8c, 0x27, 0x08, 0x36, 0x5f, 0xf9, 0xfd,
+	0xf6, 0x97, 0xec, 0x34, 0x0b, 0x62, 0x7d, 0xb1, 0x02, 0x90, 0x09, 0x45, 0x6b, 0xfd, 0xc7, 0x97,
+	0x4f, 0x77, 0xd1, 0x83, 0xef, 0x9f, 0x9d, 0x54, 0xd0, 0xf3, 0x93, 0x0a, 0x7a, 0x71, 0x52, 0x41,
+	0xbf, 0x9c, 0x56, 0x72, 0xcf, 0x4f, 0x2b, 0xb9, 0xbf, 0x4f, 0x2b, 0xb9, 0x6f, 0x3b, 0x01, 0x95,
+	0xfd, 0x51, 0xcf, 0xc6, 0x7c, 0xe8, 0xe8, 0x87, 0x13, 0xed, 0xe1, 0xbd, 0x80, 0x3b, 0xf1, 0x27,
+	0xce, 0x90, 0xfb, 0xa3, 0x01, 0x11, 0xea, 0x49, 0x26, 0x9c, 0xfa, 0xc7, 0x7b, 0x53, 0x1e, 0x7b,
+	0xf3, 0x5e, 0x63, 0xf2, 0x87, 0x90, 0x88, 0x5e, 0x21, 0x79, 0x4a, 0x7d, 0xf0, 0xdf, 0x00, 0x23,
+	0x0e, 0xb6, 0xd8, 0x8a, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -347,6 +535,10 @@ type MsgClient interface {
 	RegisterInterchainAccount(ctx context.Context, in *MsgRegisterInterchainAccount, opts ...grpc.CallOption) (*MsgRegisterInterchainAccountResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
+	// ReopenChannel defines a rpc handler for MsgReopenChannel.
+	ReopenChannel(ctx context.Context, in *MsgReopenChannel, opts ...grpc.CallOption) (*MsgReopenChannelResponse, error)
+	// TransferAccountOwnership defines a rpc handler for MsgTransferAccountOwnership.
+	TransferAccountOwnership(ctx context.Context, in *MsgTransferAccountOwnership, opts ...grpc.CallOption) (*MsgTransferAccountOwnershipResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -377,6 +569,24 @@ func (c *msgClient) SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ReopenChannel(ctx context.Context, in *MsgReopenChannel, opts ...grpc.CallOption) (*MsgReopenChannelResponse, error) {
+	out := new(MsgReopenChannelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/ReopenChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferAccountOwnership(ctx context.Context, in *MsgTransferAccountOwnership, opts ...grpc.CallOption) (*MsgTransferAccountOwnershipResponse, error) {
+	out := new(MsgTransferAccountOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferAccountOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/UpdateParams", in, out, opts...)
@@ -392,6 +602,10 @@ type MsgServer interface {
 	RegisterInterchainAccount(context.Context, *MsgRegisterInterchainAccount) (*MsgRegisterInterchainAccountResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
+	// ReopenChannel defines a rpc handler for MsgReopenChannel.
+	ReopenChannel(context.Context, *MsgReopenChannel) (*MsgReopenChannelResponse, error)
+	// TransferAccountOwnership defines a rpc handler for MsgTransferAccountOwnership.
+	TransferAccountOwnership(context.Context, *MsgTransferAccountOwnership) (*MsgTransferAccountOwnershipResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -406,6 +620,12 @@ func (*UnimplementedMsgServer) RegisterInterchainAccount(ctx context.Context, re
 func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*MsgSendTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTx not implemented")
 }
+func (*UnimplementedMsgServer) ReopenChannel(ctx context.Context, req *MsgReopenChannel) (*MsgReopenChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenChannel not implemented")
+}
+func (*UnimplementedMsgServer) TransferAccountOwnership(ctx context.Context, req *MsgTransferAccountOwnership) (*MsgTransferAccountOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAccountOwnership not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -450,6 +670,42 @@ func _Msg_SendTx_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReopenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReopenChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReopenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/ReopenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReopenChannel(ctx, req.(*MsgReopenChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferAccountOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferAccountOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferAccountOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/TransferAccountOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferAccountOwnership(ctx, req.(*MsgTransferAccountOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -480,6 +736,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendTx",
 			Handler:    _Msg_SendTx_Handler,
 		},
+		{
+			MethodName: "ReopenChannel",
+			Handler:    _Msg_ReopenChannel_Handler,
+		},
+		{
+			MethodName: "TransferAccountOwnership",
+			Handler:    _Msg_TransferAccountOwnership_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -509,6 +773,11 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Ordering != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
 		i--
@@ -595,6 +864,11 @@ func (m *MsgSendTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.RelativeTimeout != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.RelativeTimeout))
 		i--
@@ -647,6 +921,16 @@ func (m *MsgSendTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
 		i--
@@ -655,7 +939,7 @@ func (m *MsgSendTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgReopenChannel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -665,37 +949,39 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReopenChannel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReopenChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgReopenChannelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -705,63 +991,227 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReopenChannelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReopenChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferAccountOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTransferAccountOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAccountOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.NewAccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewAccountIndex))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AccountIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountIndex))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Ordering != 0 {
-		n += 1 + sovTx(uint64(m.Ordering))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferAccountOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferAccountOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferAccountOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.PortId)
 	if l > 0 {
@@ -789,6 +1239,9 @@ func (m *MsgSendTx) Size() (n int) {
 	if m.RelativeTimeout != 0 {
 		n += 1 + sovTx(uint64(m.RelativeTimeout))
 	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
 	return n
 }
 
@@ -801,6 +1254,86 @@ func (m *MsgSendTxResponse) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovTx(uint64(m.Sequence))
 	}
+	if m.Queued {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgReopenChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	return n
+}
+
+func (m *MsgReopenChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferAccountOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.AccountIndex))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewAccountIndex != 0 {
+		n += 1 + sovTx(uint64(m.NewAccountIndex))
+	}
+	return n
+}
+
+func (m *MsgTransferAccountOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -978,6 +1511,25 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1258,6 +1810,25 @@ func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1327,6 +1898,539 @@ func (m *MsgSendTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReopenChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReopenChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReopenChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReopenChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReopenChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReopenChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAccountOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAccountOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAccountOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountIndex", wireType)
+			}
+			m.AccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAccountIndex", wireType)
+			}
+			m.NewAccountIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewAccountIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferAccountOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferAccountOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferAccountOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
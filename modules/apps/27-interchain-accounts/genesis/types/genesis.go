@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -40,12 +42,15 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params, executionResults []controllertypes.ExecutionResult, pendingTxs []controllertypes.PendingTx, accountOwnerships []controllertypes.AccountOwnership) ControllerGenesisState {
 	return ControllerGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Ports:              ports,
 		Params:             controllerParams,
+		ExecutionResults:   executionResults,
+		PendingTxs:         pendingTxs,
+		AccountOwnerships:  accountOwnerships,
 	}
 }
 
@@ -77,6 +82,55 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	seenResults := make(map[string]bool)
+	for _, result := range gs.ExecutionResults {
+		if err := result.Validate(); err != nil {
+			return err
+		}
+
+		key := string(controllertypes.KeyExecutionResult(result.ConnectionId, result.PortId, result.Sequence))
+		if seenResults[key] {
+			return fmt.Errorf("duplicate execution result for port %s on connection %s with sequence %d", result.PortId, result.ConnectionId, result.Sequence)
+		}
+		seenResults[key] = true
+	}
+
+	seenPendingTxs := make(map[string]bool)
+	for _, pendingTx := range gs.PendingTxs {
+		if err := pendingTx.Validate(); err != nil {
+			return err
+		}
+
+		key := string(controllertypes.KeyPendingTx(pendingTx.ConnectionId, pendingTx.PortId, pendingTx.Index))
+		if seenPendingTxs[key] {
+			return fmt.Errorf("duplicate pending transaction for port %s on connection %s with index %d", pendingTx.PortId, pendingTx.ConnectionId, pendingTx.Index)
+		}
+		seenPendingTxs[key] = true
+	}
+
+	seenOwnerships := make(map[string]bool)
+	for _, ownership := range gs.AccountOwnerships {
+		if err := ownership.Validate(); err != nil {
+			return err
+		}
+
+		ownerPortID, err := ownership.OwnerPortID()
+		if err != nil {
+			return err
+		}
+
+		// each interchain account has at most one owner and each owner controls one account per account index
+		for _, key := range []string{
+			string(controllertypes.KeyAccountOwnership(ownership.ConnectionId, ownership.PortId)),
+			string(controllertypes.KeyOwnedAccount(ownership.ConnectionId, ownerPortID)),
+		} {
+			if seenOwnerships[key] {
+				return fmt.Errorf("duplicate account ownership for port %s on connection %s", ownership.PortId, ownership.ConnectionId)
+			}
+			seenOwnerships[key] = true
+		}
+	}
+
 	return nil
 }
 
@@ -89,12 +143,13 @@ func DefaultHostGenesis() HostGenesisState {
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params, allowListOverrides []hosttypes.AllowListOverride) HostGenesisState {
 	return HostGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Port:               port,
 		Params:             hostParams,
+		AllowListOverrides: allowListOverrides,
 	}
 }
 
@@ -124,5 +179,18 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	seenOverrides := make(map[string]bool)
+	for _, allowListOverride := range gs.AllowListOverrides {
+		if err := allowListOverride.Validate(); err != nil {
+			return err
+		}
+
+		key := string(hosttypes.KeyAllowListOverride(allowListOverride.ConnectionId, allowListOverride.ControllerPortPrefix))
+		if seenOverrides[key] {
+			return fmt.Errorf("duplicate allow list override for connection ID %s and controller port prefix %s", allowListOverride.ConnectionId, allowListOverride.ControllerPortPrefix)
+		}
+		seenOverrides[key] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -84,6 +84,9 @@ type ControllerGenesisState struct {
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
 	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ExecutionResults   []types.ExecutionResult       `protobuf:"bytes,5,rep,name=execution_results,json=executionResults,proto3" json:"execution_results"`
+	PendingTxs         []types.PendingTx             `protobuf:"bytes,6,rep,name=pending_txs,json=pendingTxs,proto3" json:"pending_txs"`
+	AccountOwnerships  []types.AccountOwnership      `protobuf:"bytes,7,rep,name=account_ownerships,json=accountOwnerships,proto3" json:"account_ownerships"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +150,34 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetExecutionResults() []types.ExecutionResult {
+	if m != nil {
+		return m.ExecutionResults
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetPendingTxs() []types.PendingTx {
+	if m != nil {
+		return m.PendingTxs
+	}
+	return nil
+}
+
+func (m *ControllerGenesisState) GetAccountOwnerships() []types.AccountOwnership {
+	if m != nil {
+		return m.AccountOwnerships
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
 	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
 	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
 	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
 	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	AllowListOverrides []types1.AllowListOverride    `protobuf:"bytes,5,rep,name=allow_list_overrides,json=allowListOverrides,proto3" json:"allow_list_overrides"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +241,13 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetAllowListOverrides() []types1.AllowListOverride {
+	if m != nil {
+		return m.AllowListOverrides
+	}
+	return nil
+}
+
 // ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
 // indicate if the channel is middleware enabled
 type ActiveChannel struct {
@@ -360,44 +392,52 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0xb4, 0x56, 0x3b, 0xfb, 0xc3, 0x65, 0x76, 0x5d, 0xc3, 0x8a, 0xb1, 0xd4, 0x83,
-	0xbd, 0x34, 0xa1, 0x55, 0x58, 0x11, 0x14, 0xba, 0x45, 0xd6, 0x82, 0x0b, 0x12, 0x2f, 0xe2, 0x25,
-	0x4c, 0x27, 0x43, 0x3a, 0x90, 0x64, 0x42, 0xde, 0xb4, 0xe2, 0x59, 0xc1, 0xa3, 0xfe, 0x09, 0xfe,
-	0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x0f, 0xf0, 0x2f, 0x10, 0x64, 0x26, 0xd9, 0xb6, 0xd6,
-	0x2a, 0x2d, 0x1e, 0x3d, 0x65, 0xe6, 0x7d, 0xf3, 0xbe, 0xef, 0x93, 0xf7, 0x92, 0x0c, 0x7a, 0xcc,
-	0x07, 0xd4, 0x25, 0x69, 0x1a, 0x71, 0x4a, 0x24, 0x17, 0x09, 0xb8, 0x3c, 0x91, 0x2c, 0xa3, 0x43,
-	0xc2, 0x13, 0x9f, 0x50, 0x2a, 0x46, 0x89, 0x04, 0x37, 0x64, 0x09, 0x03, 0x0e, 0xee, 0xb8, 0x7d,
-	0xb9, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0x5d, 0x3e, 0xa0, 0xce, 0x62, 0xba, 0xb3, 0x22, 0xdd, 0xb9,
-	0xcc, 0x19, 0xb7, 0x8f, 0x0e, 0x42, 0x11, 0x0a, 0x9d, 0xeb, 0xaa, 0x55, 0x6e, 0x73, 0xd4, 0x5b,
-	0x8b, 0x82, 0x8a, 0x44, 0x66, 0x22, 0x8a, 0x58, 0xa6, 0x40, 0xe6, 0xbb, 0xc2, 0xe4, 0x78, 0x2d,
-	0x93, 0xa1, 0x00, 0xa9, 0xd2, 0xd5, 0x35, 0x4f, 0x6c, 0x7c, 0x34, 0xd1, 0xf6, 0x69, 0x8e, 0xf8,
-	0x52, 0x12, 0xc9, 0xf0, 0x07, 0x03, 0x59, 0x73, 0x7b, 0xbf, 0xc0, 0xf7, 0x41, 0x89, 0x96, 0x51,
-	0x37, 0x9a, 0x5b, 0x9d, 0x53, 0x67, 0xc3, 0x27, 0x77, 0x7a, 0x33, 0xc3, 0xc5, 0x5a, 0x27, 0x95,
-	0xf3, 0xaf, 0x77, 0x4a, 0xde, 0x21, 0x5d, 0xa9, 0xe2, 0x11, 0xc2, 0x0a, 0x74, 0x09, 0xc1, 0xd4,
-	0x08, 0xdd, 0x8d, 0x11, 0x9e, 0x09, 0x90, 0x2b, 0x8a, 0xef, 0x0d, 0x97, 0xe2, 0x8d, 0x1f, 0x26,
-	0x3a, 0x5c, 0xcd, 0x8b, 0x63, 0x74, 0x9d, 0x50, 0xc9, 0xc7, 0xcc, 0xa7, 0x43, 0x92, 0x24, 0x2c,
-	0x02, 0xcb, 0xa8, 0x97, 0x9b, 0x5b, 0x9d, 0x27, 0x1b, 0xe3, 0x74, 0xb5, 0x4f, 0x2f, 0xb7, 0x29,
-	0x58, 0x76, 0xc9, 0x62, 0x10, 0xf0, 0x3b, 0x03, 0xed, 0xaf, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3, 0xf9,
-	0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x63, 0x41, 0x7f, 0x76, 0x63, 0x37, 0xbf, 0xaf, 0x20,
-	0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x80, 0xae, 0xa4, 0x22, 0x93, 0x60, 0x95, 0xeb, 0xe5, 0x66, 0xcd,
-	0xcb, 0x37, 0xf8, 0x15, 0xaa, 0xa6, 0x24, 0x23, 0x31, 0x58, 0x15, 0x3d, 0x90, 0x47, 0xeb, 0xd1,
-	0x2c, 0xbc, 0xb8, 0xe3, 0xb6, 0xf3, 0x42, 0x3b, 0x14, 0xb5, 0x0b, 0xbf, 0xc6, 0x77, 0x13, 0xed,
-	0x2d, 0x0f, 0xeb, 0xff, 0xec, 0x3c, 0x46, 0x15, 0xd5, 0x6c, 0xab, 0x5c, 0x37, 0x9a, 0x35, 0x4f,
-	0xaf, 0xb1, 0xb7, 0xd4, 0xf7, 0x07, 0xeb, 0xb1, 0xe8, 0x2f, 0xfe, 0x4f, 0x1d, 0xff, 0x6c, 0xa0,
-	0x9d, 0x5f, 0xba, 0x82, 0xef, 0xa2, 0x1d, 0x2a, 0x92, 0x84, 0x51, 0xe5, 0xe8, 0xf3, 0x40, 0x7f,
-	0xf8, 0x35, 0x6f, 0x7b, 0x1e, 0xec, 0x07, 0xf8, 0x26, 0xba, 0xaa, 0x90, 0x94, 0x6c, 0x6a, 0xb9,
-	0xaa, 0xb6, 0xfd, 0x00, 0xdf, 0x46, 0xa8, 0x98, 0x92, 0xd2, 0x72, 0xfa, 0x5a, 0x11, 0xe9, 0x07,
-	0xb8, 0x83, 0x6e, 0x70, 0xf0, 0x63, 0x1e, 0x04, 0x11, 0x7b, 0x43, 0x32, 0xe6, 0xb3, 0x84, 0x0c,
-	0x22, 0x16, 0xe8, 0x27, 0xba, 0xe6, 0xed, 0x73, 0x38, 0x9b, 0x69, 0x4f, 0x73, 0xa9, 0xf1, 0xde,
-	0x40, 0xb7, 0xfe, 0xd2, 0xc4, 0x7f, 0x04, 0xbe, 0xa7, 0xde, 0x2e, 0x6d, 0xe4, 0x93, 0x20, 0xc8,
-	0x18, 0x40, 0x41, 0xbd, 0x5b, 0x84, 0xbb, 0x79, 0xf4, 0x24, 0x3c, 0x9f, 0xd8, 0xc6, 0xc5, 0xc4,
-	0x36, 0xbe, 0x4d, 0x6c, 0xe3, 0xd3, 0xd4, 0x2e, 0x5d, 0x4c, 0xed, 0xd2, 0x97, 0xa9, 0x5d, 0x7a,
-	0x7d, 0x16, 0x72, 0x39, 0x1c, 0x0d, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x88, 0x05, 0xa8, 0xe3, 0xa1,
-	0x15, 0x0a, 0x77, 0xfc, 0xd0, 0x8d, 0x45, 0x30, 0x8a, 0x18, 0xa8, 0x1f, 0x34, 0xb8, 0x9d, 0xe3,
-	0xd6, 0x7c, 0x42, 0xad, 0xdf, 0x8e, 0x19, 0xf9, 0x36, 0x65, 0x30, 0xa8, 0xea, 0xbf, 0xf3, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x57, 0x5a, 0x11, 0xa3, 0x06, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x4d, 0x6f, 0x13, 0x3b,
+	0x14, 0xcd, 0x24, 0x6d, 0xfa, 0xe2, 0x7e, 0xbc, 0xd6, 0xed, 0xeb, 0x1b, 0xf5, 0xe9, 0x85, 0x28,
+	0x2c, 0xc8, 0xa6, 0x33, 0x6a, 0x40, 0x2a, 0x42, 0x2a, 0x28, 0x0d, 0x55, 0x89, 0xd4, 0xaa, 0x68,
+	0x60, 0x81, 0xd8, 0x8c, 0x9c, 0x19, 0x6b, 0x62, 0x69, 0x32, 0x1e, 0xcd, 0x75, 0x92, 0x76, 0x0d,
+	0x12, 0x4b, 0xf8, 0x09, 0xfc, 0x9c, 0x2e, 0xbb, 0x42, 0xac, 0x10, 0x6a, 0x37, 0xfc, 0x0c, 0x64,
+	0x8f, 0xa7, 0x49, 0x43, 0x40, 0x49, 0x59, 0xb2, 0x8a, 0x7d, 0xcf, 0xdc, 0x73, 0x8e, 0x7d, 0xaf,
+	0xed, 0xa0, 0x3d, 0xd6, 0xf6, 0x6c, 0x12, 0xc7, 0x21, 0xf3, 0x88, 0x60, 0x3c, 0x02, 0x9b, 0x45,
+	0x82, 0x26, 0x5e, 0x87, 0xb0, 0xc8, 0x25, 0x9e, 0xc7, 0x7b, 0x91, 0x00, 0x3b, 0xa0, 0x11, 0x05,
+	0x06, 0x76, 0x7f, 0x27, 0x1b, 0x5a, 0x71, 0xc2, 0x05, 0xc7, 0x36, 0x6b, 0x7b, 0xd6, 0x68, 0xba,
+	0x35, 0x21, 0xdd, 0xca, 0x72, 0xfa, 0x3b, 0x5b, 0x1b, 0x01, 0x0f, 0xb8, 0xca, 0xb5, 0xe5, 0x28,
+	0xa5, 0xd9, 0x6a, 0x4e, 0xe5, 0xc2, 0xe3, 0x91, 0x48, 0x78, 0x18, 0xd2, 0x44, 0x1a, 0x19, 0xce,
+	0x34, 0xc9, 0xee, 0x54, 0x24, 0x1d, 0x0e, 0x42, 0xa6, 0xcb, 0xdf, 0x34, 0xb1, 0xfa, 0x3e, 0x8f,
+	0x96, 0x0e, 0x53, 0x8b, 0x2f, 0x04, 0x11, 0x14, 0xbf, 0x33, 0x90, 0x39, 0xa4, 0x77, 0xb5, 0x7d,
+	0x17, 0x24, 0x68, 0x1a, 0x15, 0xa3, 0xb6, 0x58, 0x3f, 0xb4, 0x66, 0x5c, 0xb9, 0xd5, 0xbc, 0x26,
+	0x1c, 0xd5, 0xda, 0x9f, 0x3b, 0xff, 0x72, 0x27, 0xe7, 0x6c, 0x7a, 0x13, 0x51, 0xdc, 0x43, 0x58,
+	0x1a, 0x1d, 0xb3, 0x90, 0x57, 0x16, 0x1a, 0x33, 0x5b, 0x78, 0xc6, 0x41, 0x4c, 0x10, 0x5f, 0xed,
+	0x8c, 0xc5, 0xab, 0x9f, 0xe6, 0xd1, 0xe6, 0x64, 0xbf, 0xb8, 0x8b, 0xfe, 0x26, 0x9e, 0x60, 0x7d,
+	0xea, 0x7a, 0x1d, 0x12, 0x45, 0x34, 0x04, 0xd3, 0xa8, 0x14, 0x6a, 0x8b, 0xf5, 0xc7, 0x33, 0xdb,
+	0x69, 0x28, 0x9e, 0x66, 0x4a, 0xa3, 0xbd, 0xac, 0x90, 0xd1, 0x20, 0xe0, 0x37, 0x06, 0x5a, 0x9f,
+	0x40, 0x63, 0xe6, 0x95, 0xe6, 0xd1, 0xcc, 0x9a, 0x0e, 0x0d, 0x18, 0x08, 0x9a, 0x50, 0xbf, 0x75,
+	0xfd, 0x61, 0x23, 0xfd, 0x4e, 0x3b, 0xc0, 0x6c, 0x1c, 0x00, 0xbc, 0x81, 0xe6, 0x63, 0x9e, 0x08,
+	0x30, 0x0b, 0x95, 0x42, 0xad, 0xe4, 0xa4, 0x13, 0xfc, 0x0a, 0x15, 0x63, 0x92, 0x90, 0x2e, 0x98,
+	0x73, 0xaa, 0x20, 0x8f, 0xa6, 0x73, 0x33, 0xd2, 0xb8, 0xfd, 0x1d, 0xeb, 0xb9, 0x62, 0xd0, 0xda,
+	0x9a, 0x0f, 0xf7, 0xd1, 0x1a, 0x3d, 0xa5, 0x5e, 0x4f, 0x92, 0xb8, 0x09, 0x85, 0x5e, 0x28, 0xc0,
+	0x9c, 0x57, 0x4b, 0x6e, 0xde, 0x46, 0xe4, 0x20, 0x23, 0x73, 0x14, 0x57, 0x56, 0x77, 0x7a, 0x33,
+	0x0c, 0xd8, 0x47, 0x8b, 0x31, 0x8d, 0x7c, 0x16, 0x05, 0xae, 0x38, 0x05, 0xb3, 0xa8, 0x14, 0xf7,
+	0x6e, 0xb5, 0xac, 0x94, 0xe6, 0xe5, 0xa9, 0xd6, 0x42, 0x71, 0x16, 0x00, 0x7c, 0x86, 0xb0, 0xce,
+	0x72, 0xf9, 0x20, 0xa2, 0x09, 0x74, 0x58, 0x0c, 0xe6, 0x82, 0x12, 0x7b, 0x7a, 0x1b, 0x31, 0x5d,
+	0xa7, 0x93, 0x8c, 0x4c, 0x6b, 0xae, 0x91, 0xb1, 0x38, 0x54, 0xbf, 0x15, 0xd0, 0xea, 0xf8, 0x29,
+	0xf8, 0x33, 0x5b, 0x1a, 0xa3, 0x39, 0xd9, 0xc5, 0x66, 0xa1, 0x62, 0xd4, 0x4a, 0x8e, 0x1a, 0x63,
+	0x67, 0xac, 0xa1, 0x1f, 0x4c, 0xe7, 0x45, 0x5d, 0xa5, 0x3f, 0x6b, 0xe5, 0x01, 0xda, 0x20, 0x61,
+	0xc8, 0x07, 0x6e, 0xc8, 0x40, 0xb8, 0xbc, 0x4f, 0x93, 0x84, 0xf9, 0x34, 0xeb, 0xe6, 0x27, 0xb3,
+	0x29, 0x34, 0x24, 0xd3, 0x11, 0x03, 0x71, 0xa2, 0x79, 0xb2, 0x05, 0x92, 0x71, 0x00, 0xaa, 0x1f,
+	0x0d, 0xb4, 0x7c, 0xa3, 0x1c, 0xf8, 0x2e, 0x5a, 0xf6, 0x78, 0x14, 0x51, 0x4f, 0x1d, 0x2b, 0xe6,
+	0xab, 0xab, 0xbc, 0xe4, 0x2c, 0x0d, 0x83, 0x2d, 0x1f, 0xff, 0x8b, 0x16, 0xe4, 0x5e, 0x48, 0x38,
+	0xaf, 0xe0, 0xa2, 0x9c, 0xb6, 0x7c, 0xfc, 0x3f, 0x42, 0xba, 0x3d, 0x24, 0x96, 0x6e, 0x5b, 0x49,
+	0x47, 0x5a, 0x3e, 0xae, 0xa3, 0x7f, 0x18, 0xb8, 0x5d, 0xe6, 0xfb, 0x21, 0x1d, 0x90, 0x84, 0xba,
+	0x34, 0x22, 0xed, 0x90, 0xfa, 0x6a, 0x2b, 0xff, 0x72, 0xd6, 0x19, 0x1c, 0x5f, 0x63, 0x07, 0x29,
+	0x54, 0x7d, 0x6b, 0xa0, 0xff, 0x7e, 0x51, 0xbd, 0xdf, 0x34, 0x7c, 0x4f, 0xb6, 0x75, 0x7a, 0xcc,
+	0x88, 0xef, 0x27, 0x14, 0x40, 0xbb, 0x5e, 0xd1, 0xe1, 0x46, 0x1a, 0xdd, 0x0f, 0xce, 0x2f, 0xcb,
+	0xc6, 0xc5, 0x65, 0xd9, 0xf8, 0x7a, 0x59, 0x36, 0x3e, 0x5c, 0x95, 0x73, 0x17, 0x57, 0xe5, 0xdc,
+	0xe7, 0xab, 0x72, 0xee, 0xf5, 0x71, 0xc0, 0x44, 0xa7, 0xd7, 0xb6, 0x3c, 0xde, 0xb5, 0x3d, 0x0e,
+	0x5d, 0x0e, 0xf2, 0xc1, 0xdf, 0x0e, 0xb8, 0xdd, 0x7f, 0x68, 0x77, 0xb9, 0xdf, 0x0b, 0x29, 0xc8,
+	0x27, 0x17, 0xec, 0xfa, 0xee, 0xf6, 0xb0, 0x70, 0xdb, 0x3f, 0xfc, 0x71, 0x10, 0x67, 0x31, 0x85,
+	0x76, 0x51, 0xbd, 0xb7, 0xf7, 0xbf, 0x0f, 0x00, 0x2e, 0x7a, 0x68, 0x1a, 0x75, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +503,48 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountOwnerships) > 0 {
+		for iNdEx := len(m.AccountOwnerships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountOwnerships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingTxs) > 0 {
+		for iNdEx := len(m.PendingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ExecutionResults) > 0 {
+		for iNdEx := len(m.ExecutionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -533,6 +615,20 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowListOverrides) > 0 {
+		for iNdEx := len(m.AllowListOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowListOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -729,6 +825,24 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExecutionResults) > 0 {
+		for _, e := range m.ExecutionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTxs) > 0 {
+		for _, e := range m.PendingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountOwnerships) > 0 {
+		for _, e := range m.AccountOwnerships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -756,6 +870,12 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AllowListOverrides) > 0 {
+		for _, e := range m.AllowListOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1088,6 +1208,108 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionResults = append(m.ExecutionResults, types.ExecutionResult{})
+			if err := m.ExecutionResults[len(m.ExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTxs = append(m.PendingTxs, types.PendingTx{})
+			if err := m.PendingTxs[len(m.PendingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountOwnerships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountOwnerships = append(m.AccountOwnerships, types.AccountOwnership{})
+			if err := m.AccountOwnerships[len(m.AccountOwnerships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1271,6 +1493,40 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowListOverrides = append(m.AllowListOverrides, types1.AllowListOverride{})
+			if err := m.AllowListOverrides[len(m.AllowListOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
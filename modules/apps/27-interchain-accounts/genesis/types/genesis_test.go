@@ -106,7 +106,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, nil, nil)
 			},
 			false,
 		},
@@ -120,7 +120,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil, nil, nil)
 			},
 			false,
 		},
@@ -141,7 +141,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil, nil, nil)
 			},
 			false,
 		},
@@ -162,7 +162,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil, nil, nil)
 			},
 			false,
 		},
@@ -183,7 +183,127 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil, nil, nil)
+			},
+			false,
+		},
+		{
+			"success with execution results",
+			func() {
+				executionResults := []controllertypes.ExecutionResult{
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Success: true},
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 2, Code: 5, Error: "ABCI code: 5: error handling packet: see events for details"},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), executionResults, nil, nil)
+			},
+			true,
+		},
+		{
+			"failed to validate execution result - zero sequence",
+			func() {
+				executionResults := []controllertypes.ExecutionResult{
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: TestPortID, ChannelId: ibctesting.FirstChannelID},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), executionResults, nil, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate execution results - duplicate result",
+			func() {
+				executionResults := []controllertypes.ExecutionResult{
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Success: true},
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: TestPortID, ChannelId: ibctesting.FirstChannelID, Sequence: 1, Success: true},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), executionResults, nil, nil)
+			},
+			false,
+		},
+		{
+			"success with pending transactions",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+				pendingTxs := []controllertypes.PendingTx{
+					controllertypes.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, packetData, 100),
+					controllertypes.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 2, packetData, 100),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, pendingTxs, nil)
+			},
+			true,
+		},
+		{
+			"failed to validate pending transaction - zero relative timeout",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+				pendingTxs := []controllertypes.PendingTx{
+					controllertypes.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, packetData, 0),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, pendingTxs, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate pending transactions - duplicate index",
+			func() {
+				packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data")}
+				pendingTxs := []controllertypes.PendingTx{
+					controllertypes.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, packetData, 100),
+					controllertypes.NewPendingTx(ibctesting.FirstConnectionID, TestPortID, 1, packetData, 100),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, pendingTxs, nil)
+			},
+			false,
+		},
+		{
+			"success with account ownerships",
+			func() {
+				ownerships := []controllertypes.AccountOwnership{
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 0),
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID+".1", "new-owner", 1),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, nil, ownerships)
+			},
+			true,
+		},
+		{
+			"failed to validate account ownership - owner encoded in port",
+			func() {
+				ownerships := []controllertypes.AccountOwnership{
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix+"owner", "owner", 0),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, nil, ownerships)
+			},
+			false,
+		},
+		{
+			"failed to validate account ownerships - duplicate port",
+			func() {
+				ownerships := []controllertypes.AccountOwnership{
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 0),
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 1),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, nil, ownerships)
+			},
+			false,
+		},
+		{
+			"failed to validate account ownerships - duplicate owner and account index",
+			func() {
+				ownerships := []controllertypes.AccountOwnership{
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID, "new-owner", 0),
+					controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, TestPortID+".1", "new-owner", 0),
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, nil, controllertypes.DefaultParams(), nil, nil, ownerships)
 			},
 			false,
 		},
@@ -231,7 +351,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -245,7 +365,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -266,7 +386,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -287,7 +407,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -308,7 +428,42 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"success with allow list overrides",
+			func() {
+				allowListOverrides := []hosttypes.AllowListOverride{
+					hosttypes.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+					hosttypes.NewAllowListOverride(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix, []string{"*"}),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowListOverrides)
+			},
+			true,
+		},
+		{
+			"failed to validate allow list override - invalid connection identifier",
+			func() {
+				allowListOverrides := []hosttypes.AllowListOverride{
+					hosttypes.NewAllowListOverride("invalid|connection", "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowListOverrides)
+			},
+			false,
+		},
+		{
+			"failed to validate allow list overrides - duplicate override",
+			func() {
+				allowListOverrides := []hosttypes.AllowListOverride{
+					hosttypes.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+					hosttypes.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"*"}),
+				}
+
+				genesisState = genesistypes.NewHostGenesisState(nil, nil, icatypes.HostPortID, hosttypes.DefaultParams(), allowListOverrides)
 			},
 			false,
 		},
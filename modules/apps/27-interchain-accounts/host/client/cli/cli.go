@@ -18,6 +18,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdAllowListOverrides(),
+		GetCmdAllowedMessages(),
+		GetCmdInterchainAccounts(),
+		GetCmdInterchainAccount(),
 	)
 
 	return queryCmd
@@ -35,6 +39,7 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		generatePacketDataCmd(),
+		simulateTxCmd(),
 	)
 
 	return cmd
@@ -98,3 +98,150 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdAllowListOverrides returns the command handler for the host submodule allow list overrides querying.
+func GetCmdAllowListOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allow-list-overrides [connection-id]",
+		Short:   "Query the interchain-accounts host submodule allow list overrides",
+		Long:    "Query the interchain-accounts host submodule allow list overrides, optionally filtered by host connection",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host allow-list-overrides connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllowListOverridesRequest{
+				Pagination: pageReq,
+			}
+
+			if len(args) == 1 {
+				req.ConnectionId = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllowListOverrides(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allow list overrides")
+
+	return cmd
+}
+
+// GetCmdAllowedMessages returns the command handler for querying the messages an interchain account is allowed to execute.
+func GetCmdAllowedMessages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed-messages [connection-id] [controller-port-id]",
+		Short:   "Query the messages an interchain account is allowed to execute",
+		Long:    "Query the sdk message typeURLs the interchain account registered over a host connection by a controller port is allowed to execute",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host allowed-messages connection-0 icacontroller-cosmos1...", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllowedMessages(cmd.Context(), &types.QueryAllowedMessagesRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccounts returns the command handler for querying the interchain accounts registered on the host chain.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts [connection-id]",
+		Short:   "Query the interchain accounts registered on the host chain",
+		Long:    "Query the interchain accounts registered on the host chain with their host connection and controller port, optionally filtered by host connection",
+		Args:    cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts connection-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(args) == 1 {
+				req.ConnectionId = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdInterchainAccount returns the command handler for looking up an interchain account by its address.
+func GetCmdInterchainAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-account [address]",
+		Short:   "Query the host connection and controller port of an interchain account",
+		Long:    "Query the host connection and controller port over which the interchain account with the given address was registered",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterchainAccount(cmd.Context(), &types.QueryInterchainAccountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,16 +9,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
 const (
 	memoFlag     string = "memo"
 	encodingFlag string = "encoding"
+	gasLimitFlag string = "execution-gas-limit"
 )
 
 func generatePacketDataCmd() *cobra.Command {
@@ -102,6 +105,83 @@ otherwise the encoding flag can be used in combination with either "proto3" or "
 	return cmd
 }
 
+func simulateTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-tx [connection-id] [controller-port-id] [message]",
+		Short: "Simulates the execution of messages by an interchain account on the host chain.",
+		Long: `simulate-tx accepts a message string in the same format as generate-packet-data and executes
+the messages on behalf of the interchain account registered over the host connection by the controller port,
+without committing any state changes. The message responses, the gas used, the gas limit applied, the execution fee,
+the emitted events and the execution error, if any, are returned. The encoding flag must match the encoding of the
+interchain account channel. The execution gas limit flag sets the gas limit requested by the controller in the packet memo.`,
+		Example: fmt.Sprintf(`%s tx interchain-accounts host simulate-tx connection-0 icacontroller-cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs '{
+    "@type":"/cosmos.bank.v1beta1.MsgSend",
+    "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [
+        {
+            "denom": "stake",
+            "amount": "1000"
+        }
+    ]
+}'`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			encoding, err := cmd.Flags().GetString(encodingFlag)
+			if err != nil {
+				return err
+			}
+
+			if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, encoding) {
+				return fmt.Errorf("unsupported encoding type: %s", encoding)
+			}
+
+			protoMessages, err := convertBytesIntoProtoMessages(cdc, []byte(args[2]))
+			if err != nil {
+				return err
+			}
+
+			data, err := icatypes.SerializeCosmosTx(cdc, protoMessages, encoding)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := cmd.Flags().GetUint64(gasLimitFlag)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QuerySimulateTxRequest{
+				ConnectionId: args[0],
+				PortId:       args[1],
+				Data:         data,
+				GasLimit:     gasLimit,
+			}
+
+			res, err := queryClient.SimulateTx(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(encodingFlag, icatypes.EncodingProtobuf, "encoding format of the interchain account channel, either \"proto3\" or \"proto3json\"")
+	cmd.Flags().Uint64(gasLimitFlag, 0, "optional execution gas limit requested by the controller, capped by the maximum execution gas of the host")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // generatePacketData takes in message bytes and a memo and serializes the message into an
 // instance of InterchainAccountPacketData which is returned as bytes.
 func generatePacketData(cdc *codec.ProtoCodec, msgBytes []byte, memo string, encoding string) ([]byte, error) {
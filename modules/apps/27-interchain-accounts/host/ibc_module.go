@@ -1,6 +1,7 @@
 package host
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -114,7 +115,7 @@ func (im IBCModule) OnChanCloseConfirm(
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	logger := im.keeper.Logger(ctx)
 	if !im.keeper.GetParams(ctx).HostEnabled {
@@ -123,10 +124,17 @@ func (im IBCModule) OnRecvPacket(
 		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet, relayer)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
 		ack = channeltypes.NewErrorAcknowledgement(err)
+
+		// message policy violations identify the failed rule in the acknowledgement
+		var policyViolation *types.PolicyViolationError
+		if errors.As(err, &policyViolation) {
+			ack = policyViolation.Acknowledgement()
+		}
+
 		logger.Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
 	} else {
 		logger.Info("successfully handled packet", "sequence", packet.Sequence)
@@ -3,6 +3,7 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
@@ -11,9 +12,12 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	controllerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -161,7 +165,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil))
 			}, false,
 		},
 		{
@@ -278,7 +282,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil))
 			}, false,
 		},
 		{
@@ -407,7 +411,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil))
 			}, false,
 			types.ErrHostSubModuleDisabled.Error(),
 		},
@@ -427,6 +431,16 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 			}, false,
 			"cannot unmarshal ICS-27 interchain account packet data: unknown data type",
 		},
+		{
+			"ICA OnRecvPacket fails - message policy violation", func() {
+				params := types.NewParams(true, []string{"*"}, nil)
+				params.MessagePolicies = []types.MessagePolicy{
+					types.NewMessagePolicy("max-send", sdk.MsgTypeURL(&banktypes.MsgSend{}), types.NewPolicyRule("amount", types.COINS_MAX, "50stake")),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false,
+			"failed to execute interchain account transaction: message policy violation: policy max-send rule 0 (CONSTRAINT_TYPE_COINS_MAX on amount): coins 100stake exceed maximum 50stake",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -474,7 +488,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
 
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -532,6 +546,101 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketMessagePolicyViolation() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	}
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{"*"}, nil)
+	params.MessagePolicies = []types.MessagePolicy{
+		types.NewMessagePolicy("max-send", sdk.MsgTypeURL(msg), types.NewPolicyRule("amount", types.COINS_MAX, "50stake")),
+	}
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+	suite.Require().False(ack.Success())
+
+	// the error acknowledgement identifies the failed policy rule but not the message contents
+	expectedAck := channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: "ABCI code: 4: message policy violation: policy max-send rule 0 (CONSTRAINT_TYPE_COINS_MAX on amount)",
+		},
+	}
+	suite.Require().Equal(expectedAck, ack)
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketOutOfGas() {
+	suite.SetupTest() // reset
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	}
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	// the requested gas limit is insufficient to execute the transaction
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: `{"ica_execution": {"gas_limit": "1000"}}`,
+	}
+
+	params := types.DefaultParams()
+	params.ExecutionGasPrice = "1stake"
+	params.ExecutionFeeRecipient = types.RELAYER
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+	module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+	suite.Require().NoError(err)
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+	suite.Require().True(ok)
+
+	ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(sdkerrors.ErrOutOfGas), ack)
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	testCases := []struct {
 		name     string
@@ -676,7 +785,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanUpgradeTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil))
 			}, types.ErrHostSubModuleDisabled,
 		},
 	}
@@ -821,7 +930,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Data: data,
 	}
 
-	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	//nolint: staticcheck // SA1019: ibctesting.FirstConnectionID is deprecated: use path.EndpointA.ConnectionID instead. (staticcheck)
@@ -864,6 +973,181 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.assertBalance(icaAddr, expBalAfterSecondSend)
 }
 
+// TestExecutionResultsStoredOnAcknowledgement relays a failing and a successful transaction packet and asserts that
+// the execution results decoded from their acknowledgements are stored on the controller chain.
+func (suite *InterchainAccountsTestSuite) TestExecutionResultsStoredOnAcknowledgement() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerParams := controllertypes.DefaultParams()
+	controllerParams.MaxExecutionResults = 10
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), controllerParams)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	tokenAmt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)))
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	sendAndRelay := func() uint64 {
+		//nolint: staticcheck // SA1019: ibctesting.FirstConnectionID is deprecated: use path.EndpointA.ConnectionID instead. (staticcheck)
+		sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+		suite.Require().NoError(err)
+		err = path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+
+		packetRelay := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+		err = path.RelayPacket(packetRelay)
+		suite.Require().NoError(err)
+
+		return sequence
+	}
+
+	// the interchain account is not funded, the transaction fails on the host chain
+	sequence := sendAndRelay()
+
+	result, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetExecutionResult(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, sequence)
+	suite.Require().True(found)
+	suite.Require().False(result.Success)
+	suite.Require().Nil(result.TxMsgData)
+	suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), result.Code)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, tokenAmt)
+	sequence = sendAndRelay()
+
+	result, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetExecutionResult(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, sequence)
+	suite.Require().True(found)
+	suite.Require().True(result.Success)
+	suite.Require().Len(result.TxMsgData.MsgResponses, 1)
+	suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), result.TxMsgData.MsgResponses[0].TypeUrl)
+	suite.Require().Zero(result.Code)
+	suite.Require().Empty(result.Error)
+}
+
+// TestReopenChannelAfterTimeout times out a packet on an ORDERED channel and asserts that the controller reopens the
+// channel with the same metadata at the end of the block, and that a transaction submitted while the channel is closed
+// is queued and sent once the new channel is open.
+func (suite *InterchainAccountsTestSuite) TestReopenChannelAfterTimeout() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	controllerParams := controllertypes.DefaultParams()
+	controllerParams.AutoReopenChannels = true
+	controllerParams.MaxPendingTxs = 10
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), controllerParams)
+
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	tokenAmt := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)))
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, tokenAmt)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	closedChannelID := path.EndpointA.ChannelID
+	closedChannel := path.EndpointA.GetChannel()
+
+	// send a packet which times out before it is relayed, closing the ORDERED channel
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Minute).UnixNano())
+	//nolint: staticcheck // SA1019: ibctesting.FirstConnectionID is deprecated: use path.EndpointA.ConnectionID instead. (staticcheck)
+	sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, timeoutTimestamp)
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.chainB.NextBlock()
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// the channel is closed and a new channel handshake has been initiated at the end of the block
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+	suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.IsActiveChannelClosed(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasReopenChannelFlag(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(1)
+	reopenedChannel := path.EndpointA.GetChannel()
+	suite.Require().Equal(channeltypes.INIT, reopenedChannel.State)
+	suite.Require().Equal(closedChannel.Version, reopenedChannel.Version)
+	suite.Require().Equal(closedChannel.Ordering, reopenedChannel.Ordering)
+
+	// a transaction submitted while the channel is closed is queued
+	msgServer := controllerkeeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	res, err := msgServer.SendTx(suite.chainA.GetContext(), controllertypes.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, uint64(time.Hour.Nanoseconds()), icaPacketData))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Queued)
+	suite.Require().Len(suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID), 1)
+
+	// the host channel end is closed by relaying the closure of the controller channel end
+	path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+	// complete the channel handshake, the pending transaction is sent at the end of the block in which the channel is opened
+	path.EndpointA.ChannelConfig.Version = reopenedChannel.Version
+	path.EndpointB.ChannelID = ""
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+
+	// the pending transaction is sent with a timeout relative to the block time of the channel opening block
+	flushedTimeout := uint64(suite.chainA.LatestCommittedHeader.GetTime().UnixNano()) + uint64(time.Hour.Nanoseconds())
+
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().NotEqual(closedChannelID, activeChannelID)
+	suite.Require().Empty(suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingTxs(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID))
+
+	packet = channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), flushedTimeout)
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), icaAddr, sdk.DefaultBondDenom).IsZero())
+}
+
 // assertBalance asserts that the provided address has exactly the expected balance.
 // CONTRACT: the expected balance must only contain one coin denom.
 func (suite *InterchainAccountsTestSuite) assertBalance(addr sdk.AccAddress, expBalance sdk.Coins) {
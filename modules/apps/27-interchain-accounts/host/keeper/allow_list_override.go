@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+)
+
+// GetAllowListOverride retrieves the allow list override stored for the provided host connectionID and controller port prefix
+func (k Keeper) GetAllowListOverride(ctx sdk.Context, connectionID, controllerPortPrefix string) (types.AllowListOverride, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAllowListOverride(connectionID, controllerPortPrefix))
+	if bz == nil {
+		return types.AllowListOverride{}, false
+	}
+
+	var allowListOverride types.AllowListOverride
+	k.cdc.MustUnmarshal(bz, &allowListOverride)
+
+	return allowListOverride, true
+}
+
+// SetAllowListOverride stores the provided allow list override, keyed by its host connectionID and controller port prefix
+func (k Keeper) SetAllowListOverride(ctx sdk.Context, allowListOverride types.AllowListOverride) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&allowListOverride)
+	store.Set(types.KeyAllowListOverride(allowListOverride.ConnectionId, allowListOverride.ControllerPortPrefix), bz)
+}
+
+// DeleteAllowListOverride removes the allow list override stored for the provided host connectionID and controller port prefix
+func (k Keeper) DeleteAllowListOverride(ctx sdk.Context, connectionID, controllerPortPrefix string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAllowListOverride(connectionID, controllerPortPrefix))
+}
+
+// GetAllAllowListOverrides returns all the allow list overrides stored
+func (k Keeper) GetAllAllowListOverrides(ctx sdk.Context) []types.AllowListOverride {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AllowListOverrideKeyPrefix+"/"))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var allowListOverrides []types.AllowListOverride
+	for ; iterator.Valid(); iterator.Next() {
+		var allowListOverride types.AllowListOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &allowListOverride)
+
+		allowListOverrides = append(allowListOverrides, allowListOverride)
+	}
+
+	return allowListOverrides
+}
+
+// GetMatchingAllowListOverride returns the allow list override which applies to the interchain account registered over the
+// provided host connectionID by the provided controller portID. If several overrides apply, the one with the longest
+// controller port prefix is returned.
+func (k Keeper) GetMatchingAllowListOverride(ctx sdk.Context, connectionID, portID string) (types.AllowListOverride, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyAllowListOverrideConnectionPrefix(connectionID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var (
+		matchingOverride types.AllowListOverride
+		found            bool
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var allowListOverride types.AllowListOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &allowListOverride)
+
+		if !allowListOverride.Matches(connectionID, portID) {
+			continue
+		}
+
+		if !found || len(allowListOverride.ControllerPortPrefix) > len(matchingOverride.ControllerPortPrefix) {
+			matchingOverride = allowListOverride
+			found = true
+		}
+	}
+
+	return matchingOverride, found
+}
+
+// GetAllowMessages returns the sdk message typeURLs the interchain account registered over the provided host connectionID
+// by the provided controller portID is allowed to execute. A matching allow list override takes precedence over the
+// allow_messages parameter.
+func (k Keeper) GetAllowMessages(ctx sdk.Context, connectionID, portID string) []string {
+	if allowListOverride, found := k.GetMatchingAllowListOverride(ctx, connectionID, portID); found {
+		return allowListOverride.AllowMessages
+	}
+
+	return k.GetParams(ctx).AllowMessages
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestGetAllowMessages() {
+	paramsAllowMsgs := []string{"/cosmos.gov.v1beta1.MsgVote"}
+	connectionAllowMsgs := []string{"/cosmos.bank.v1beta1.MsgSend"}
+	prefixAllowMsgs := []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	longerPrefixAllowMsgs := []string{"/cosmos.staking.v1beta1.MsgUndelegate"}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		connectionID string
+		portID       string
+		expAllowMsgs []string
+	}{
+		{
+			"no override: allow messages param",
+			func() {},
+			ibctesting.FirstConnectionID,
+			TestPortID,
+			paramsAllowMsgs,
+		},
+		{
+			"connection wide override takes precedence over params",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, "", connectionAllowMsgs))
+			},
+			ibctesting.FirstConnectionID,
+			TestPortID,
+			connectionAllowMsgs,
+		},
+		{
+			"override of another connection does not apply",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride("connection-10", "", connectionAllowMsgs))
+			},
+			ibctesting.FirstConnectionID,
+			TestPortID,
+			paramsAllowMsgs,
+		},
+		{
+			"controller port prefix override takes precedence over connection wide override",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, "", connectionAllowMsgs))
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix, prefixAllowMsgs))
+			},
+			ibctesting.FirstConnectionID,
+			TestPortID,
+			prefixAllowMsgs,
+		},
+		{
+			"longest matching controller port prefix takes precedence",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, TestPortID, longerPrefixAllowMsgs))
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix, prefixAllowMsgs))
+			},
+			ibctesting.FirstConnectionID,
+			TestPortID,
+			longerPrefixAllowMsgs,
+		},
+		{
+			"controller port prefix override does not apply to other ports",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, TestPortID, prefixAllowMsgs))
+			},
+			ibctesting.FirstConnectionID,
+			icatypes.ControllerPortPrefix + "other",
+			paramsAllowMsgs,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, paramsAllowMsgs, nil))
+
+			tc.malleate()
+
+			allowMsgs := suite.chainA.GetSimApp().ICAHostKeeper.GetAllowMessages(suite.chainA.GetContext(), tc.connectionID, tc.portID)
+			suite.Require().Equal(tc.expAllowMsgs, allowMsgs)
+		})
+	}
+}
@@ -44,3 +44,16 @@ func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 		),
 	)
 }
+
+// EmitExecutionFeeEvent emits an event signalling that an execution fee was charged to an interchain account.
+func EmitExecutionFeeEvent(ctx sdk.Context, interchainAccountAddr, feeRecipient string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeExecutionFee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyInterchainAccount, interchainAccountAddr),
+			sdk.NewAttribute(icatypes.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(icatypes.AttributeKeyFeeRecipient, feeRecipient),
+		),
+	)
+}
@@ -37,6 +37,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		panic(fmt.Errorf("could not set ica host params at genesis: %v", err))
 	}
 	keeper.SetParams(ctx, state.Params)
+
+	for _, allowListOverride := range state.AllowListOverrides {
+		keeper.SetAllowListOverride(ctx, allowListOverride)
+	}
 }
 
 // ExportGenesis returns the interchain accounts host exported genesis
@@ -46,5 +50,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllAllowListOverrides(ctx),
 	)
 }
@@ -27,6 +27,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			},
 		},
 		Port: icatypes.HostPortID,
+		AllowListOverrides: []types.AllowListOverride{
+			types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}),
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -43,6 +46,9 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 
+	allowListOverrides := suite.chainA.GetSimApp().ICAHostKeeper.GetAllAllowListOverrides(suite.chainA.GetContext())
+	suite.Require().Equal(genesisState.AllowListOverrides, allowListOverrides)
+
 	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 	suite.Require().True(store.Has(icatypes.KeyPort(icatypes.HostPortID)))
 
@@ -58,10 +64,10 @@ func (suite *KeeperTestSuite) TestGenesisParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: non-default params", types.NewParams(!types.DefaultHostEnabled, []string{"/cosmos.staking.v1beta1.MsgDelegate"}), true},
-		{"success: set empty byte for allow messages", types.NewParams(true, nil), true},
-		{"failure: set empty string for allow messages", types.NewParams(true, []string{""}), false},
-		{"failure: set space string for allow messages", types.NewParams(true, []string{" "}), false},
+		{"success: non-default params", types.NewParams(!types.DefaultHostEnabled, []string{"/cosmos.staking.v1beta1.MsgDelegate"}, nil), true},
+		{"success: set empty byte for allow messages", types.NewParams(true, nil, nil), true},
+		{"failure: set empty string for allow messages", types.NewParams(true, []string{""}, nil), false},
+		{"failure: set space string for allow messages", types.NewParams(true, []string{" "}, nil), false},
 	}
 
 	for _, tc := range testCases {
@@ -123,6 +129,9 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
+	allowListOverride := types.NewAllowListOverride(path.EndpointB.ConnectionID, icatypes.ControllerPortPrefix, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainB.GetContext(), allowListOverride)
+
 	genesisState := keeper.ExportGenesis(suite.chainB.GetContext(), suite.chainB.GetSimApp().ICAHostKeeper)
 
 	suite.Require().Equal(path.EndpointB.ChannelID, genesisState.ActiveChannels[0].ChannelId)
@@ -135,4 +144,6 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
+
+	suite.Require().Equal([]types.AllowListOverride{allowListOverride}, genesisState.GetAllowListOverrides())
 }
@@ -2,10 +2,19 @@ package keeper
 
 import (
 	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -19,3 +28,173 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// AllowListOverrides implements the Query/AllowListOverrides gRPC method
+func (k Keeper) AllowListOverrides(c context.Context, req *types.QueryAllowListOverridesRequest) (*types.QueryAllowListOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	keyPrefix := []byte(types.AllowListOverrideKeyPrefix + "/")
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		keyPrefix = types.KeyAllowListOverrideConnectionPrefix(req.ConnectionId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	var allowListOverrides []types.AllowListOverride
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var allowListOverride types.AllowListOverride
+		if err := k.cdc.Unmarshal(value, &allowListOverride); err != nil {
+			return err
+		}
+
+		allowListOverrides = append(allowListOverrides, allowListOverride)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowListOverridesResponse{
+		AllowListOverrides: allowListOverrides,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// AllowedMessages implements the Query/AllowedMessages gRPC method
+func (k Keeper) AllowedMessages(c context.Context, req *types.QueryAllowedMessagesRequest) (*types.QueryAllowedMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	allowListOverride, found := k.GetMatchingAllowListOverride(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return &types.QueryAllowedMessagesResponse{
+			AllowMessages: k.GetParams(ctx).AllowMessages,
+		}, nil
+	}
+
+	return &types.QueryAllowedMessagesResponse{
+		AllowMessages:     allowListOverride.AllowMessages,
+		AllowListOverride: &allowListOverride,
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	var interchainAccounts []types.InterchainAccountRegistration
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the form {portID}/{connectionID} within the owner key prefix
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 || (req.ConnectionId != "" && keySplit[1] != req.ConnectionId) {
+			return false, nil
+		}
+
+		if accumulate {
+			interchainAccounts = append(interchainAccounts, types.InterchainAccountRegistration{
+				ConnectionId: keySplit[1],
+				PortId:       keySplit[0],
+				Address:      string(value),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// InterchainAccount implements the Query/InterchainAccount gRPC method
+func (k Keeper) InterchainAccount(c context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	interchainAccount, found := k.GetInterchainAccountRegistration(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "interchain account not found for address %s", req.Address)
+	}
+
+	return &types.QueryInterchainAccountResponse{
+		InterchainAccount: interchainAccount,
+	}, nil
+}
+
+// SimulateTx implements the Query/SimulateTx gRPC method
+func (k Keeper) SimulateTx(c context.Context, req *types.QuerySimulateTxRequest) (*types.QuerySimulateTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channelID, found := k.GetActiveChannelID(ctx, req.ConnectionId, req.PortId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "failed to retrieve active channel for port %s on connection %s", req.PortId, req.ConnectionId)
+	}
+
+	metadata, err := k.getAppMetadata(ctx, icatypes.HostPortID, channelID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	msgs, err := icatypes.DeserializeCosmosTx(k.cdc, req.Data, metadata.Encoding)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to deserialize interchain account transaction: %s", err)
+	}
+
+	if !k.GetParams(ctx).HostEnabled {
+		return &types.QuerySimulateTxResponse{Error: types.ErrHostSubModuleDisabled.Error()}, nil
+	}
+
+	return k.simulateTx(ctx, req.ConnectionId, req.PortId, msgs, req.GasLimit), nil
+}
@@ -1,7 +1,19 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -10,3 +22,548 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryAllowListOverrides() {
+	var req *types.QueryAllowListOverridesRequest
+
+	connectionOverride := types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"})
+	prefixOverride := types.NewAllowListOverride(ibctesting.FirstConnectionID, icatypes.ControllerPortPrefix, []string{"*"})
+	otherConnectionOverride := types.NewAllowListOverride("connection-10", "", nil)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expected []types.AllowListOverride
+		expErr   error
+	}{
+		{
+			"success: all overrides",
+			func() {
+				req = &types.QueryAllowListOverridesRequest{}
+			},
+			[]types.AllowListOverride{connectionOverride, prefixOverride, otherConnectionOverride},
+			nil,
+		},
+		{
+			"success: overrides filtered by connection",
+			func() {
+				req = &types.QueryAllowListOverridesRequest{ConnectionId: ibctesting.FirstConnectionID}
+			},
+			[]types.AllowListOverride{connectionOverride, prefixOverride},
+			nil,
+		},
+		{
+			"success: paginated overrides",
+			func() {
+				req = &types.QueryAllowListOverridesRequest{Pagination: &query.PageRequest{Limit: 1}}
+			},
+			[]types.AllowListOverride{connectionOverride},
+			nil,
+		},
+		{
+			"failure: invalid connection identifier",
+			func() {
+				req = &types.QueryAllowListOverridesRequest{ConnectionId: "invalid|connection"}
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "identifier invalid|connection cannot contain separator '/'"),
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			for _, allowListOverride := range []types.AllowListOverride{connectionOverride, prefixOverride, otherConnectionOverride} {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(ctx, allowListOverride)
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.AllowListOverrides(ctx, req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expected, res.AllowListOverrides)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAllowedMessages() {
+	var (
+		req               *types.QueryAllowedMessagesRequest
+		allowListOverride *types.AllowListOverride
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expAllowMsg []string
+		expErr      error
+	}{
+		{
+			"success: no override returns allow messages param",
+			func() {},
+			types.DefaultParams().AllowMessages,
+			nil,
+		},
+		{
+			"success: matching override",
+			func() {
+				override := types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"})
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), override)
+				allowListOverride = &override
+			},
+			[]string{"/cosmos.bank.v1beta1.MsgSend"},
+			nil,
+		},
+		{
+			"success: override of another connection does not apply",
+			func() {
+				override := types.NewAllowListOverride("connection-10", "", []string{"/cosmos.bank.v1beta1.MsgSend"})
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), override)
+			},
+			types.DefaultParams().AllowMessages,
+			nil,
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				req.PortId = "invalid|port"
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "invalid port"),
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			nil,
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			req = &types.QueryAllowedMessagesRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       TestPortID,
+			}
+			allowListOverride = nil
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.AllowedMessages(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAllowMsg, res.AllowMessages)
+				suite.Require().Equal(allowListOverride, res.AllowListOverride)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req                   *types.QueryInterchainAccountsRequest
+		expInterchainAccounts []types.InterchainAccountRegistration
+		expNextKeyNull        bool
+	)
+
+	// interchain accounts are ordered by controller port and host connection
+	interchainAccounts := []types.InterchainAccountRegistration{
+		{ConnectionId: ibctesting.FirstConnectionID, PortId: icatypes.ControllerPortPrefix + "owner-a", Address: "address-a"},
+		{ConnectionId: "connection-1", PortId: icatypes.ControllerPortPrefix + "owner-a", Address: "address-b"},
+		{ConnectionId: ibctesting.FirstConnectionID, PortId: icatypes.ControllerPortPrefix + "owner-b", Address: "address-c"},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: filtered by connection",
+			func() {
+				req.ConnectionId = ibctesting.FirstConnectionID
+				expInterchainAccounts = []types.InterchainAccountRegistration{interchainAccounts[0], interchainAccounts[2]}
+			},
+			nil,
+		},
+		{
+			"success: with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 2}
+				expInterchainAccounts = interchainAccounts[:2]
+				expNextKeyNull = false
+			},
+			nil,
+		},
+		{
+			"success: connection without interchain accounts",
+			func() {
+				req.ConnectionId = "connection-10"
+				expInterchainAccounts = nil
+			},
+			nil,
+		},
+		{
+			"failure: invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			status.Error(codes.InvalidArgument, "invalid connection"),
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			for _, interchainAccount := range interchainAccounts {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), interchainAccount.ConnectionId, interchainAccount.PortId, interchainAccount.Address)
+			}
+
+			req = &types.QueryInterchainAccountsRequest{}
+			expInterchainAccounts = interchainAccounts
+			expNextKeyNull = true
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAHostKeeper.InterchainAccounts(suite.chainA.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expInterchainAccounts, res.InterchainAccounts)
+				suite.Require().Equal(expNextKeyNull, res.Pagination.NextKey == nil)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var (
+		path *ibctesting.Path
+		req  *types.QueryInterchainAccountRequest
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: account is not an interchain account",
+			func() {
+				req.Address = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			status.Error(codes.NotFound, "interchain account not found"),
+		},
+		{
+			"failure: invalid address",
+			func() {
+				req.Address = "invalid"
+			},
+			status.Error(codes.InvalidArgument, "invalid address"),
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			req = &types.QueryInterchainAccountRequest{
+				Address: interchainAccountAddr,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccount(suite.chainB.GetContext(), req)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				expInterchainAccount := types.InterchainAccountRegistration{
+					ConnectionId: path.EndpointB.ConnectionID,
+					PortId:       path.EndpointA.ChannelConfig.PortID,
+					Address:      interchainAccountAddr,
+				}
+				suite.Require().Equal(expInterchainAccount, res.InterchainAccount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySimulateTx() {
+	var (
+		path        *ibctesting.Path
+		req         *types.QuerySimulateTxRequest
+		msg         *banktypes.MsgSend
+		expGasLimit uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError string
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			"",
+			nil,
+		},
+		{
+			"success: requested gas limit applies",
+			func() {
+				req.GasLimit = 1_000_000
+				expGasLimit = 1_000_000
+			},
+			"",
+			nil,
+		},
+		{
+			"success: requested gas limit is capped by the maximum execution gas",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxExecutionGas = 1_000_000
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				req.GasLimit = 5_000_000
+				expGasLimit = 1_000_000
+			},
+			"",
+			nil,
+		},
+		{
+			"success: execution fee is charged",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.ExecutionGasPrice = "0.1" + sdk.DefaultBondDenom
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			"",
+			nil,
+		},
+		{
+			"success: execution fails with out of gas",
+			func() {
+				req.GasLimit = 1000
+				expGasLimit = 1000
+			},
+			"out of gas",
+			nil,
+		},
+		{
+			"success: execution fails as the execution fee cannot be paid",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.ExecutionGasPrice = "1000" + sdk.DefaultBondDenom
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			"insufficient funds",
+			nil,
+		},
+		{
+			"success: execution fails with message type not allowed",
+			func() {
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgMultiSend)(nil))}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			"message type not allowed",
+			nil,
+		},
+		{
+			"success: execution fails with insufficient funds",
+			func() {
+				msg.Amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000000)))
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+				suite.Require().NoError(err)
+				req.Data = data
+			},
+			"insufficient funds",
+			nil,
+		},
+		{
+			"success: host submodule disabled",
+			func() {
+				params := types.NewParams(false, []string{"*"}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrHostSubModuleDisabled.Error(),
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			"",
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"failure: invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid|connection"
+			},
+			"",
+			status.Error(codes.InvalidArgument, "invalid connection"),
+		},
+		{
+			"failure: active channel not found",
+			func() {
+				req.ConnectionId = "connection-10"
+			},
+			"",
+			status.Error(codes.NotFound, "active channel not found"),
+		},
+		{
+			"failure: invalid transaction data",
+			func() {
+				req.Data = []byte("invalid data")
+			},
+			"",
+			status.Error(codes.InvalidArgument, "invalid data"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg = &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			req = &types.QuerySimulateTxRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				PortId:       path.EndpointA.ChannelConfig.PortID,
+				Data:         data,
+			}
+			expGasLimit = 0
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			balanceBefore := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr))
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.SimulateTx(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				if tc.expError == "" {
+					suite.Require().Empty(res.Error)
+					suite.Require().Len(res.TxMsgData.MsgResponses, 1)
+					suite.Require().NotZero(res.GasUsed)
+					suite.Require().NotEmpty(res.Events)
+
+					expFee, err := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(ctx).ExecutionFee(res.GasUsed)
+					suite.Require().NoError(err)
+					suite.Require().Equal(expFee, res.ExecutionFee)
+				} else {
+					suite.Require().Contains(res.Error, tc.expError)
+					suite.Require().Nil(res.TxMsgData)
+				}
+
+				suite.Require().Equal(expGasLimit, res.GasLimit)
+
+				// the simulation never modifies state
+				balanceAfter := suite.chainB.GetSimApp().BankKeeper.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(interchainAccountAddr))
+				suite.Require().Equal(balanceBefore, balanceAfter)
+				suite.Require().Empty(ctx.EventManager().Events())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -33,10 +33,12 @@ type Keeper struct {
 	channelKeeper icatypes.ChannelKeeper
 	portKeeper    icatypes.PortKeeper
 	accountKeeper icatypes.AccountKeeper
+	bankKeeper    icatypes.BankKeeper
 
 	scopedKeeper exported.ScopedKeeper
 
-	msgRouter icatypes.MessageRouter
+	msgRouter   icatypes.MessageRouter
+	queryRouter icatypes.QueryRouter
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -47,8 +49,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Codec, key storetypes.StoreKey, legacySubspace icatypes.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	accountKeeper icatypes.AccountKeeper, scopedKeeper exported.ScopedKeeper, msgRouter icatypes.MessageRouter,
-	authority string,
+	accountKeeper icatypes.AccountKeeper, bankKeeper icatypes.BankKeeper, scopedKeeper exported.ScopedKeeper,
+	msgRouter icatypes.MessageRouter, queryRouter icatypes.QueryRouter, authority string,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -67,8 +69,10 @@ func NewKeeper(
 		channelKeeper:  channelKeeper,
 		portKeeper:     portKeeper,
 		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 		scopedKeeper:   scopedKeeper,
 		msgRouter:      msgRouter,
+		queryRouter:    queryRouter,
 		authority:      authority,
 	}
 }
@@ -226,6 +230,37 @@ func (k Keeper) GetAllInterchainAccounts(ctx sdk.Context) []genesistypes.Registe
 	return interchainAccounts
 }
 
+// GetInterchainAccountRegistration retrieves the connectionID and controller portID over which the interchain account with
+// the provided address was registered. The controller portID is obtained from the account owner of the interchain
+// account, such that only the accounts registered by that port need to be iterated over.
+func (k Keeper) GetInterchainAccountRegistration(ctx sdk.Context, address sdk.AccAddress) (types.InterchainAccountRegistration, bool) {
+	interchainAccount, ok := k.accountKeeper.GetAccount(ctx, address).(*icatypes.InterchainAccount)
+	if !ok {
+		return types.InterchainAccountRegistration{}, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	// the empty connectionID yields the key prefix of all interchain accounts registered by the controller port
+	iterator := storetypes.KVStorePrefixIterator(store, icatypes.KeyOwnerAccount(interchainAccount.AccountOwner, ""))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) != address.String() {
+			continue
+		}
+
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		return types.InterchainAccountRegistration{
+			ConnectionId: keySplit[2],
+			PortId:       keySplit[1],
+			Address:      address.String(),
+		}, true
+	}
+
+	return types.InterchainAccountRegistration{}, false
+}
+
 // SetInterchainAccountAddress stores the InterchainAccount address, keyed by the associated connectionID and portID
 func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string) {
 	store := ctx.KVStore(k.storeKey)
@@ -34,3 +34,31 @@ func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetAllowListOverride creates or replaces an allow list override of the host submodule.
+func (m msgServer) SetAllowListOverride(goCtx context.Context, msg *types.MsgSetAllowListOverride) (*types.MsgSetAllowListOverrideResponse, error) {
+	if m.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", m.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.Keeper.SetAllowListOverride(ctx, msg.AllowListOverride)
+
+	return &types.MsgSetAllowListOverrideResponse{}, nil
+}
+
+// RemoveAllowListOverride removes an allow list override of the host submodule.
+func (m msgServer) RemoveAllowListOverride(goCtx context.Context, msg *types.MsgRemoveAllowListOverride) (*types.MsgRemoveAllowListOverrideResponse, error) {
+	if m.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", m.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetAllowListOverride(ctx, msg.ConnectionId, msg.ControllerPortPrefix); !found {
+		return nil, errorsmod.Wrapf(types.ErrAllowListOverrideNotFound, "connection ID %s, controller port prefix %s", msg.ConnectionId, msg.ControllerPortPrefix)
+	}
+
+	m.DeleteAllowListOverride(ctx, msg.ConnectionId, msg.ControllerPortPrefix)
+
+	return &types.MsgRemoveAllowListOverrideResponse{}, nil
+}
@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -43,3 +46,121 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetAllowListOverride() {
+	var msg *types.MsgSetAllowListOverride
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: replaces existing override",
+			func() {
+				suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainA.GetContext(), types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"*"}))
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			allowListOverride := types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"})
+			msg = types.NewMsgSetAllowListOverride(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), allowListOverride)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.SetAllowListOverride(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				storedOverride, found := suite.chainA.GetSimApp().ICAHostKeeper.GetAllowListOverride(ctx, ibctesting.FirstConnectionID, "")
+				suite.Require().True(found)
+				suite.Require().Equal(allowListOverride, storedOverride)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveAllowListOverride() {
+	var msg *types.MsgRemoveAllowListOverride
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: allow list override not found",
+			func() {
+				msg.ControllerPortPrefix = icatypes.ControllerPortPrefix
+			},
+			types.ErrAllowListOverrideNotFound,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().ICAHostKeeper.SetAllowListOverride(ctx, types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"}))
+
+			msg = types.NewMsgRemoveAllowListOverride(suite.chainA.GetSimApp().ICAHostKeeper.GetAuthority(), ibctesting.FirstConnectionID, "")
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAHostKeeper)
+			res, err := msgServer.RemoveAllowListOverride(ctx, msg)
+
+			_, found := suite.chainA.GetSimApp().ICAHostKeeper.GetAllowListOverride(ctx, ibctesting.FirstConnectionID, "")
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
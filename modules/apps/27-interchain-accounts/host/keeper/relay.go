@@ -1,12 +1,19 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -16,7 +23,9 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+// If the queries are successfully executed, the query response bytes will be returned.
+// The execution fee of the transaction, if any, may be paid to the provided relayer address.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 	err := data.UnmarshalJSON(packet.GetData())
 	if err != nil {
@@ -36,11 +45,31 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		gasLimit, err := data.GetExecutionGasLimit()
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to obtain interchain account transaction gas limit")
+		}
+
+		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs, gasLimit, relayer)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
 		return txResponse, nil
+	case icatypes.QUERY:
+		if !metadata.SupportsQueries() {
+			return nil, errorsmod.Wrapf(icatypes.ErrUnsupported, "query packets are not supported by tx type %s", metadata.TxType)
+		}
+
+		requests, err := icatypes.DeserializeCosmosQuery(k.cdc, data.Data, metadata.Encoding)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account query")
+		}
+
+		queryResponse, err := k.executeQuery(ctx, requests)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute interchain account query")
+		}
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
@@ -50,13 +79,146 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+// The execution is limited to the gas limit requested by the controller, capped by the maximum execution gas. If an
+// execution gas price is set, the fee for the gas consumed is charged to the interchain account once it succeeds.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg, requestedGasLimit uint64, relayer sdk.AccAddress) ([]byte, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	params := k.GetParams(ctx)
+	gasLimit := params.ExecutionGasLimit(requestedGasLimit)
+
+	txMsgData, gasUsed, err := k.executeMsgsWithGasLimit(ctx, channel.ConnectionHops[0], sourcePort, msgs, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.chargeExecutionFee(ctx, params, channel.ConnectionHops[0], sourcePort, gasUsed, relayer); err != nil {
+		return nil, err
+	}
+
+	txResponse, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+
+	return txResponse, nil
+}
+
+// simulateTx executes the provided msgs in the same way as executeTx, against a branched context whose state changes
+// are always discarded. The execution is limited to the requested gas limit, capped by the maximum execution gas, and
+// the execution fee is charged to the interchain account if it succeeds. The result of the execution, the gas consumed,
+// the gas limit applied, the fee charged and the events emitted are returned.
+func (k Keeper) simulateTx(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, requestedGasLimit uint64) *types.QuerySimulateTxResponse {
+	// the simulation must never modify state, the cache is therefore never written
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	params := k.GetParams(ctx)
+	res := &types.QuerySimulateTxResponse{
+		GasLimit: params.ExecutionGasLimit(requestedGasLimit),
+	}
+
+	txMsgData, gasUsed, err := k.executeMsgsWithGasLimit(cacheCtx, connectionID, portID, msgs, res.GasLimit)
+	res.GasUsed = gasUsed
+
+	if err == nil {
+		// the relayer of the packet is unknown, the host module account is used as the recipient of the fee instead
+		relayer := k.accountKeeper.GetModuleAddress(icatypes.ModuleName)
+		if err = k.chargeExecutionFee(cacheCtx, params, connectionID, portID, gasUsed, relayer); err == nil {
+			res.TxMsgData = txMsgData
+			res.ExecutionFee, err = params.ExecutionFee(gasUsed)
+		}
+	}
+
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	res.Events = cacheCtx.EventManager().ABCIEvents()
+	return res
+}
+
+// executeMsgsWithGasLimit executes the provided msgs in the same way as executeMsgs, limiting the gas they may consume
+// to the provided gas limit. No limit is enforced if the gas limit is zero. Running out of gas is returned as an
+// ErrOutOfGas error. The gas consumed by the execution is returned and charged to the gas meter of the context.
+func (k Keeper) executeMsgsWithGasLimit(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg, gasLimit uint64) (txMsgData *sdk.TxMsgData, gasUsed uint64, err error) {
+	if gasLimit == 0 {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		txMsgData, err = k.executeMsgs(ctx, connectionID, portID, msgs)
+		return txMsgData, ctx.GasMeter().GasConsumed() - gasBefore, err
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			txMsgData = nil
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+		}
+
+		gasUsed = gasMeter.GasConsumedToLimit()
+		ctx.GasMeter().ConsumeGas(gasUsed, "interchain account transaction execution")
+	}()
+
+	txMsgData, err = k.executeMsgs(ctx.WithGasMeter(gasMeter), connectionID, portID, msgs)
+	return txMsgData, gasUsed, err
+}
+
+// chargeExecutionFee charges the execution fee for the provided amount of gas consumed to the interchain account
+// registered over the host connection by the controller port. The fee is paid to the recipient set in the host
+// parameters. No fee is charged if the execution gas price is not set.
+func (k Keeper) chargeExecutionFee(ctx sdk.Context, params types.Params, connectionID, portID string, gasUsed uint64, relayer sdk.AccAddress) error {
+	fee, err := params.ExecutionFee(gasUsed)
+	if err != nil {
+		return err
+	}
+
+	if fee.IsZero() {
+		return nil
+	}
+
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
+	}
+
+	payer, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	if err != nil {
+		return err
+	}
+
+	var recipient sdk.AccAddress
+	switch params.ExecutionFeeRecipient {
+	case types.RELAYER:
+		recipient = relayer
+		err = k.bankKeeper.SendCoins(ctx, payer, recipient, fee)
+	case types.FEE_COLLECTOR:
+		recipient = k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, fee)
+	default:
+		err = fmt.Errorf("unsupported execution fee recipient %s", params.ExecutionFeeRecipient)
+	}
+
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to charge execution fee %s", fee)
+	}
+
+	EmitExecutionFeeEvent(ctx, interchainAccountAddr, recipient.String(), fee)
+
+	return nil
+}
+
+// executeMsgs authenticates the provided msgs for the interchain account registered over the host connection by the
+// controller port, and delivers each message into state. State changes are only committed if all messages succeed.
+func (k Keeper) executeMsgs(ctx sdk.Context, connectionID, portID string, msgs []sdk.Msg) (*sdk.TxMsgData, error) {
+	if err := k.authenticateTx(ctx, msgs, connectionID, portID); err != nil {
 		return nil, err
 	}
 
@@ -84,28 +246,28 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
-	}
-
-	return txResponse, nil
+	return txMsgData, nil
 }
 
-// authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
-// from state using the provided controller port identifier
+// authenticateTx ensures the provided msgs are allowed, satisfy the host message policies and contain the correct
+// interchain account signer address retrieved from state using the provided controller port identifier
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
 	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
 	if !found {
 		return errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetParams(ctx).AllowMessages
+	allowMsgs := k.GetAllowMessages(ctx, connectionID, portID)
+	messagePolicies := k.GetParams(ctx).MessagePolicies
 	for _, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
 			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
 		}
 
+		if err := types.CheckMessagePolicies(k.cdc, messagePolicies, msg); err != nil {
+			return err
+		}
+
 		// obtain the message signers using the proto signer annotations
 		// the msgv2 return value is discarded as it is not used
 		signers, _, err := k.cdc.GetMsgV1Signers(msg)
@@ -126,6 +288,49 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 	return nil
 }
 
+// executeQuery attempts to execute the provided gRPC queries. Each query path must be present in the host
+// query allow list. The queries are executed against a branched context whose state changes are always
+// discarded. The proto marshaled CosmosQueryResponse is returned if all queries succeed.
+func (k Keeper) executeQuery(ctx sdk.Context, requests []icatypes.QueryRequest) ([]byte, error) {
+	allowQueries := k.GetParams(ctx).AllowQueries
+
+	queryResponse := &icatypes.CosmosQueryResponse{
+		Responses: make([][]byte, len(requests)),
+		Height:    ctx.BlockHeight(),
+	}
+
+	// queries must never modify state, the cache is therefore never written
+	cacheCtx, _ := ctx.CacheContext()
+	for i, request := range requests {
+		if !types.ContainsQueryPath(allowQueries, request.Path) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "query path not allowed: %s", request.Path)
+		}
+
+		route := k.queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(icatypes.ErrInvalidRoute, "no route found for query path %s", request.Path)
+		}
+
+		res, err := route(cacheCtx, &abci.RequestQuery{
+			Path:   request.Path,
+			Data:   request.Data,
+			Height: ctx.BlockHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse.Responses[i] = res.Value
+	}
+
+	bz, err := proto.Marshal(queryResponse)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal query response")
+	}
+
+	return bz, nil
+}
+
 // Attempts to get the message handler from the router and if found will then execute the message.
 // If the message execution is successful, the proto marshaled message response will be returned.
 func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error) {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -9,13 +10,17 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -75,7 +80,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{"*"})
+				params := types.NewParams(true, []string{"*"}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -102,11 +107,41 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by an allow list override",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				allowListOverride := types.NewAllowListOverride(ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, []string{sdk.MsgTypeURL(msg)})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainB.GetContext(), allowListOverride)
+			},
+			nil,
+		},
 		{
 			"interchain account successfully executes stakingtypes.MsgDelegate",
 			func(encoding string) {
@@ -130,7 +165,41 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"interchain account successfully executes stakingtypes.MsgDelegate satisfying message policies",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+				msg := &stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: validatorAddr.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
+				params.MessagePolicies = []types.MessagePolicy{
+					types.NewMessagePolicy("delegations", sdk.MsgTypeURL(msg),
+						types.NewPolicyRule("validator_address", types.ADDRESS_IN, validatorAddr.String()),
+						types.NewPolicyRule("amount", types.COINS_MAX, "10000"+sdk.DefaultBondDenom),
+					),
+				}
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -164,7 +233,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -199,7 +268,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -243,7 +312,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -269,7 +338,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -295,7 +364,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -330,7 +399,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -364,7 +433,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			ibcerrors.ErrInvalidAddress,
@@ -384,7 +453,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{"/" + proto.MessageName(msg)})
+				params := types.NewParams(true, []string{"/" + proto.MessageName(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			icatypes.ErrUnknownDataType,
@@ -482,11 +551,71 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			ibcerrors.ErrUnauthorized,
 		},
+		{
+			"unauthorised: message type not allowed by allow list override",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"}, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				allowListOverride := types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{sdk.MsgTypeURL((*stakingtypes.MsgDelegate)(nil))})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetAllowListOverride(suite.chainB.GetContext(), allowListOverride)
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"unauthorised: message denied by message policy",
+			func(encoding string) {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				params := types.NewParams(true, []string{"*"}, nil)
+				params.MessagePolicies = []types.MessagePolicy{
+					types.NewMessagePolicy("max-send", sdk.MsgTypeURL(msg), types.NewPolicyRule("amount", types.COINS_MAX, "50"+sdk.DefaultBondDenom)),
+				}
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrMessagePolicyViolation,
+		},
 	}
 
 	for _, encoding := range testedEncodings {
@@ -531,7 +660,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					0,
 				)
 
-				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+				txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
 
 				expPass := tc.expErr == nil
 				if expPass {
@@ -546,6 +675,209 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketExecutionGasAndFee() {
+	var (
+		path   *ibctesting.Path
+		params types.Params
+		memo   string
+	)
+
+	relayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	sendAmount := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expRecipient func() sdk.AccAddress
+		expErr       error
+	}{
+		{
+			"success: no gas limit and no execution fee",
+			func() {},
+			nil,
+			nil,
+		},
+		{
+			"success: requested gas limit is sufficient",
+			func() {
+				memo = `{"ica_execution": {"gas_limit": "1000000"}}`
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: maximum execution gas is sufficient",
+			func() {
+				params.MaxExecutionGas = 1_000_000
+			},
+			nil,
+			nil,
+		},
+		{
+			"success: execution fee paid to the relayer",
+			func() {
+				params.ExecutionGasPrice = "0.1" + sdk.DefaultBondDenom
+				params.ExecutionFeeRecipient = types.RELAYER
+			},
+			func() sdk.AccAddress { return relayer },
+			nil,
+		},
+		{
+			"success: execution fee paid to the fee collector",
+			func() {
+				params.ExecutionGasPrice = "0.1" + sdk.DefaultBondDenom
+				params.ExecutionFeeRecipient = types.FEE_COLLECTOR
+			},
+			func() sdk.AccAddress {
+				return suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			},
+			nil,
+		},
+		{
+			"failure: requested gas limit is exceeded",
+			func() {
+				memo = `{"ica_execution": {"gas_limit": "1000"}}`
+			},
+			nil,
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: maximum execution gas is exceeded",
+			func() {
+				params.MaxExecutionGas = 1000
+			},
+			nil,
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: requested gas limit is capped by the maximum execution gas",
+			func() {
+				memo = `{"ica_execution": {"gas_limit": "1000000"}}`
+				params.MaxExecutionGas = 1000
+			},
+			nil,
+			sdkerrors.ErrOutOfGas,
+		},
+		{
+			"failure: invalid requested gas limit",
+			func() {
+				memo = `{"ica_execution": {"gas_limit": "invalid"}}`
+			},
+			nil,
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: interchain account cannot pay the execution fee",
+			func() {
+				params.ExecutionGasPrice = "1000" + sdk.DefaultBondDenom
+			},
+			nil,
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			params = types.DefaultParams()
+			memo = ""
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sendAmount),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: memo,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				1,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			var recipient sdk.AccAddress
+			if tc.expRecipient != nil {
+				recipient = tc.expRecipient()
+			}
+
+			ctx := suite.chainB.GetContext()
+			icaBalanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			recipientBalanceBefore := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, relayer)
+
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+
+				if errors.Is(tc.expErr, sdkerrors.ErrOutOfGas) {
+					// the gas consumed up to the limit is still charged to the context gas meter
+					suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(1000))
+				}
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(txResponse)
+
+			icaBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			fee := icaBalanceBefore.Sub(icaBalance).Sub(sendAmount)
+
+			if tc.expRecipient == nil {
+				suite.Require().True(fee.IsZero())
+				return
+			}
+
+			suite.Require().True(fee.IsPositive())
+			recipientBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+			suite.Require().Equal(recipientBalanceBefore.Add(fee), recipientBalance)
+
+			expEvent := sdk.NewEvent(
+				icatypes.EventTypeExecutionFee,
+				sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+				sdk.NewAttribute(icatypes.AttributeKeyInterchainAccount, interchainAccountAddr),
+				sdk.NewAttribute(icatypes.AttributeKeyFee, fee.String()),
+				sdk.NewAttribute(icatypes.AttributeKeyFeeRecipient, recipient.String()),
+			)
+			suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	var (
 		path       *ibctesting.Path
@@ -597,7 +929,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{"*"})
+				params := types.NewParams(true, []string{"*"}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -622,7 +954,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgSend)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgSend)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -651,7 +983,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgSubmitProposal)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgSubmitProposal)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -693,7 +1025,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgVote)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgVote)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -734,7 +1066,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgSubmitProposal)(nil)), sdk.MsgTypeURL((*govtypes.MsgDeposit)(nil)), sdk.MsgTypeURL((*govtypes.MsgVote)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*govtypes.MsgSubmitProposal)(nil)), sdk.MsgTypeURL((*govtypes.MsgDeposit)(nil)), sdk.MsgTypeURL((*govtypes.MsgVote)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -767,7 +1099,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*transfertypes.MsgTransfer)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*transfertypes.MsgTransfer)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			nil,
@@ -783,7 +1115,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{"*"})
+				params := types.NewParams(true, []string{"*"}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			icatypes.ErrUnknownDataType,
@@ -808,7 +1140,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*transfertypes.MsgTransfer)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*transfertypes.MsgTransfer)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			ibcerrors.ErrUnauthorized,
@@ -833,7 +1165,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 					"data":` + byteArrayString + `
 				}`)
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgSend)(nil))})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL((*banktypes.MsgSend)(nil))}, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			icatypes.ErrUnknownDataType,
@@ -874,7 +1206,7 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 				0,
 			)
 
-			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
 
 			expPass := tc.expErr == nil
 			if expPass {
@@ -888,6 +1220,176 @@ func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvQueryPacket() {
+	testedEncodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+	var (
+		path     *ibctesting.Path
+		requests []icatypes.QueryRequest
+		icaAddr  string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple queries using the * (allow all query paths) param",
+			func() {
+				req, err := suite.chainB.Codec.Marshal(&banktypes.QueryBalanceRequest{Address: icaAddr, Denom: sdk.DefaultBondDenom})
+				suite.Require().NoError(err)
+
+				requests = append(requests, icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", req))
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{"*"}, []string{"*"}))
+			},
+			nil,
+		},
+		{
+			"channel tx type does not support queries",
+			func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) {
+					metadata, err := icatypes.MetadataFromVersion(channel.Version)
+					suite.Require().NoError(err)
+
+					metadata.TxType = icatypes.TxTypeSDKMultiMsg
+					channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				})
+			},
+			icatypes.ErrUnsupported,
+		},
+		{
+			"query path not allowed",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{"*"}, []string{"/cosmos.bank.v1beta1.Query/AllBalances"}))
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"query path not allowed by default params",
+			func() {
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.DefaultParams())
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"no route found for query path",
+			func() {
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Unknown", nil)}
+
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{"*"}, []string{"*"}))
+			},
+			icatypes.ErrInvalidRoute,
+		},
+		{
+			"empty query requests",
+			func() {
+				requests = []icatypes.QueryRequest{}
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+		{
+			"invalid query path",
+			func() {
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest("cosmos.bank.v1beta1.Query/Balance", nil)}
+			},
+			icatypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, encoding := range testedEncodings {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.msg, func() {
+				suite.SetupTest() // reset
+
+				path = NewICAPath(suite.chainA, suite.chainB, encoding)
+				metadata, err := icatypes.MetadataFromVersion(path.EndpointA.ChannelConfig.Version)
+				suite.Require().NoError(err)
+
+				metadata.TxType = icatypes.TxTypeSDKMultiMsgQuery
+				path.EndpointA.ChannelConfig.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+				path.EndpointB.ChannelConfig.Version = path.EndpointA.ChannelConfig.Version
+				path.SetupConnections()
+
+				err = SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				var found bool
+				icaAddr, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				balance := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(balance))
+
+				req, err := suite.chainB.Codec.Marshal(&banktypes.QueryBalanceRequest{Address: icaAddr, Denom: sdk.DefaultBondDenom})
+				suite.Require().NoError(err)
+
+				requests = []icatypes.QueryRequest{icatypes.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", req)}
+
+				params := types.NewParams(true, []string{"*"}, []string{"/cosmos.bank.v1beta1.Query/Balance"})
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				tc.malleate() // malleate mutates test data
+
+				data, err := icatypes.SerializeCosmosQuery(suite.chainB.GetSimApp().AppCodec(), requests, encoding)
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.QUERY,
+					Data: data,
+				}
+
+				packet := channeltypes.NewPacket(
+					icaPacketData.GetBytes(),
+					suite.chainA.SenderAccount.GetSequence(),
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID,
+					path.EndpointB.ChannelID,
+					suite.chainB.GetTimeoutHeight(),
+					0,
+				)
+
+				ctx := suite.chainB.GetContext()
+				queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+
+				expPass := tc.expErr == nil
+				if expPass {
+					suite.Require().NoError(err)
+
+					ack := channeltypes.NewResultAcknowledgement(queryResponse)
+					res, err := controllertypes.DeserializeQueryAcknowledgement(ack.Acknowledgement())
+					suite.Require().NoError(err)
+					suite.Require().Equal(ctx.BlockHeight(), res.Height)
+					suite.Require().Len(res.Responses, len(requests))
+
+					responses := make([]proto.Message, len(requests))
+					for i := range responses {
+						responses[i] = &banktypes.QueryBalanceResponse{}
+					}
+
+					err = controllertypes.UnmarshalQueryResponses(suite.chainA.Codec, res, responses...)
+					suite.Require().NoError(err)
+
+					for _, response := range responses {
+						suite.Require().Equal(balance, *response.(*banktypes.QueryBalanceResponse).Balance)
+					}
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+					suite.Require().Nil(queryResponse)
+				}
+			})
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
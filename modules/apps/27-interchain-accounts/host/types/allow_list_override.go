@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewAllowListOverride creates a new AllowListOverride instance
+func NewAllowListOverride(connectionID, controllerPortPrefix string, allowMsgs []string) AllowListOverride {
+	return AllowListOverride{
+		ConnectionId:         connectionID,
+		ControllerPortPrefix: controllerPortPrefix,
+		AllowMessages:        allowMsgs,
+	}
+}
+
+// Validate performs basic validation of the AllowListOverride. The controller port prefix may be empty,
+// in which case the override applies to all controller ports of the connection.
+func (o AllowListOverride) Validate() error {
+	if err := host.ConnectionIdentifierValidator(o.ConnectionId); err != nil {
+		return err
+	}
+
+	if err := validateControllerPortPrefix(o.ControllerPortPrefix); err != nil {
+		return err
+	}
+
+	return validateAllowlist(o.AllowMessages)
+}
+
+// Matches returns true if the AllowListOverride applies to the provided host connection and controller port, otherwise false
+func (o AllowListOverride) Matches(connectionID, portID string) bool {
+	return o.ConnectionId == connectionID && strings.HasPrefix(portID, o.ControllerPortPrefix)
+}
+
+// validateControllerPortPrefix validates the controller port prefix if it is not empty
+func validateControllerPortPrefix(controllerPortPrefix string) error {
+	if controllerPortPrefix == "" {
+		return nil
+	}
+
+	return host.PortIdentifierValidator(controllerPortPrefix)
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestAllowListOverrideMatches(t *testing.T) {
+	testCases := []struct {
+		name              string
+		allowListOverride types.AllowListOverride
+		connectionID      string
+		portID            string
+		expMatch          bool
+	}{
+		{
+			"connection wide override matches any port",
+			types.NewAllowListOverride(ibctesting.FirstConnectionID, "", nil),
+			ibctesting.FirstConnectionID,
+			"icacontroller-cosmos1",
+			true,
+		},
+		{
+			"controller port prefix override matches port with prefix",
+			types.NewAllowListOverride(ibctesting.FirstConnectionID, "icacontroller-cosmos1", nil),
+			ibctesting.FirstConnectionID,
+			"icacontroller-cosmos1abc",
+			true,
+		},
+		{
+			"controller port prefix override does not match port without prefix",
+			types.NewAllowListOverride(ibctesting.FirstConnectionID, "icacontroller-cosmos1", nil),
+			ibctesting.FirstConnectionID,
+			"icacontroller-osmo1abc",
+			false,
+		},
+		{
+			"override does not match other connection",
+			types.NewAllowListOverride(ibctesting.FirstConnectionID, "", nil),
+			"connection-1",
+			"icacontroller-cosmos1",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expMatch, tc.allowListOverride.Matches(tc.connectionID, tc.portID), tc.name)
+	}
+}
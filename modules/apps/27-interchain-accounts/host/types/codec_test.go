@@ -23,6 +23,16 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			true,
 		},
+		{
+			"success: MsgSetAllowListOverride",
+			sdk.MsgTypeURL(&types.MsgSetAllowListOverride{}),
+			true,
+		},
+		{
+			"success: MsgRemoveAllowListOverride",
+			sdk.MsgTypeURL(&types.MsgRemoveAllowListOverride{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -6,5 +6,7 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled     = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrAllowListOverrideNotFound = errorsmod.Register(SubModuleName, 3, "allow list override not found")
+	ErrMessagePolicyViolation    = errorsmod.Register(SubModuleName, 4, "message policy violation")
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,6 +23,74 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExecutionFeeRecipient defines the recipients to which the execution fees charged to interchain accounts may be paid.
+type ExecutionFeeRecipient int32
+
+const (
+	// The execution fees are paid to the fee collector module account.
+	FEE_COLLECTOR ExecutionFeeRecipient = 0
+	// The execution fees are paid to the relayer of the packet.
+	RELAYER ExecutionFeeRecipient = 1
+)
+
+var ExecutionFeeRecipient_name = map[int32]string{
+	0: "EXECUTION_FEE_RECIPIENT_FEE_COLLECTOR",
+	1: "EXECUTION_FEE_RECIPIENT_RELAYER",
+}
+
+var ExecutionFeeRecipient_value = map[string]int32{
+	"EXECUTION_FEE_RECIPIENT_FEE_COLLECTOR": 0,
+	"EXECUTION_FEE_RECIPIENT_RELAYER":       1,
+}
+
+func (x ExecutionFeeRecipient) String() string {
+	return proto.EnumName(ExecutionFeeRecipient_name, int32(x))
+}
+
+func (ExecutionFeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{0}
+}
+
+// ConstraintType defines the built-in constraints which may be applied to a message field by a PolicyRule.
+type ConstraintType int32
+
+const (
+	// Default zero value enumeration
+	UNSPECIFIED ConstraintType = 0
+	// The sum of the coins in the field must not exceed the maximum coins. Denominations without a maximum are denied.
+	COINS_MAX ConstraintType = 1
+	// Every address in the field must be one of the listed addresses.
+	ADDRESS_IN ConstraintType = 2
+	// No address in the field may be one of the listed addresses.
+	ADDRESS_NOT_IN ConstraintType = 3
+	// Every enum value in the field must be one of the listed enum value names.
+	ENUM_IN ConstraintType = 4
+)
+
+var ConstraintType_name = map[int32]string{
+	0: "CONSTRAINT_TYPE_UNSPECIFIED",
+	1: "CONSTRAINT_TYPE_COINS_MAX",
+	2: "CONSTRAINT_TYPE_ADDRESS_IN",
+	3: "CONSTRAINT_TYPE_ADDRESS_NOT_IN",
+	4: "CONSTRAINT_TYPE_ENUM_IN",
+}
+
+var ConstraintType_value = map[string]int32{
+	"CONSTRAINT_TYPE_UNSPECIFIED":    0,
+	"CONSTRAINT_TYPE_COINS_MAX":      1,
+	"CONSTRAINT_TYPE_ADDRESS_IN":     2,
+	"CONSTRAINT_TYPE_ADDRESS_NOT_IN": 3,
+	"CONSTRAINT_TYPE_ENUM_IN":        4,
+}
+
+func (x ConstraintType) String() string {
+	return proto.EnumName(ConstraintType_name, int32(x))
+}
+
+func (ConstraintType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+
 // Params defines the set of on-chain interchain accounts parameters.
 // The following parameters may be used to disable the host submodule.
 type Params struct {
@@ -29,6 +98,19 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// allow_queries defines a list of gRPC query paths allowed to be executed on a host chain.
+	AllowQueries []string `protobuf:"bytes,3,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty"`
+	// message_policies defines a list of content-aware policies which allowed messages must satisfy to be executed on a
+	// host chain.
+	MessagePolicies []MessagePolicy `protobuf:"bytes,4,rep,name=message_policies,json=messagePolicies,proto3" json:"message_policies"`
+	// max_execution_gas defines the maximum amount of gas which may be consumed by the execution of a single interchain
+	// account transaction. Controllers may request a lower limit in the packet memo. No limit is enforced if set to 0.
+	MaxExecutionGas uint64 `protobuf:"varint,5,opt,name=max_execution_gas,json=maxExecutionGas,proto3" json:"max_execution_gas,omitempty"`
+	// execution_gas_price defines the decimal coin price per unit of gas charged to the interchain account for the
+	// execution of its transactions, e.g. "0.025stake". No execution fee is charged if empty.
+	ExecutionGasPrice string `protobuf:"bytes,6,opt,name=execution_gas_price,json=executionGasPrice,proto3" json:"execution_gas_price,omitempty"`
+	// execution_fee_recipient defines the recipient of the execution fees charged to interchain accounts.
+	ExecutionFeeRecipient ExecutionFeeRecipient `protobuf:"varint,7,opt,name=execution_fee_recipient,json=executionFeeRecipient,proto3,enum=ibc.applications.interchain_accounts.host.v1.ExecutionFeeRecipient" json:"execution_fee_recipient,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,8 +160,311 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMessagePolicies() []MessagePolicy {
+	if m != nil {
+		return m.MessagePolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMaxExecutionGas() uint64 {
+	if m != nil {
+		return m.MaxExecutionGas
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionGasPrice() string {
+	if m != nil {
+		return m.ExecutionGasPrice
+	}
+	return ""
+}
+
+func (m *Params) GetExecutionFeeRecipient() ExecutionFeeRecipient {
+	if m != nil {
+		return m.ExecutionFeeRecipient
+	}
+	return FEE_COLLECTOR
+}
+
+// AllowListOverride defines a list of sdk message typeURLs allowed to be executed by the interchain accounts
+// registered over a host connection. It takes precedence over the allow_messages parameter. If the controller
+// port prefix is set, the override only applies to interchain accounts whose controller port starts with it.
+type AllowListOverride struct {
+	// connection_id defines the host connection identifier the override applies to.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller_port_prefix optionally restricts the override to controller ports with the given prefix.
+	ControllerPortPrefix string `protobuf:"bytes,2,opt,name=controller_port_prefix,json=controllerPortPrefix,proto3" json:"controller_port_prefix,omitempty"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+}
+
+func (m *AllowListOverride) Reset()         { *m = AllowListOverride{} }
+func (m *AllowListOverride) String() string { return proto.CompactTextString(m) }
+func (*AllowListOverride) ProtoMessage()    {}
+func (*AllowListOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *AllowListOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowListOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowListOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowListOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowListOverride.Merge(m, src)
+}
+func (m *AllowListOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowListOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowListOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowListOverride proto.InternalMessageInfo
+
+func (m *AllowListOverride) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AllowListOverride) GetControllerPortPrefix() string {
+	if m != nil {
+		return m.ControllerPortPrefix
+	}
+	return ""
+}
+
+func (m *AllowListOverride) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+// MessagePolicy defines a set of rules which every message of the given sdk message typeURL must satisfy in order to
+// be executed by an interchain account. Messages are only subject to the policies matching their typeURL.
+type MessagePolicy struct {
+	// name uniquely identifies the policy and is included in the error acknowledgement of a denied message.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type_url defines the sdk message typeURL the policy applies to.
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// rules defines the list of rules which must all be satisfied by the message.
+	Rules []PolicyRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *MessagePolicy) Reset()         { *m = MessagePolicy{} }
+func (m *MessagePolicy) String() string { return proto.CompactTextString(m) }
+func (*MessagePolicy) ProtoMessage()    {}
+func (*MessagePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *MessagePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessagePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessagePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessagePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePolicy.Merge(m, src)
+}
+func (m *MessagePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessagePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePolicy proto.InternalMessageInfo
+
+func (m *MessagePolicy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MessagePolicy) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *MessagePolicy) GetRules() []PolicyRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// PolicyRule defines a constraint on the value of a single message field.
+type PolicyRule struct {
+	// field_path defines the dot separated path of the constrained field in the proto3 JSON representation of the
+	// message, e.g. "amount" or "description.moniker". Repeated fields along the path are constrained element-wise.
+	FieldPath string `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"`
+	// constraint defines the type of constraint applied to the field value.
+	Constraint ConstraintType `protobuf:"varint,2,opt,name=constraint,proto3,enum=ibc.applications.interchain_accounts.host.v1.ConstraintType" json:"constraint,omitempty"`
+	// values defines the constraint operands: coins for coin constraints, bech32 addresses for address constraints
+	// and enum value names for enum constraints.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *PolicyRule) Reset()         { *m = PolicyRule{} }
+func (m *PolicyRule) String() string { return proto.CompactTextString(m) }
+func (*PolicyRule) ProtoMessage()    {}
+func (*PolicyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *PolicyRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PolicyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PolicyRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PolicyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyRule.Merge(m, src)
+}
+func (m *PolicyRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PolicyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyRule proto.InternalMessageInfo
+
+func (m *PolicyRule) GetFieldPath() string {
+	if m != nil {
+		return m.FieldPath
+	}
+	return ""
+}
+
+func (m *PolicyRule) GetConstraint() ConstraintType {
+	if m != nil {
+		return m.Constraint
+	}
+	return UNSPECIFIED
+}
+
+func (m *PolicyRule) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+// InterchainAccountRegistration defines an interchain account registered on the host chain, together with the host
+// connection and controller port over which it was registered.
+type InterchainAccountRegistration struct {
+	// connection_id defines the host connection identifier.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// port_id defines the controller port identifier.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// address defines the interchain account address.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *InterchainAccountRegistration) Reset()         { *m = InterchainAccountRegistration{} }
+func (m *InterchainAccountRegistration) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountRegistration) ProtoMessage()    {}
+func (*InterchainAccountRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *InterchainAccountRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountRegistration.Merge(m, src)
+}
+func (m *InterchainAccountRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountRegistration proto.InternalMessageInfo
+
+func (m *InterchainAccountRegistration) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRegistration) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountRegistration) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("ibc.applications.interchain_accounts.host.v1.ExecutionFeeRecipient", ExecutionFeeRecipient_name, ExecutionFeeRecipient_value)
+	proto.RegisterEnum("ibc.applications.interchain_accounts.host.v1.ConstraintType", ConstraintType_name, ConstraintType_value)
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*AllowListOverride)(nil), "ibc.applications.interchain_accounts.host.v1.AllowListOverride")
+	proto.RegisterType((*MessagePolicy)(nil), "ibc.applications.interchain_accounts.host.v1.MessagePolicy")
+	proto.RegisterType((*PolicyRule)(nil), "ibc.applications.interchain_accounts.host.v1.PolicyRule")
+	proto.RegisterType((*InterchainAccountRegistration)(nil), "ibc.applications.interchain_accounts.host.v1.InterchainAccountRegistration")
 }
 
 func init() {
@@ -87,23 +472,62 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xcf, 0xbf, 0x4a, 0xc4, 0x40,
-	0x10, 0xc7, 0xf1, 0x44, 0xe1, 0xd0, 0xf8, 0xa7, 0x48, 0x75, 0xd5, 0x72, 0x0a, 0xc2, 0x15, 0x66,
-	0x97, 0xd3, 0xe2, 0xac, 0x05, 0x1b, 0x41, 0x90, 0x94, 0x36, 0x61, 0x77, 0xb2, 0x24, 0x0b, 0xbb,
-	0x3b, 0x21, 0xb3, 0x89, 0xf8, 0x16, 0x3e, 0x96, 0xe5, 0x95, 0x96, 0x92, 0xbc, 0x88, 0x24, 0x0a,
-	0x2a, 0x58, 0x0d, 0x7c, 0xe0, 0x07, 0xf3, 0x4d, 0xb6, 0x46, 0x81, 0x90, 0x4d, 0x63, 0x0d, 0xc8,
-	0x60, 0xd0, 0x93, 0x30, 0x3e, 0xe8, 0x16, 0x6a, 0x69, 0x7c, 0x21, 0x01, 0xb0, 0xf3, 0x81, 0x44,
-	0x8d, 0x14, 0x44, 0xbf, 0x99, 0x2f, 0x6f, 0x5a, 0x0c, 0x98, 0x5e, 0x1a, 0x05, 0xfc, 0xf7, 0x90,
-	0xff, 0x33, 0xe4, 0xf3, 0xa0, 0xdf, 0x9c, 0xe7, 0xc9, 0xe2, 0x51, 0xb6, 0xd2, 0x51, 0x7a, 0x96,
-	0x1c, 0x4f, 0x58, 0x68, 0x2f, 0x95, 0xd5, 0xe5, 0x32, 0x5e, 0xc5, 0xeb, 0x83, 0xfc, 0x68, 0xb2,
-	0xbb, 0x2f, 0x4a, 0x2f, 0x92, 0x53, 0x69, 0x2d, 0x3e, 0x17, 0x4e, 0x13, 0xc9, 0x4a, 0xd3, 0x72,
-	0x6f, 0xb5, 0xbf, 0x3e, 0xcc, 0x4f, 0x66, 0x7d, 0xf8, 0xc6, 0xdb, 0xf2, 0x6d, 0x60, 0xf1, 0x6e,
-	0x60, 0xf1, 0xc7, 0xc0, 0xe2, 0xd7, 0x91, 0x45, 0xbb, 0x91, 0x45, 0xef, 0x23, 0x8b, 0x9e, 0xee,
-	0x2b, 0x13, 0xea, 0x4e, 0x71, 0x40, 0x27, 0x00, 0xc9, 0x21, 0x09, 0xa3, 0x20, 0xab, 0x50, 0xf4,
-	0x37, 0xc2, 0x61, 0xd9, 0x59, 0x4d, 0x53, 0x34, 0x89, 0xab, 0x6d, 0xf6, 0xf3, 0x76, 0xf6, 0xb7,
-	0x37, 0xbc, 0x34, 0x9a, 0xd4, 0x62, 0xce, 0xbd, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x51, 0x50,
-	0x78, 0xd7, 0x29, 0x01, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x2d, 0xc5, 0x8a, 0x56, 0xb6, 0x2c, 0x6f, 0x93, 0x58, 0x51, 0x11, 0x85, 0x55, 0x11,
+	0x40, 0x30, 0x62, 0x31, 0x51, 0x8b, 0x26, 0x40, 0x73, 0x51, 0x68, 0xba, 0x60, 0x61, 0x53, 0x2c,
+	0x45, 0x03, 0x49, 0x51, 0x60, 0xb1, 0x22, 0xd7, 0xd2, 0x02, 0x24, 0x97, 0x5d, 0x52, 0x8a, 0x8d,
+	0xfe, 0x81, 0x40, 0x40, 0x81, 0x1e, 0xda, 0xa3, 0x0e, 0x45, 0xff, 0x47, 0xcf, 0x39, 0xe6, 0xd8,
+	0x53, 0x51, 0xd8, 0x7f, 0xa4, 0x58, 0x92, 0xd1, 0x87, 0xa1, 0x00, 0xf1, 0x49, 0x9c, 0xb7, 0xef,
+	0xbd, 0x99, 0x9d, 0x19, 0x61, 0xc1, 0x33, 0x3a, 0x70, 0x14, 0x1c, 0x86, 0x1e, 0x75, 0x70, 0x4c,
+	0x59, 0x10, 0x29, 0x34, 0x88, 0x09, 0x77, 0x46, 0x98, 0x06, 0x08, 0x3b, 0x0e, 0x1b, 0x07, 0x71,
+	0xa4, 0x8c, 0x58, 0x14, 0x2b, 0x93, 0xa7, 0xc9, 0x6f, 0x3b, 0xe4, 0x2c, 0x66, 0xf0, 0x31, 0x1d,
+	0x38, 0xed, 0x65, 0x61, 0x7b, 0x8d, 0xb0, 0x9d, 0x08, 0x26, 0x4f, 0xeb, 0x77, 0x86, 0x6c, 0xc8,
+	0x12, 0xa1, 0x22, 0xbe, 0x52, 0x8f, 0xe6, 0xdf, 0x79, 0xb0, 0x69, 0x62, 0x8e, 0xfd, 0x08, 0x7e,
+	0x01, 0xb6, 0x04, 0x17, 0x91, 0x00, 0x0f, 0x3c, 0xe2, 0xd6, 0x24, 0x59, 0x6a, 0xdd, 0xb6, 0xca,
+	0x02, 0xd3, 0x52, 0x08, 0x3e, 0x02, 0x15, 0xec, 0x79, 0xec, 0x0d, 0xf2, 0x49, 0x14, 0xe1, 0x21,
+	0x89, 0x6a, 0x1b, 0x72, 0xbe, 0x55, 0xb2, 0xb6, 0x13, 0xf4, 0x24, 0x03, 0xe1, 0x97, 0x20, 0x05,
+	0xd0, 0xcf, 0x63, 0xc2, 0x29, 0x89, 0x6a, 0xf9, 0x84, 0xb5, 0x95, 0x80, 0x3f, 0xa4, 0x18, 0xf4,
+	0x40, 0x35, 0x73, 0x41, 0x21, 0xf3, 0xa8, 0x23, 0x78, 0x05, 0x39, 0xdf, 0x2a, 0x77, 0xbe, 0x6d,
+	0xdf, 0xe4, 0x62, 0xed, 0x2c, 0xad, 0x29, 0x4c, 0x2e, 0x5e, 0x16, 0xde, 0xfd, 0xfb, 0x30, 0x67,
+	0xed, 0xf8, 0x4b, 0xa0, 0xc8, 0xb6, 0x0f, 0x76, 0x7d, 0x7c, 0x8e, 0xc8, 0x39, 0x71, 0xc6, 0xc2,
+	0x12, 0x0d, 0x71, 0x54, 0xbb, 0x25, 0x4b, 0xad, 0x82, 0xb5, 0xe3, 0xe3, 0x73, 0xed, 0x03, 0xfe,
+	0x1d, 0x8e, 0x60, 0x1b, 0x7c, 0xb6, 0xc2, 0x43, 0x21, 0xa7, 0x0e, 0xa9, 0x6d, 0xca, 0x52, 0xab,
+	0x64, 0xed, 0x92, 0x25, 0xaa, 0x29, 0x0e, 0xe0, 0x2f, 0x60, 0x6f, 0xc1, 0x3f, 0x23, 0x04, 0x71,
+	0xe2, 0xd0, 0x90, 0x92, 0x20, 0xae, 0x15, 0x65, 0xa9, 0x55, 0xe9, 0xa8, 0x37, 0xbb, 0xd0, 0xbc,
+	0x98, 0x23, 0x42, 0xac, 0x0f, 0x56, 0xd6, 0x5d, 0xb2, 0x0e, 0x6e, 0xfe, 0x21, 0x81, 0xdd, 0xae,
+	0xe8, 0xeb, 0x31, 0x8d, 0xe2, 0xde, 0x84, 0x70, 0x4e, 0x5d, 0x22, 0x26, 0xe0, 0xb0, 0x20, 0x20,
+	0x4e, 0x52, 0x13, 0x4d, 0x87, 0x59, 0xb2, 0xb6, 0x16, 0xa0, 0xee, 0xc2, 0xaf, 0xc1, 0x3d, 0x87,
+	0x05, 0x31, 0x67, 0x9e, 0x47, 0x38, 0x0a, 0x19, 0x8f, 0x51, 0xc8, 0xc9, 0x19, 0x3d, 0xaf, 0x6d,
+	0x24, 0xec, 0x3b, 0x8b, 0x53, 0x93, 0xf1, 0xd8, 0x4c, 0xce, 0xd6, 0xec, 0x40, 0x7e, 0xcd, 0x0e,
+	0x34, 0x7f, 0x97, 0xc0, 0xf6, 0xca, 0x64, 0x20, 0x04, 0x85, 0x00, 0xfb, 0x24, 0x2b, 0x25, 0xf9,
+	0x86, 0xf7, 0xc1, 0xed, 0xf8, 0x22, 0x24, 0x68, 0xcc, 0xbd, 0x2c, 0x69, 0x51, 0xc4, 0xa7, 0xdc,
+	0x83, 0x36, 0xb8, 0xc5, 0xc7, 0x5e, 0x66, 0x5f, 0xee, 0x3c, 0xbf, 0x59, 0x0f, 0xd3, 0x9c, 0xd6,
+	0xd8, 0x23, 0xd9, 0x46, 0xa4, 0x66, 0xcd, 0x3f, 0x25, 0x00, 0x16, 0x67, 0xf0, 0x01, 0x00, 0x67,
+	0x94, 0x78, 0x2e, 0x0a, 0x71, 0x3c, 0xca, 0x2a, 0x2b, 0x25, 0x88, 0x89, 0xe3, 0x11, 0xfc, 0x09,
+	0x00, 0x87, 0x05, 0x51, 0xcc, 0x31, 0x0d, 0xe2, 0xa4, 0xc0, 0x4a, 0xe7, 0xc5, 0xcd, 0x0a, 0x51,
+	0xe7, 0x7a, 0xfb, 0x22, 0x24, 0xd6, 0x92, 0x1f, 0xbc, 0x07, 0x36, 0x27, 0xd8, 0x1b, 0xcf, 0x3b,
+	0x98, 0x45, 0xcd, 0x37, 0xe0, 0x81, 0x3e, 0x77, 0xec, 0xa6, 0x86, 0x16, 0x19, 0x52, 0xa1, 0x13,
+	0x09, 0x3f, 0x6d, 0xba, 0x7b, 0xa0, 0x98, 0x8c, 0x94, 0xba, 0x59, 0x67, 0x37, 0x45, 0xa8, 0xbb,
+	0xb0, 0x06, 0x8a, 0xd8, 0x75, 0x39, 0x89, 0x44, 0xde, 0xa4, 0xe5, 0x59, 0xb8, 0xff, 0xab, 0x04,
+	0xee, 0xae, 0x5d, 0x3e, 0xf8, 0x02, 0x3c, 0xd2, 0x5e, 0x69, 0xea, 0xa9, 0xad, 0xf7, 0x0c, 0x74,
+	0xa4, 0x69, 0xc8, 0xd2, 0x54, 0xdd, 0xd4, 0x35, 0xc3, 0x4e, 0x22, 0xb5, 0x77, 0x7c, 0xac, 0xa9,
+	0x76, 0xcf, 0xaa, 0xe6, 0xea, 0xbb, 0xd3, 0x99, 0xbc, 0xbd, 0x02, 0xc2, 0x27, 0xe0, 0xe1, 0xc7,
+	0xd4, 0x96, 0x76, 0xdc, 0x7d, 0xad, 0x59, 0x55, 0xa9, 0x5e, 0x9e, 0xce, 0xe4, 0x62, 0x16, 0xd6,
+	0x0b, 0x6f, 0xff, 0x6a, 0xe4, 0xf6, 0xdf, 0x6e, 0x80, 0xca, 0x6a, 0xff, 0xe0, 0x13, 0xf0, 0xb9,
+	0xda, 0x33, 0xfa, 0xb6, 0xd5, 0xd5, 0x0d, 0x1b, 0xd9, 0xaf, 0x4d, 0x0d, 0x9d, 0x1a, 0x7d, 0x53,
+	0x53, 0xf5, 0x23, 0x5d, 0x3b, 0xac, 0xe6, 0xea, 0x3b, 0xd3, 0x99, 0x5c, 0x5e, 0x82, 0xe0, 0x63,
+	0x70, 0xff, 0xba, 0x42, 0xed, 0xe9, 0x46, 0x1f, 0x9d, 0x74, 0x5f, 0x55, 0xa5, 0xfa, 0xf6, 0x74,
+	0x26, 0x97, 0xe6, 0x00, 0x6c, 0x83, 0xfa, 0x75, 0x76, 0xf7, 0xf0, 0xd0, 0xd2, 0xfa, 0x7d, 0xa4,
+	0x1b, 0xd5, 0x8d, 0x7a, 0x65, 0x3a, 0x93, 0xc1, 0x02, 0x81, 0xdf, 0x80, 0xc6, 0xc7, 0xf8, 0x46,
+	0xcf, 0x16, 0x9a, 0x7c, 0x1d, 0x4e, 0x67, 0x72, 0x65, 0x15, 0x85, 0x2d, 0xb0, 0x77, 0x5d, 0xa7,
+	0x19, 0xa7, 0x27, 0x42, 0x50, 0x48, 0x5b, 0x91, 0x85, 0x69, 0x2b, 0x5e, 0xba, 0xef, 0x2e, 0x1b,
+	0xd2, 0xfb, 0xcb, 0x86, 0xf4, 0xdf, 0x65, 0x43, 0xfa, 0xed, 0xaa, 0x91, 0x7b, 0x7f, 0xd5, 0xc8,
+	0xfd, 0x73, 0xd5, 0xc8, 0xfd, 0xf8, 0xfd, 0x90, 0xc6, 0xa3, 0xf1, 0xa0, 0xed, 0x30, 0x5f, 0x71,
+	0x58, 0xe4, 0xb3, 0x48, 0xa1, 0x03, 0xe7, 0x60, 0xc8, 0x94, 0xc9, 0x73, 0xc5, 0x67, 0xae, 0x58,
+	0x7b, 0xf1, 0xbc, 0x44, 0x4a, 0xe7, 0xd9, 0xc1, 0x62, 0x53, 0x0f, 0x56, 0x5f, 0x16, 0xf1, 0xaf,
+	0x8b, 0x06, 0x9b, 0xc9, 0xa3, 0xf0, 0xd5, 0xff, 0x03, 0x00, 0x07, 0xc9, 0x6e, 0x53, 0x93, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +550,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionFeeRecipient != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.ExecutionFeeRecipient))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExecutionGasPrice) > 0 {
+		i -= len(m.ExecutionGasPrice)
+		copy(dAtA[i:], m.ExecutionGasPrice)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ExecutionGasPrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxExecutionGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecutionGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MessagePolicies) > 0 {
+		for iNdEx := len(m.MessagePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessagePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -148,44 +612,340 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AllowListOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *AllowListOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowListOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HostEnabled {
-		n += 2
-	}
 	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.ControllerPortPrefix) > 0 {
+		i -= len(m.ControllerPortPrefix)
+		copy(dAtA[i:], m.ControllerPortPrefix)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ControllerPortPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovHost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MessagePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozHost(x uint64) (n int) {
-	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MessagePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MessagePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicyRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Constraint != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Constraint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FieldPath) > 0 {
+		i -= len(m.FieldPath)
+		copy(dAtA[i:], m.FieldPath)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.FieldPath)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterchainAccountRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.MessagePolicies) > 0 {
+		for _, e := range m.MessagePolicies {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxExecutionGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecutionGas))
+	}
+	l = len(m.ExecutionGasPrice)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.ExecutionFeeRecipient != 0 {
+		n += 1 + sovHost(uint64(m.ExecutionFeeRecipient))
+	}
+	return n
+}
+
+func (m *AllowListOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.ControllerPortPrefix)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessagePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PolicyRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FieldPath)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.Constraint != 0 {
+		n += 1 + sovHost(uint64(m.Constraint))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterchainAccountRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func sovHost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHost(x uint64) (n int) {
+	return sovHost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -264,6 +1024,715 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessagePolicies = append(m.MessagePolicies, MessagePolicy{})
+			if err := m.MessagePolicies[len(m.MessagePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionGas", wireType)
+			}
+			m.MaxExecutionGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFeeRecipient", wireType)
+			}
+			m.ExecutionFeeRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionFeeRecipient |= ExecutionFeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowListOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowListOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowListOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessagePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, PolicyRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraint", wireType)
+			}
+			m.Constraint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constraint |= ConstraintType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterchainAccountRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
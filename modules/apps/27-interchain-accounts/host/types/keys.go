@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,8 +19,24 @@ const (
 
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
+
+	// AllowAllHostQueries holds the string key that allows all query paths on interchain accounts host module
+	AllowAllHostQueries = "*"
+
+	// AllowListOverrideKeyPrefix defines the key prefix used to store allow list overrides
+	AllowListOverrideKeyPrefix = "allowListOverride"
 )
 
+// KeyAllowListOverride creates and returns a new key used for allow list override store operations
+func KeyAllowListOverride(connectionID, controllerPortPrefix string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AllowListOverrideKeyPrefix, connectionID, controllerPortPrefix))
+}
+
+// KeyAllowListOverrideConnectionPrefix creates and returns a new key prefix used to iterate the allow list overrides of a connection
+func KeyAllowListOverrideConnectionPrefix(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", AllowListOverrideKeyPrefix, connectionID))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
@@ -33,3 +52,13 @@ func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 
 	return false
 }
+
+// ContainsQueryPath returns true if the gRPC query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	// check that wildcard * option for allowing all query paths is the only string in the array, if so, return true
+	if len(allowQueries) == 1 && allowQueries[0] == AllowAllHostQueries {
+		return true
+	}
+
+	return slices.Contains(allowQueries, path)
+}
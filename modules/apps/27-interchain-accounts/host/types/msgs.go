@@ -5,12 +5,19 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+
+	_ sdk.Msg              = (*MsgSetAllowListOverride)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetAllowListOverride)(nil)
+
+	_ sdk.Msg              = (*MsgRemoveAllowListOverride)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveAllowListOverride)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -30,3 +37,44 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 
 	return msg.Params.Validate()
 }
+
+// NewMsgSetAllowListOverride creates a new MsgSetAllowListOverride instance
+func NewMsgSetAllowListOverride(signer string, allowListOverride AllowListOverride) *MsgSetAllowListOverride {
+	return &MsgSetAllowListOverride{
+		Signer:            signer,
+		AllowListOverride: allowListOverride,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetAllowListOverride) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.AllowListOverride.Validate()
+}
+
+// NewMsgRemoveAllowListOverride creates a new MsgRemoveAllowListOverride instance
+func NewMsgRemoveAllowListOverride(signer, connectionID, controllerPortPrefix string) *MsgRemoveAllowListOverride {
+	return &MsgRemoveAllowListOverride{
+		Signer:               signer,
+		ConnectionId:         connectionID,
+		ControllerPortPrefix: controllerPortPrefix,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveAllowListOverride) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return err
+	}
+
+	return validateControllerPortPrefix(msg.ControllerPortPrefix)
+}
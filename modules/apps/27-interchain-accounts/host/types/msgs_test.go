@@ -74,3 +74,112 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetAllowListOverrideValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetAllowListOverride
+		expPass bool
+	}{
+		{
+			"success: connection wide override",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"})),
+			true,
+		},
+		{
+			"success: controller port prefix override",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "icacontroller-", []string{"*"})),
+			true,
+		},
+		{
+			"success: empty allow list",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "", nil)),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgSetAllowListOverride("signer", types.NewAllowListOverride(ibctesting.FirstConnectionID, "", nil)),
+			false,
+		},
+		{
+			"failure: invalid connection identifier",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride("invalid|connection", "", nil)),
+			false,
+		},
+		{
+			"failure: invalid controller port prefix",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "invalid|prefix", nil)),
+			false,
+		},
+		{
+			"failure: empty string in allow list",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{""})),
+			false,
+		},
+		{
+			"failure: wildcard is not the only element of the allow list",
+			types.NewMsgSetAllowListOverride(signer, types.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"*", "/cosmos.bank.v1beta1.MsgSend"})),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRemoveAllowListOverrideValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(ibctesting.TestAccAddress).String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRemoveAllowListOverride
+		expPass bool
+	}{
+		{
+			"success: connection wide override",
+			types.NewMsgRemoveAllowListOverride(signer, ibctesting.FirstConnectionID, ""),
+			true,
+		},
+		{
+			"success: controller port prefix override",
+			types.NewMsgRemoveAllowListOverride(signer, ibctesting.FirstConnectionID, "icacontroller-"),
+			true,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgRemoveAllowListOverride("signer", ibctesting.FirstConnectionID, ""),
+			false,
+		},
+		{
+			"failure: invalid connection identifier",
+			types.NewMsgRemoveAllowListOverride(signer, "invalid|connection", ""),
+			false,
+		},
+		{
+			"failure: invalid controller port prefix",
+			types.NewMsgRemoveAllowListOverride(signer, ibctesting.FirstConnectionID, "invalid|prefix"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -12,21 +16,70 @@ const (
 )
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(enableHost bool, allowMsgs, allowQueries []string) Params {
 	return Params{
 		HostEnabled:   enableHost,
 		AllowMessages: allowMsgs,
+		AllowQueries:  allowQueries,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, []string{AllowAllHostMsgs})
+	return NewParams(DefaultHostEnabled, []string{AllowAllHostMsgs}, nil)
 }
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
-	return validateAllowlist(p.AllowMessages)
+	if err := validateAllowlist(p.AllowMessages); err != nil {
+		return err
+	}
+
+	if err := validateQueryAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMessagePolicies(p.MessagePolicies); err != nil {
+		return err
+	}
+
+	if err := validateExecutionGasPrice(p.ExecutionGasPrice); err != nil {
+		return err
+	}
+
+	if _, found := ExecutionFeeRecipient_name[int32(p.ExecutionFeeRecipient)]; !found {
+		return fmt.Errorf("unsupported execution fee recipient %d", p.ExecutionFeeRecipient)
+	}
+
+	return nil
+}
+
+// ExecutionGasLimit returns the gas limit enforced on the execution of an interchain account transaction for which
+// the controller requested the provided gas limit. The requested gas limit is capped by the maximum execution gas.
+// The maximum execution gas applies when no gas limit is requested. Zero is returned if no limit is enforced.
+func (p Params) ExecutionGasLimit(requestedGasLimit uint64) uint64 {
+	if requestedGasLimit == 0 || (p.MaxExecutionGas != 0 && requestedGasLimit > p.MaxExecutionGas) {
+		return p.MaxExecutionGas
+	}
+
+	return requestedGasLimit
+}
+
+// ExecutionFee returns the fee charged to an interchain account for the execution of a transaction which consumed
+// the provided amount of gas. The fee is the gas used multiplied by the execution gas price, rounded up.
+// An empty set of coins is returned if no execution gas price is set.
+func (p Params) ExecutionFee(gasUsed uint64) (sdk.Coins, error) {
+	if p.ExecutionGasPrice == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	gasPrice, err := sdk.ParseDecCoin(p.ExecutionGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(gasUsed)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, amount)), nil
 }
 
 func validateAllowlist(allowMsgs []string) error {
@@ -42,3 +95,46 @@ func validateAllowlist(allowMsgs []string) error {
 
 	return nil
 }
+
+func validateQueryAllowlist(allowQueries []string) error {
+	if slices.Contains(allowQueries, AllowAllHostQueries) && len(allowQueries) > 1 {
+		return fmt.Errorf("query allow list must have only one element because the allow all host queries wildcard (%s) is present", AllowAllHostQueries)
+	}
+
+	for _, path := range allowQueries {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
+		}
+	}
+
+	return nil
+}
+
+func validateMessagePolicies(policies []MessagePolicy) error {
+	names := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := names[policy.Name]; found {
+			return fmt.Errorf("duplicate message policy name %s", policy.Name)
+		}
+
+		names[policy.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateExecutionGasPrice(gasPrice string) error {
+	if gasPrice == "" {
+		return nil
+	}
+
+	if _, err := sdk.ParseDecCoin(gasPrice); err != nil {
+		return fmt.Errorf("invalid execution gas price %s: %w", gasPrice, err)
+	}
+
+	return nil
+}
@@ -5,13 +5,96 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 )
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
-	require.Error(t, types.NewParams(true, []string{""}).Validate())
-	require.Error(t, types.NewParams(true, []string{" "}).Validate())
-	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{""}, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{" "}, nil).Validate())
+	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}, nil).Validate())
+	require.NoError(t, types.NewParams(true, nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}).Validate())
+	require.NoError(t, types.NewParams(true, nil, []string{"*"}).Validate())
+	require.Error(t, types.NewParams(true, nil, []string{""}).Validate())
+	require.Error(t, types.NewParams(true, nil, []string{"*", "/cosmos.bank.v1beta1.Query/Balance"}).Validate())
+
+	params := types.DefaultParams()
+	policy := types.NewMessagePolicy("max-send", "/cosmos.bank.v1beta1.MsgSend", types.NewPolicyRule("amount", types.COINS_MAX, "100stake"))
+	params.MessagePolicies = []types.MessagePolicy{policy}
+	require.NoError(t, params.Validate())
+	params.MessagePolicies = []types.MessagePolicy{policy, policy}
+	require.Error(t, params.Validate())
+	params.MessagePolicies = []types.MessagePolicy{types.NewMessagePolicy("max-send", "/cosmos.bank.v1beta1.MsgSend")}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxExecutionGas = 1_000_000
+	params.ExecutionGasPrice = "0.025stake"
+	params.ExecutionFeeRecipient = types.RELAYER
+	require.NoError(t, params.Validate())
+	params.ExecutionGasPrice = "stake"
+	require.Error(t, params.Validate())
+	params.ExecutionGasPrice = "-1stake"
+	require.Error(t, params.Validate())
+	params.ExecutionGasPrice = "1stake"
+	params.ExecutionFeeRecipient = 2
+	require.Error(t, params.Validate())
+}
+
+func TestExecutionGasLimit(t *testing.T) {
+	testCases := []struct {
+		name              string
+		maxExecutionGas   uint64
+		requestedGasLimit uint64
+		expGasLimit       uint64
+	}{
+		{"no limit", 0, 0, 0},
+		{"requested limit without maximum", 0, 100_000, 100_000},
+		{"maximum applies when no limit is requested", 500_000, 0, 500_000},
+		{"requested limit below maximum", 500_000, 100_000, 100_000},
+		{"requested limit capped by maximum", 500_000, 1_000_000, 500_000},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxExecutionGas = tc.maxExecutionGas
+
+			require.Equal(t, tc.expGasLimit, params.ExecutionGasLimit(tc.requestedGasLimit))
+		})
+	}
+}
+
+func TestExecutionFee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		gasPrice string
+		gasUsed  uint64
+		expFee   sdk.Coins
+	}{
+		{"no gas price", "", 100_000, sdk.NewCoins()},
+		{"whole gas price", "2stake", 100_000, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(200_000)))},
+		{"fee rounded up", "0.025stake", 100_001, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(2501)))},
+		{"zero gas price", "0stake", 100_000, sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ExecutionGasPrice = tc.gasPrice
+
+			fee, err := params.ExecutionFee(tc.gasUsed)
+			require.NoError(t, err)
+			require.Equal(t, tc.expFee, fee)
+		})
+	}
 }
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// fieldPathSeparator separates the field names of a PolicyRule field path
+const fieldPathSeparator = "."
+
+// NewMessagePolicy creates a new MessagePolicy instance
+func NewMessagePolicy(name, typeURL string, rules ...PolicyRule) MessagePolicy {
+	return MessagePolicy{
+		Name:    name,
+		TypeUrl: typeURL,
+		Rules:   rules,
+	}
+}
+
+// NewPolicyRule creates a new PolicyRule instance
+func NewPolicyRule(fieldPath string, constraint ConstraintType, values ...string) PolicyRule {
+	return PolicyRule{
+		FieldPath:  fieldPath,
+		Constraint: constraint,
+		Values:     values,
+	}
+}
+
+// Validate performs basic validation of the MessagePolicy and each of its rules
+func (p MessagePolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("message policy name cannot be empty")
+	}
+
+	if strings.TrimSpace(p.TypeUrl) == "" {
+		return fmt.Errorf("message policy %s type URL cannot be empty", p.Name)
+	}
+
+	if len(p.Rules) == 0 {
+		return fmt.Errorf("message policy %s must contain at least one rule", p.Name)
+	}
+
+	for i, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("message policy %s rule %d is invalid: %w", p.Name, i, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the PolicyRule field path and constraint values
+func (r PolicyRule) Validate() error {
+	if slices.Contains(strings.Split(r.FieldPath, fieldPathSeparator), "") {
+		return fmt.Errorf("field path %q must not contain empty field names", r.FieldPath)
+	}
+
+	if len(r.Values) == 0 {
+		return fmt.Errorf("constraint values cannot be empty")
+	}
+
+	switch r.Constraint {
+	case COINS_MAX:
+		_, err := r.maxCoins()
+		return err
+	case ADDRESS_IN, ADDRESS_NOT_IN:
+		_, err := r.addresses()
+		return err
+	case ENUM_IN:
+		if slices.Contains(r.Values, "") {
+			return fmt.Errorf("enum value names cannot be empty")
+		}
+	default:
+		return fmt.Errorf("unsupported constraint type %s", r.Constraint)
+	}
+
+	return nil
+}
+
+// maxCoins parses the values of a COINS_MAX rule into sorted and validated coins
+func (r PolicyRule) maxCoins() (sdk.Coins, error) {
+	coins := make(sdk.Coins, len(r.Values))
+	for i, value := range r.Values {
+		coin, err := sdk.ParseCoinNormalized(value)
+		if err != nil {
+			return nil, err
+		}
+
+		coins[i] = coin
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coins, nil
+}
+
+// addresses decodes the bech32 values of an ADDRESS_IN or ADDRESS_NOT_IN rule into address bytes
+func (r PolicyRule) addresses() ([][]byte, error) {
+	addresses := make([][]byte, len(r.Values))
+	for i, value := range r.Values {
+		address, err := addressBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses[i] = address
+	}
+
+	return addresses, nil
+}
+
+// evaluate checks that every value resolved from the message field path satisfies the rule constraint
+func (r PolicyRule) evaluate(msgJSON any) error {
+	values, err := resolveFieldPath(msgJSON, strings.Split(r.FieldPath, fieldPathSeparator))
+	if err != nil {
+		return err
+	}
+
+	switch r.Constraint {
+	case COINS_MAX:
+		maxCoins, err := r.maxCoins()
+		if err != nil {
+			return err
+		}
+
+		total := sdk.NewCoins()
+		for _, value := range values {
+			coin, err := coinFromJSON(value)
+			if err != nil {
+				return err
+			}
+
+			total = total.Add(coin)
+		}
+
+		if !maxCoins.IsAllGTE(total) {
+			return fmt.Errorf("coins %s exceed maximum %s", total, maxCoins)
+		}
+	case ADDRESS_IN, ADDRESS_NOT_IN:
+		// addresses are compared as bytes, as several bech32 strings, e.g. differing in case, encode the same address
+		addresses, err := r.addresses()
+		if err != nil {
+			return err
+		}
+
+		for _, value := range values {
+			addressStr, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("expected address string, got %T", value)
+			}
+
+			address, err := addressBytes(addressStr)
+			if err != nil {
+				return err
+			}
+
+			listed := slices.ContainsFunc(addresses, func(a []byte) bool { return bytes.Equal(a, address) })
+			if listed != (r.Constraint == ADDRESS_IN) {
+				return fmt.Errorf("address %s is not allowed", addressStr)
+			}
+		}
+	case ENUM_IN:
+		for _, value := range values {
+			name, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("expected enum value name, got %T", value)
+			}
+
+			if !slices.Contains(r.Values, name) {
+				return fmt.Errorf("enum value %s is not allowed", name)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported constraint type %s", r.Constraint)
+	}
+
+	return nil
+}
+
+// CheckMessagePolicies evaluates the message against every policy matching its type URL. The message is converted
+// to its proto3 JSON representation from which the rule field paths are resolved. A PolicyViolationError
+// identifying the first failed rule is returned if the message does not satisfy a policy. The messages executed
+// through an authz MsgExec are evaluated recursively, so that policies cannot be bypassed using authz grants.
+func CheckMessagePolicies(cdc codec.JSONCodec, policies []MessagePolicy, msg sdk.Msg) error {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if execMsg, ok := msg.(*authz.MsgExec); ok {
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to unpack authz messages")
+		}
+
+		for _, innerMsg := range innerMsgs {
+			if err := CheckMessagePolicies(cdc, policies, innerMsg); err != nil {
+				return err
+			}
+		}
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+
+	var msgJSON any
+	for _, policy := range policies {
+		if policy.TypeUrl != typeURL {
+			continue
+		}
+
+		// the message is only decoded once a policy applies to it
+		if msgJSON == nil {
+			bz, err := cdc.MarshalJSON(msg)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to marshal message %s", typeURL)
+			}
+
+			decoder := json.NewDecoder(bytes.NewReader(bz))
+			decoder.UseNumber()
+			if err := decoder.Decode(&msgJSON); err != nil {
+				return errorsmod.Wrapf(err, "failed to decode message %s", typeURL)
+			}
+		}
+
+		for i, rule := range policy.Rules {
+			if err := rule.evaluate(msgJSON); err != nil {
+				return &PolicyViolationError{
+					Policy:    policy.Name,
+					RuleIndex: i,
+					Rule:      rule,
+					Reason:    err,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveFieldPath returns the values found at the field path of the decoded JSON value. Lists encountered along
+// the path, including the value found at the end of the path, are expanded element-wise.
+func resolveFieldPath(value any, path []string) ([]any, error) {
+	switch v := value.(type) {
+	case []any:
+		var values []any
+		for _, elem := range v {
+			elemValues, err := resolveFieldPath(elem, path)
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, elemValues...)
+		}
+
+		return values, nil
+	case map[string]any:
+		if len(path) == 0 {
+			return []any{v}, nil
+		}
+
+		field, ok := v[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("field %s not found", path[0])
+		}
+
+		return resolveFieldPath(field, path[1:])
+	default:
+		if len(path) != 0 {
+			return nil, fmt.Errorf("field %s not found", path[0])
+		}
+
+		return []any{v}, nil
+	}
+}
+
+// addressBytes decodes a bech32 address into its bytes, ignoring the human readable part
+func addressBytes(address string) ([]byte, error) {
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bech32 address %s: %w", address, err)
+	}
+
+	return bz, nil
+}
+
+// coinFromJSON parses a coin from its decoded proto3 JSON representation
+func coinFromJSON(value any) (sdk.Coin, error) {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("expected coin, got %T", value)
+	}
+
+	denom, ok := fields["denom"].(string)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("expected coin denomination string, got %T", fields["denom"])
+	}
+
+	amountStr, ok := fields["amount"].(string)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("expected coin amount string, got %T", fields["amount"])
+	}
+
+	amount, ok := sdkmath.NewIntFromString(amountStr)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("invalid coin amount %s", amountStr)
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return coin, nil
+}
+
+// PolicyViolationError is returned when a message does not satisfy a rule of a message policy.
+// It identifies the failed rule so that it may be included in the error acknowledgement.
+type PolicyViolationError struct {
+	Policy    string
+	RuleIndex int
+	Rule      PolicyRule
+	Reason    error
+}
+
+// Error implements the error interface
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.ruleDescription(), e.Reason)
+}
+
+// Cause returns ErrMessagePolicyViolation so that the ABCI code of the error may be obtained
+func (*PolicyViolationError) Cause() error {
+	return ErrMessagePolicyViolation
+}
+
+// Unwrap returns ErrMessagePolicyViolation so that the error may be matched using errors.Is
+func (*PolicyViolationError) Unwrap() error {
+	return ErrMessagePolicyViolation
+}
+
+// Acknowledgement returns an error acknowledgement identifying the failed policy rule.
+// NOTE: Only the ABCI code and the policy rule taken from the host parameters are included in the acknowledgement
+// as it is written into state. The message dependent reason is omitted and must be obtained from the packet events.
+func (e *PolicyViolationError) Acknowledgement() channeltypes.Acknowledgement {
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: fmt.Sprintf("ABCI code: %d: %s", ErrMessagePolicyViolation.ABCICode(), e.ruleDescription()),
+		},
+	}
+}
+
+// ruleDescription returns a deterministic description of the failed policy rule
+func (e *PolicyViolationError) ruleDescription() string {
+	return fmt.Sprintf("%s: policy %s rule %d (%s on %s)", ErrMessagePolicyViolation.Error(), e.Policy, e.RuleIndex, e.Rule.Constraint, e.Rule.FieldPath)
+}
@@ -0,0 +1,377 @@
+package types_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+var (
+	sender    = sdk.AccAddress("sender______________").String()
+	recipient = sdk.AccAddress("recipient___________").String()
+	validator = sdk.ValAddress("validator___________").String()
+)
+
+func TestMessagePolicyValidate(t *testing.T) {
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name    string
+		policy  types.MessagePolicy
+		expPass bool
+	}{
+		{
+			"success: coins max",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake", "10atom")),
+			true,
+		},
+		{
+			"success: address in",
+			types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_IN, recipient)),
+			true,
+		},
+		{
+			"success: address not in",
+			types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient, validator)),
+			true,
+		},
+		{
+			"success: enum in",
+			types.NewMessagePolicy("votes", sdk.MsgTypeURL(&govtypesv1.MsgVote{}), types.NewPolicyRule("option", types.ENUM_IN, "VOTE_OPTION_YES")),
+			true,
+		},
+		{
+			"success: nested field path",
+			types.NewMessagePolicy("multi-send", sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), types.NewPolicyRule("outputs.address", types.ADDRESS_IN, recipient)),
+			true,
+		},
+		{
+			"empty name",
+			types.NewMessagePolicy(" ", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake")),
+			false,
+		},
+		{
+			"empty type URL",
+			types.NewMessagePolicy("max-send", "", types.NewPolicyRule("amount", types.COINS_MAX, "100stake")),
+			false,
+		},
+		{
+			"no rules",
+			types.NewMessagePolicy("max-send", sendTypeURL),
+			false,
+		},
+		{
+			"empty field path",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("", types.COINS_MAX, "100stake")),
+			false,
+		},
+		{
+			"empty field name in field path",
+			types.NewMessagePolicy("multi-send", sendTypeURL, types.NewPolicyRule("outputs..address", types.ADDRESS_IN, recipient)),
+			false,
+		},
+		{
+			"unspecified constraint",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.UNSPECIFIED, "100stake")),
+			false,
+		},
+		{
+			"no constraint values",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX)),
+			false,
+		},
+		{
+			"invalid coin",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "stake")),
+			false,
+		},
+		{
+			"duplicate coin denomination",
+			types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake", "10stake")),
+			false,
+		},
+		{
+			"invalid address",
+			types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_IN, "invalid")),
+			false,
+		},
+		{
+			"empty enum value name",
+			types.NewMessagePolicy("votes", sdk.MsgTypeURL(&govtypesv1.MsgVote{}), types.NewPolicyRule("option", types.ENUM_IN, "")),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckMessagePolicies(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   recipient,
+		Amount:      sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(10))),
+	}
+
+	msgMultiSend := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100))))},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(60)))),
+			banktypes.NewOutput(sdk.MustAccAddressFromBech32(sender), sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(40)))),
+		},
+	}
+
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sender,
+		ValidatorAddress: validator,
+		Amount:           sdk.NewCoin("stake", sdkmath.NewInt(100)),
+	}
+
+	msgVote := &govtypesv1.MsgVote{
+		ProposalId: 1,
+		Voter:      sender,
+		Option:     govtypesv1.OptionYes,
+	}
+
+	// the same address encoded in upper case bech32
+	msgSendUpperCase := &banktypes.MsgSend{
+		FromAddress: sender,
+		ToAddress:   strings.ToUpper(recipient),
+		Amount:      msgSend.Amount,
+	}
+
+	msgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sender), []sdk.Msg{msgDelegate, msgSend})
+	nestedMsgExec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sender), []sdk.Msg{&msgExec})
+
+	sendTypeURL := sdk.MsgTypeURL(msgSend)
+
+	testCases := []struct {
+		name       string
+		policies   []types.MessagePolicy
+		msg        sdk.Msg
+		expViolate *types.PolicyViolationError
+	}{
+		{
+			"success: no policies",
+			nil,
+			msgSend,
+			nil,
+		},
+		{
+			"success: policy for another message type",
+			[]types.MessagePolicy{types.NewMessagePolicy("delegations", sdk.MsgTypeURL(msgDelegate), types.NewPolicyRule("validator_address", types.ADDRESS_IN, recipient))},
+			msgSend,
+			nil,
+		},
+		{
+			"success: coins below maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake", "20atom"))},
+			msgSend,
+			nil,
+		},
+		{
+			"success: single coin below maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("max-delegation", sdk.MsgTypeURL(msgDelegate), types.NewPolicyRule("amount", types.COINS_MAX, "1000stake"))},
+			msgDelegate,
+			nil,
+		},
+		{
+			"success: delegation to allowed validator",
+			[]types.MessagePolicy{types.NewMessagePolicy("delegations", sdk.MsgTypeURL(msgDelegate), types.NewPolicyRule("validator_address", types.ADDRESS_IN, validator))},
+			msgDelegate,
+			nil,
+		},
+		{
+			"success: upper case recipient allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_IN, recipient))},
+			msgSendUpperCase,
+			nil,
+		},
+		{
+			"success: recipient allowed by upper case rule value",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_IN, strings.ToUpper(recipient)))},
+			msgSend,
+			nil,
+		},
+		{
+			"success: authz executed messages satisfy policies",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_IN, recipient))},
+			&msgExec,
+			nil,
+		},
+		{
+			"success: recipient not denied",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, validator))},
+			msgSend,
+			nil,
+		},
+		{
+			"success: vote option allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("votes", sdk.MsgTypeURL(msgVote), types.NewPolicyRule("option", types.ENUM_IN, "VOTE_OPTION_YES", "VOTE_OPTION_ABSTAIN"))},
+			msgVote,
+			nil,
+		},
+		{
+			"success: all nested outputs allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("multi-send", sdk.MsgTypeURL(msgMultiSend), types.NewPolicyRule("outputs.address", types.ADDRESS_IN, recipient, sender))},
+			msgMultiSend,
+			nil,
+		},
+		{
+			"success: sum of nested output coins below maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("multi-send", sdk.MsgTypeURL(msgMultiSend), types.NewPolicyRule("outputs.coins", types.COINS_MAX, "100stake"))},
+			msgMultiSend,
+			nil,
+		},
+		{
+			"coins exceed maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "99stake", "20atom"))},
+			msgSend,
+			&types.PolicyViolationError{Policy: "max-send", RuleIndex: 0, Rule: types.NewPolicyRule("amount", types.COINS_MAX, "99stake", "20atom")},
+		},
+		{
+			"coin denomination without maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake"))},
+			msgSend,
+			&types.PolicyViolationError{Policy: "max-send", RuleIndex: 0, Rule: types.NewPolicyRule("amount", types.COINS_MAX, "100stake")},
+		},
+		{
+			"sum of nested output coins exceeds maximum",
+			[]types.MessagePolicy{types.NewMessagePolicy("multi-send", sdk.MsgTypeURL(msgMultiSend), types.NewPolicyRule("outputs.coins", types.COINS_MAX, "80stake"))},
+			msgMultiSend,
+			&types.PolicyViolationError{Policy: "multi-send", RuleIndex: 0, Rule: types.NewPolicyRule("outputs.coins", types.COINS_MAX, "80stake")},
+		},
+		{
+			"delegation to validator not allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("delegations", sdk.MsgTypeURL(msgDelegate), types.NewPolicyRule("validator_address", types.ADDRESS_IN, recipient))},
+			msgDelegate,
+			&types.PolicyViolationError{Policy: "delegations", RuleIndex: 0, Rule: types.NewPolicyRule("validator_address", types.ADDRESS_IN, recipient)},
+		},
+		{
+			"recipient denied",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient))},
+			msgSend,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 0, Rule: types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient)},
+		},
+		{
+			"failure: upper case recipient denied",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient))},
+			msgSendUpperCase,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 0, Rule: types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient)},
+		},
+		{
+			"failure: authz executed message denied",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient))},
+			&msgExec,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 0, Rule: types.NewPolicyRule("to_address", types.ADDRESS_NOT_IN, recipient)},
+		},
+		{
+			"failure: nested authz executed message denied",
+			[]types.MessagePolicy{types.NewMessagePolicy("delegations", sdk.MsgTypeURL(msgDelegate), types.NewPolicyRule("validator_address", types.ADDRESS_IN, recipient))},
+			&nestedMsgExec,
+			&types.PolicyViolationError{Policy: "delegations", RuleIndex: 0, Rule: types.NewPolicyRule("validator_address", types.ADDRESS_IN, recipient)},
+		},
+		{
+			"one nested output not allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("multi-send", sdk.MsgTypeURL(msgMultiSend), types.NewPolicyRule("outputs.address", types.ADDRESS_IN, recipient))},
+			msgMultiSend,
+			&types.PolicyViolationError{Policy: "multi-send", RuleIndex: 0, Rule: types.NewPolicyRule("outputs.address", types.ADDRESS_IN, recipient)},
+		},
+		{
+			"vote option not allowed",
+			[]types.MessagePolicy{types.NewMessagePolicy("votes", sdk.MsgTypeURL(msgVote), types.NewPolicyRule("option", types.ENUM_IN, "VOTE_OPTION_ABSTAIN"))},
+			msgVote,
+			&types.PolicyViolationError{Policy: "votes", RuleIndex: 0, Rule: types.NewPolicyRule("option", types.ENUM_IN, "VOTE_OPTION_ABSTAIN")},
+		},
+		{
+			"second rule of second policy fails",
+			[]types.MessagePolicy{
+				types.NewMessagePolicy("max-send", sendTypeURL, types.NewPolicyRule("amount", types.COINS_MAX, "100stake", "10atom")),
+				types.NewMessagePolicy("recipients", sendTypeURL,
+					types.NewPolicyRule("from_address", types.ADDRESS_IN, sender),
+					types.NewPolicyRule("to_address", types.ADDRESS_IN, sender),
+				),
+			},
+			msgSend,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 1, Rule: types.NewPolicyRule("to_address", types.ADDRESS_IN, sender)},
+		},
+		{
+			"field not found",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("recipient", types.ADDRESS_IN, recipient))},
+			msgSend,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 0, Rule: types.NewPolicyRule("recipient", types.ADDRESS_IN, recipient)},
+		},
+		{
+			"field is not an address",
+			[]types.MessagePolicy{types.NewMessagePolicy("recipients", sendTypeURL, types.NewPolicyRule("amount", types.ADDRESS_IN, recipient))},
+			msgSend,
+			&types.PolicyViolationError{Policy: "recipients", RuleIndex: 0, Rule: types.NewPolicyRule("amount", types.ADDRESS_IN, recipient)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.CheckMessagePolicies(cdc, tc.policies, tc.msg)
+
+			if tc.expViolate == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, types.ErrMessagePolicyViolation)
+
+			var violation *types.PolicyViolationError
+			require.True(t, errors.As(err, &violation))
+			require.Equal(t, tc.expViolate.Policy, violation.Policy)
+			require.Equal(t, tc.expViolate.RuleIndex, violation.RuleIndex)
+			require.Equal(t, tc.expViolate.Rule, violation.Rule)
+		})
+	}
+}
+
+func TestPolicyViolationAcknowledgement(t *testing.T) {
+	violation := &types.PolicyViolationError{
+		Policy:    "max-send",
+		RuleIndex: 1,
+		Rule:      types.NewPolicyRule("amount", types.COINS_MAX, "100stake"),
+		Reason:    fmt.Errorf("coins 200stake exceed maximum 100stake"),
+	}
+
+	expAck := channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: "ABCI code: 4: message policy violation: policy max-send rule 1 (CONSTRAINT_TYPE_COINS_MAX on amount)",
+		},
+	}
+
+	require.Equal(t, expAck, violation.Acknowledgement())
+	require.NoError(t, violation.Acknowledgement().ValidateBasic())
+}
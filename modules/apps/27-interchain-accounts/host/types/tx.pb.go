@@ -109,9 +109,171 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAllowListOverride defines the payload for Msg/SetAllowListOverride
+type MsgSetAllowListOverride struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// allow_list_override defines the allow list override to create or replace.
+	AllowListOverride AllowListOverride `protobuf:"bytes,2,opt,name=allow_list_override,json=allowListOverride,proto3" json:"allow_list_override"`
+}
+
+func (m *MsgSetAllowListOverride) Reset()         { *m = MsgSetAllowListOverride{} }
+func (m *MsgSetAllowListOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowListOverride) ProtoMessage()    {}
+func (*MsgSetAllowListOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{2}
+}
+func (m *MsgSetAllowListOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowListOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowListOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowListOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowListOverride.Merge(m, src)
+}
+func (m *MsgSetAllowListOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowListOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowListOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowListOverride proto.InternalMessageInfo
+
+// MsgSetAllowListOverrideResponse defines the response for Msg/SetAllowListOverride
+type MsgSetAllowListOverrideResponse struct {
+}
+
+func (m *MsgSetAllowListOverrideResponse) Reset()         { *m = MsgSetAllowListOverrideResponse{} }
+func (m *MsgSetAllowListOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAllowListOverrideResponse) ProtoMessage()    {}
+func (*MsgSetAllowListOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{3}
+}
+func (m *MsgSetAllowListOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAllowListOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAllowListOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAllowListOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAllowListOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetAllowListOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAllowListOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAllowListOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAllowListOverrideResponse proto.InternalMessageInfo
+
+// MsgRemoveAllowListOverride defines the payload for Msg/RemoveAllowListOverride
+type MsgRemoveAllowListOverride struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// connection_id defines the host connection identifier of the allow list override.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// controller_port_prefix defines the controller port prefix of the allow list override.
+	ControllerPortPrefix string `protobuf:"bytes,3,opt,name=controller_port_prefix,json=controllerPortPrefix,proto3" json:"controller_port_prefix,omitempty"`
+}
+
+func (m *MsgRemoveAllowListOverride) Reset()         { *m = MsgRemoveAllowListOverride{} }
+func (m *MsgRemoveAllowListOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowListOverride) ProtoMessage()    {}
+func (*MsgRemoveAllowListOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{4}
+}
+func (m *MsgRemoveAllowListOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowListOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowListOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowListOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowListOverride.Merge(m, src)
+}
+func (m *MsgRemoveAllowListOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowListOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowListOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowListOverride proto.InternalMessageInfo
+
+// MsgRemoveAllowListOverrideResponse defines the response for Msg/RemoveAllowListOverride
+type MsgRemoveAllowListOverrideResponse struct {
+}
+
+func (m *MsgRemoveAllowListOverrideResponse) Reset()         { *m = MsgRemoveAllowListOverrideResponse{} }
+func (m *MsgRemoveAllowListOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowListOverrideResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowListOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa437afde7f1e7ae, []int{5}
+}
+func (m *MsgRemoveAllowListOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowListOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowListOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowListOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowListOverrideResponse.Merge(m, src)
+}
+func (m *MsgRemoveAllowListOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowListOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowListOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowListOverrideResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAllowListOverride)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetAllowListOverride")
+	proto.RegisterType((*MsgSetAllowListOverrideResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgSetAllowListOverrideResponse")
+	proto.RegisterType((*MsgRemoveAllowListOverride)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveAllowListOverride")
+	proto.RegisterType((*MsgRemoveAllowListOverrideResponse)(nil), "ibc.applications.interchain_accounts.host.v1.MsgRemoveAllowListOverrideResponse")
 }
 
 func init() {
@@ -119,29 +281,41 @@ func init() {
 }
 
 var fileDescriptor_fa437afde7f1e7ae = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xd2, 0xc9, 0x4c, 0x4a, 0xd6, 0x43, 0xd6, 0xa6, 0x87, 0x45, 0x9b, 0x1e, 0x48, 0x9b,
-	0x5e, 0x99, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x58, 0xa3, 0x3e, 0x88, 0x05, 0x31, 0x43,
-	0x4a, 0x3c, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0xb7, 0x38, 0x1d, 0x64, 0x76, 0x6e, 0x71,
-	0x3a, 0x54, 0xc2, 0x9c, 0x24, 0x37, 0x81, 0x2d, 0x01, 0x6b, 0x54, 0xea, 0x63, 0xe4, 0xe2, 0xf7,
-	0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x12,
-	0xe3, 0x62, 0x2b, 0xce, 0x4c, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82,
-	0xf2, 0x84, 0x82, 0xb8, 0xd8, 0x0a, 0xc0, 0x2a, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x4c,
-	0xf4, 0x48, 0xf1, 0x92, 0x1e, 0xc4, 0x74, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x26,
-	0x59, 0xf1, 0x77, 0x2c, 0x90, 0x67, 0x68, 0x7a, 0xbe, 0x41, 0x0b, 0x6a, 0x89, 0x92, 0x24, 0x97,
-	0x38, 0x9a, 0x7b, 0x82, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x8d, 0x16, 0x33, 0x72, 0x31,
-	0xfb, 0x16, 0xa7, 0x0b, 0x4d, 0x61, 0xe4, 0xe2, 0x41, 0x71, 0xb0, 0x2d, 0x69, 0x0e, 0x41, 0x33,
-	0x5f, 0xca, 0x95, 0x22, 0xed, 0x30, 0xe7, 0x49, 0xb1, 0x36, 0x3c, 0xdf, 0xa0, 0xc5, 0xe8, 0x94,
-	0x72, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x5e, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0x88, 0xcc, 0x4c, 0x4a, 0xd6, 0x4d, 0xcf, 0xd7,
-	0x2f, 0xb3, 0xd0, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x45, 0x62, 0xb1, 0xbe, 0x91,
-	0xb9, 0x2e, 0xc2, 0x05, 0xba, 0xa8, 0xf1, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e,
-	0x3e, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x76, 0xa2, 0x96, 0x3e, 0x8d, 0x02, 0x00, 0x00,
+	// 533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0xd4, 0x4e,
+	0x18, 0xc6, 0x77, 0xfe, 0xed, 0x7f, 0xa1, 0x63, 0xa5, 0x18, 0x97, 0xee, 0x9a, 0x43, 0xb6, 0xae,
+	0x1e, 0x4a, 0x71, 0x33, 0x74, 0xad, 0x54, 0x04, 0x11, 0x0b, 0x82, 0x8a, 0x8b, 0x4b, 0xc4, 0x8b,
+	0x97, 0x90, 0x9d, 0x8c, 0xb3, 0x03, 0x49, 0xde, 0x30, 0x33, 0x1b, 0xeb, 0x4d, 0x3c, 0x89, 0x07,
+	0xf1, 0xe0, 0x07, 0xe8, 0x07, 0xf0, 0xd0, 0x8b, 0x07, 0xbf, 0x41, 0x8f, 0x3d, 0x7a, 0x12, 0xd9,
+	0x3d, 0xf4, 0x6b, 0x48, 0xd2, 0x6c, 0xd7, 0xee, 0x6e, 0xc0, 0x60, 0x6f, 0x49, 0xde, 0xf7, 0x79,
+	0xde, 0xe7, 0x17, 0x5e, 0x5e, 0x7c, 0x47, 0xf4, 0x29, 0xf1, 0xe2, 0x38, 0x10, 0xd4, 0xd3, 0x02,
+	0x22, 0x45, 0x44, 0xa4, 0x99, 0xa4, 0x03, 0x4f, 0x44, 0xae, 0x47, 0x29, 0x0c, 0x23, 0xad, 0xc8,
+	0x00, 0x94, 0x26, 0xc9, 0x36, 0xd1, 0xfb, 0x76, 0x2c, 0x41, 0x83, 0x71, 0x4b, 0xf4, 0xa9, 0xfd,
+	0xa7, 0xcc, 0x5e, 0x20, 0xb3, 0x53, 0x99, 0x9d, 0x6c, 0x9b, 0x35, 0x0e, 0x1c, 0x32, 0x21, 0x49,
+	0x9f, 0x4e, 0x3d, 0xcc, 0x3a, 0x05, 0x15, 0x82, 0x22, 0xa1, 0xe2, 0xa9, 0x77, 0xa8, 0x78, 0x5e,
+	0xd8, 0x2d, 0x95, 0x29, 0x1b, 0x92, 0x09, 0x5b, 0x9f, 0x10, 0x5e, 0xeb, 0x2a, 0xfe, 0x32, 0xf6,
+	0x3d, 0xcd, 0x7a, 0x9e, 0xf4, 0x42, 0x65, 0xac, 0xe3, 0xaa, 0x12, 0x3c, 0x62, 0xb2, 0x81, 0x36,
+	0xd0, 0xe6, 0x8a, 0x93, 0xbf, 0x19, 0x0e, 0xae, 0xc6, 0x59, 0x47, 0xe3, 0xbf, 0x0d, 0xb4, 0x79,
+	0xa9, 0xb3, 0x63, 0x97, 0x41, 0xb2, 0x4f, 0xdd, 0xf7, 0x96, 0x8f, 0x7e, 0x36, 0x2b, 0x4e, 0xee,
+	0x74, 0x6f, 0xed, 0xc3, 0x41, 0xb3, 0xf2, 0xfe, 0xe4, 0x70, 0x2b, 0x1f, 0xd2, 0xba, 0x86, 0xeb,
+	0x33, 0x79, 0x1c, 0xa6, 0x62, 0x88, 0x14, 0x6b, 0x7d, 0x47, 0x59, 0xed, 0x05, 0xd3, 0x0f, 0x83,
+	0x00, 0xde, 0x3c, 0x13, 0x4a, 0x3f, 0x4f, 0x98, 0x94, 0xc2, 0x67, 0x85, 0x99, 0x87, 0xf8, 0xaa,
+	0x97, 0x36, 0xbb, 0x81, 0x50, 0xda, 0x85, 0xbc, 0x3d, 0x07, 0x78, 0x50, 0x0e, 0x60, 0x6e, 0x6a,
+	0xce, 0x72, 0xc5, 0x9b, 0x2d, 0xcc, 0x63, 0x5d, 0xc7, 0xcd, 0x82, 0xe8, 0x67, 0x78, 0x07, 0x08,
+	0x9b, 0x5d, 0xc5, 0x1d, 0x16, 0x42, 0xc2, 0xfe, 0x9e, 0xf0, 0x06, 0xbe, 0x4c, 0x21, 0x8a, 0x18,
+	0x4d, 0x01, 0x5c, 0xe1, 0x67, 0x6c, 0x2b, 0xce, 0xea, 0xf4, 0xe3, 0x13, 0xdf, 0xd8, 0xc1, 0xeb,
+	0x14, 0x22, 0x2d, 0x21, 0x08, 0x98, 0x74, 0x63, 0x90, 0xda, 0x8d, 0x25, 0x7b, 0x2d, 0xf6, 0x1b,
+	0x4b, 0x59, 0x77, 0x6d, 0x5a, 0xed, 0x81, 0xd4, 0xbd, 0xac, 0x36, 0x4f, 0x71, 0x13, 0xb7, 0x8a,
+	0x13, 0x4e, 0x40, 0x3a, 0x1f, 0x97, 0xf1, 0x52, 0x57, 0x71, 0xe3, 0x0b, 0xc2, 0xab, 0xe7, 0x16,
+	0xeb, 0x7e, 0xb9, 0xff, 0x3d, 0xb3, 0x07, 0xe6, 0xa3, 0x7f, 0x92, 0x4f, 0xe2, 0x19, 0x5f, 0x11,
+	0xae, 0x2d, 0xdc, 0xa1, 0xf2, 0xfe, 0x8b, 0x6c, 0xcc, 0xee, 0x85, 0xd8, 0x9c, 0xc5, 0xfd, 0x86,
+	0x70, 0xbd, 0x68, 0x27, 0x1e, 0x97, 0x1e, 0x55, 0xe0, 0x64, 0xf6, 0x2e, 0xca, 0x69, 0x92, 0xdb,
+	0xfc, 0xff, 0xdd, 0xc9, 0xe1, 0x16, 0xda, 0xf3, 0x8f, 0x46, 0x16, 0x3a, 0x1e, 0x59, 0xe8, 0xd7,
+	0xc8, 0x42, 0x9f, 0xc7, 0x56, 0xe5, 0x78, 0x6c, 0x55, 0x7e, 0x8c, 0xad, 0xca, 0xab, 0xa7, 0x5c,
+	0xe8, 0xc1, 0xb0, 0x6f, 0x53, 0x08, 0x49, 0x7e, 0xd7, 0x44, 0x9f, 0xb6, 0x39, 0x90, 0xe4, 0x2e,
+	0x09, 0xc1, 0x1f, 0x06, 0x4c, 0xa5, 0x37, 0x4d, 0x91, 0xce, 0x6e, 0x7b, 0x1a, 0xa6, 0x7d, 0xfe,
+	0x9c, 0xe9, 0xb7, 0x31, 0x53, 0xfd, 0x6a, 0x76, 0xcd, 0x6e, 0xff, 0x1e, 0x00, 0xa3, 0xdf, 0xd5,
+	0x4e, 0x9c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +332,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAllowListOverride defines a rpc handler for MsgSetAllowListOverride.
+	SetAllowListOverride(ctx context.Context, in *MsgSetAllowListOverride, opts ...grpc.CallOption) (*MsgSetAllowListOverrideResponse, error)
+	// RemoveAllowListOverride defines a rpc handler for MsgRemoveAllowListOverride.
+	RemoveAllowListOverride(ctx context.Context, in *MsgRemoveAllowListOverride, opts ...grpc.CallOption) (*MsgRemoveAllowListOverrideResponse, error)
 }
 
 type msgClient struct {
@@ -177,10 +355,32 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAllowListOverride(ctx context.Context, in *MsgSetAllowListOverride, opts ...grpc.CallOption) (*MsgSetAllowListOverrideResponse, error) {
+	out := new(MsgSetAllowListOverrideResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/SetAllowListOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowListOverride(ctx context.Context, in *MsgRemoveAllowListOverride, opts ...grpc.CallOption) (*MsgRemoveAllowListOverrideResponse, error) {
+	out := new(MsgRemoveAllowListOverrideResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveAllowListOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAllowListOverride defines a rpc handler for MsgSetAllowListOverride.
+	SetAllowListOverride(context.Context, *MsgSetAllowListOverride) (*MsgSetAllowListOverrideResponse, error)
+	// RemoveAllowListOverride defines a rpc handler for MsgRemoveAllowListOverride.
+	RemoveAllowListOverride(context.Context, *MsgRemoveAllowListOverride) (*MsgRemoveAllowListOverrideResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -190,6 +390,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAllowListOverride(ctx context.Context, req *MsgSetAllowListOverride) (*MsgSetAllowListOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowListOverride not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowListOverride(ctx context.Context, req *MsgRemoveAllowListOverride) (*MsgRemoveAllowListOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowListOverride not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -213,6 +419,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAllowListOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAllowListOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAllowListOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/SetAllowListOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAllowListOverride(ctx, req.(*MsgSetAllowListOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowListOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowListOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowListOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Msg/RemoveAllowListOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowListOverride(ctx, req.(*MsgRemoveAllowListOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -221,6 +463,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAllowListOverride",
+			Handler:    _Msg_SetAllowListOverride_Handler,
+		},
+		{
+			MethodName: "RemoveAllowListOverride",
+			Handler:    _Msg_RemoveAllowListOverride_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/tx.proto",
@@ -289,6 +539,136 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAllowListOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowListOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowListOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllowListOverride.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAllowListOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAllowListOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAllowListOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowListOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowListOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowListOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ControllerPortPrefix) > 0 {
+		i -= len(m.ControllerPortPrefix)
+		copy(dAtA[i:], m.ControllerPortPrefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ControllerPortPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowListOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowListOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowListOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -324,13 +704,67 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetAllowListOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AllowListOverride.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+
+func (m *MsgSetAllowListOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowListOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ControllerPortPrefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowListOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -495,6 +929,367 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAllowListOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowListOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowListOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListOverride", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowListOverride.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAllowListOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAllowListOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAllowListOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowListOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowListOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowListOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPortPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerPortPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAllowListOverrideResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAllowListOverrideResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAllowListOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 )
 
@@ -22,6 +24,28 @@ func NewDecodeStore() func(kvA, kvB kv.Pair) string {
 			return fmt.Sprintf("ActiveChannel A: %s\nActiveChannel B: %s", string(kvA.Value), string(kvB.Value))
 		case bytes.Equal(kvA.Key[:len(types.IsMiddlewareEnabledPrefix)], []byte(types.IsMiddlewareEnabledPrefix)):
 			return fmt.Sprintf("IsMiddlewareEnabled A: %s\nIsMiddlewareEnabled B: %s", string(kvA.Value), string(kvB.Value))
+		case bytes.HasPrefix(kvA.Key, []byte(hosttypes.AllowListOverrideKeyPrefix)):
+			var allowListOverrideA, allowListOverrideB hosttypes.AllowListOverride
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &allowListOverrideA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &allowListOverrideB)
+			return fmt.Sprintf("AllowListOverride A: %v\nAllowListOverride B: %v", allowListOverrideA, allowListOverrideB)
+		case bytes.HasPrefix(kvA.Key, []byte(controllertypes.ExecutionResultKeyPrefix)):
+			var executionResultA, executionResultB controllertypes.ExecutionResult
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &executionResultA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &executionResultB)
+			return fmt.Sprintf("ExecutionResult A: %v\nExecutionResult B: %v", executionResultA, executionResultB)
+		case bytes.HasPrefix(kvA.Key, []byte(controllertypes.PendingTxKeyPrefix)):
+			var pendingTxA, pendingTxB controllertypes.PendingTx
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &pendingTxA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &pendingTxB)
+			return fmt.Sprintf("PendingTx A: %v\nPendingTx B: %v", pendingTxA, pendingTxB)
+		case bytes.HasPrefix(kvA.Key, []byte(controllertypes.AccountOwnershipKeyPrefix)):
+			var accountOwnershipA, accountOwnershipB controllertypes.AccountOwnership
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &accountOwnershipA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &accountOwnershipB)
+			return fmt.Sprintf("AccountOwnership A: %v\nAccountOwnership B: %v", accountOwnershipA, accountOwnershipB)
+		case bytes.HasPrefix(kvA.Key, []byte(controllertypes.OwnedAccountKeyPrefix)):
+			return fmt.Sprintf("OwnedAccount A: %s\nOwnedAccount B: %s", string(kvA.Value), string(kvB.Value))
 
 		default:
 			panic(fmt.Errorf("invalid %s key prefix %s", types.ModuleName, kvA.Key))
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/simulation"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -19,6 +21,26 @@ func TestDecodeStore(t *testing.T) {
 		channelID = ibctesting.FirstChannelID
 	)
 
+	allowListOverride := hosttypes.NewAllowListOverride(ibctesting.FirstConnectionID, "", []string{"/cosmos.bank.v1beta1.MsgSend"})
+
+	executionResult := controllertypes.ExecutionResult{
+		ConnectionId: ibctesting.FirstConnectionID,
+		PortId:       types.ControllerPortPrefix + owner,
+		ChannelId:    channelID,
+		Sequence:     1,
+		Success:      true,
+	}
+
+	pendingTx := controllertypes.NewPendingTx(
+		ibctesting.FirstConnectionID,
+		types.ControllerPortPrefix+owner,
+		1,
+		types.InterchainAccountPacketData{Type: types.EXECUTE_TX, Data: []byte("data")},
+		types.DefaultRelativePacketTimeoutTimestamp,
+	)
+
+	accountOwnership := controllertypes.NewAccountOwnership(ibctesting.FirstConnectionID, types.ControllerPortPrefix+owner, "new-owner", 0)
+
 	dec := simulation.NewDecodeStore()
 
 	kvPairs := kv.Pairs{
@@ -39,6 +61,26 @@ func TestDecodeStore(t *testing.T) {
 				Key:   []byte(types.IsMiddlewareEnabledPrefix),
 				Value: []byte("false"),
 			},
+			{
+				Key:   hosttypes.KeyAllowListOverride(ibctesting.FirstConnectionID, ""),
+				Value: types.ModuleCdc.MustMarshal(&allowListOverride),
+			},
+			{
+				Key:   controllertypes.KeyExecutionResult(executionResult.ConnectionId, executionResult.PortId, executionResult.Sequence),
+				Value: types.ModuleCdc.MustMarshal(&executionResult),
+			},
+			{
+				Key:   controllertypes.KeyPendingTx(pendingTx.ConnectionId, pendingTx.PortId, pendingTx.Index),
+				Value: types.ModuleCdc.MustMarshal(&pendingTx),
+			},
+			{
+				Key:   controllertypes.KeyAccountOwnership(accountOwnership.ConnectionId, accountOwnership.PortId),
+				Value: types.ModuleCdc.MustMarshal(&accountOwnership),
+			},
+			{
+				Key:   controllertypes.KeyOwnedAccount(accountOwnership.ConnectionId, types.ControllerPortPrefix+accountOwnership.Owner),
+				Value: []byte(accountOwnership.PortId),
+			},
 		},
 	}
 	tests := []struct {
@@ -49,6 +91,11 @@ func TestDecodeStore(t *testing.T) {
 		{"Owner", fmt.Sprintf("Owner A: %s\nOwner B: %s", owner, owner)},
 		{"ActiveChannel", fmt.Sprintf("ActiveChannel A: %s\nActiveChannel B: %s", channelID, channelID)},
 		{"IsMiddlewareEnabled", fmt.Sprintf("IsMiddlewareEnabled A: %s\nIsMiddlewareEnabled B: %s", "false", "false")},
+		{"AllowListOverride", fmt.Sprintf("AllowListOverride A: %v\nAllowListOverride B: %v", allowListOverride, allowListOverride)},
+		{"ExecutionResult", fmt.Sprintf("ExecutionResult A: %v\nExecutionResult B: %v", executionResult, executionResult)},
+		{"PendingTx", fmt.Sprintf("PendingTx A: %v\nPendingTx B: %v", pendingTx, pendingTx)},
+		{"AccountOwnership", fmt.Sprintf("AccountOwnership A: %v\nAccountOwnership B: %v", accountOwnership, accountOwnership)},
+		{"OwnedAccount", fmt.Sprintf("OwnedAccount A: %s\nOwnedAccount B: %s", accountOwnership.PortId, accountOwnership.PortId)},
 		{"other", ""},
 	}
 
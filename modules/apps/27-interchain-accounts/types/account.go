@@ -43,6 +43,7 @@ type interchainAccountPretty struct {
 
 // GenerateAddress returns an sdk.AccAddress derived using a host module account address, host connection ID, the controller portID,
 // the current block app hash, and the current block data hash. The sdk.AccAddress returned is a sub-address of the host module account.
+// The account index of the controller owner, if any, is part of the controller portID and thus of the derived address.
 func GenerateAddress(ctx sdk.Context, connectionID, portID string) sdk.AccAddress {
 	hostModuleAcc := sdkaddress.Module(ModuleName, []byte(hostAccountsKey))
 	header := ctx.BlockHeader()
@@ -107,3 +107,69 @@ func DeserializeCosmosTx(cdc codec.Codec, data []byte, encoding string) ([]sdk.M
 
 	return msgs, nil
 }
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The CosmosQuery
+// is marshaled depending on the encoding type passed in. The marshaled bytes are returned. Only the ProtoCodec
+// is supported for serializing queries. Both protobuf and proto3 JSON are supported.
+func SerializeCosmosQuery(cdc codec.Codec, requests []QueryRequest, encoding string) ([]byte, error) {
+	// this is a defensive check to ensure only the ProtoCodec is used for query serialization
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving queries on the host chain")
+	}
+
+	var bz []byte
+	var err error
+
+	cosmosQuery := &CosmosQuery{
+		Requests: requests,
+	}
+
+	switch encoding {
+	case EncodingProtobuf:
+		bz, err = cdc.Marshal(cosmosQuery)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "cannot marshal CosmosQuery with protobuf")
+		}
+	case EncodingProto3JSON:
+		bz, err = cdc.MarshalJSON(cosmosQuery)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot marshal CosmosQuery with proto3 json")
+		}
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query bytes into a slice of query requests. The query
+// bytes are unmarshaled depending on the encoding type passed in. Each query request is validated before
+// the requests are returned. Only the ProtoCodec is supported for deserializing queries. Both protobuf and
+// proto3 JSON are supported.
+func DeserializeCosmosQuery(cdc codec.Codec, data []byte, encoding string) ([]QueryRequest, error) {
+	// this is a defensive check to ensure only the ProtoCodec is used for query deserialization
+	if _, ok := cdc.(*codec.ProtoCodec); !ok {
+		return nil, errorsmod.Wrap(ErrInvalidCodec, "only the ProtoCodec may be used for receiving queries on the host chain")
+	}
+
+	var cosmosQuery CosmosQuery
+
+	switch encoding {
+	case EncodingProtobuf:
+		if err := cdc.Unmarshal(data, &cosmosQuery); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosQuery with protobuf: %v", err)
+		}
+	case EncodingProto3JSON:
+		if err := cdc.UnmarshalJSON(data, &cosmosQuery); err != nil {
+			return nil, errorsmod.Wrapf(ErrUnknownDataType, "cannot unmarshal CosmosQuery with proto3 json")
+		}
+	default:
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+
+	if err := cosmosQuery.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return cosmosQuery.Requests, nil
+}
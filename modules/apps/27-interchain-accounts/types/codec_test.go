@@ -469,3 +469,84 @@ func (suite *TypesTestSuite) TestUnsupportedEncodingType() {
 	_, err = types.DeserializeCosmosTx(suite.chainA.Codec, data, types.EncodingProtobuf)
 	suite.Require().NoError(err)
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	testedEncodings := []string{types.EncodingProtobuf, types.EncodingProto3JSON}
+
+	var requests []types.QueryRequest
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: single query",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple queries",
+			func() {
+				requests = append(requests, types.NewQueryRequest("/cosmos.bank.v1beta1.Query/TotalSupply", nil))
+			},
+			nil,
+		},
+		{
+			"failure: empty query requests",
+			func() {
+				requests = nil
+			},
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"failure: empty query path",
+			func() {
+				requests = []types.QueryRequest{types.NewQueryRequest("", nil)}
+			},
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"failure: query path is not a fully qualified gRPC method name",
+			func() {
+				requests = []types.QueryRequest{types.NewQueryRequest("bank/balance", nil)}
+			},
+			types.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, encoding := range testedEncodings {
+		for _, tc := range testCases {
+			tc := tc
+
+			suite.Run(tc.name, func() {
+				req, err := suite.chainA.Codec.Marshal(&banktypes.QueryBalanceRequest{Address: TestOwnerAddress, Denom: sdk.DefaultBondDenom})
+				suite.Require().NoError(err)
+
+				requests = []types.QueryRequest{types.NewQueryRequest("/cosmos.bank.v1beta1.Query/Balance", req)}
+
+				tc.malleate()
+
+				bz, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, encoding)
+				suite.Require().NoError(err)
+
+				deserializedRequests, err := types.DeserializeCosmosQuery(suite.chainA.Codec, bz, encoding)
+				if tc.expErr == nil {
+					suite.Require().NoError(err)
+					suite.Require().Len(deserializedRequests, len(requests))
+					for i, request := range requests {
+						suite.Require().Equal(request.Path, deserializedRequests[i].Path)
+						suite.Require().Equal(request.Data, deserializedRequests[i].Data)
+					}
+				} else {
+					suite.Require().ErrorIs(err, tc.expErr)
+				}
+			})
+		}
+	}
+
+	_, err := types.SerializeCosmosQuery(suite.chainA.Codec, requests, "unsupported")
+	suite.Require().ErrorIs(err, types.ErrInvalidCodec)
+
+	_, err = types.DeserializeCosmosQuery(suite.chainA.Codec, []byte("invalid"), types.EncodingProtobuf)
+	suite.Require().ErrorIs(err, types.ErrUnknownDataType)
+}
@@ -2,10 +2,20 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket                      = "ics27_packet"
+	EventTypeExecutionFee                = "ics27_execution_fee"
+	EventTypeAccountOwnershipTransferred = "ics27_account_ownership_transferred"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyInterchainAccount   = "interchain_account"
+	AttributeKeyFee                 = "fee"
+	AttributeKeyFeeRecipient        = "fee_recipient"
+	AttributeKeyConnectionID        = "connection_id"
+	AttributeKeyPortID              = "port_id"
+	AttributeKeyPreviousOwner       = "previous_owner"
+	AttributeKeyNewOwner            = "new_owner"
+	AttributeKeyNewAccountIndex     = "new_account_index"
 )
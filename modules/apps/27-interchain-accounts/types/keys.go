@@ -14,6 +14,9 @@ const (
 	// ControllerPortPrefix is the default port prefix that the interchain accounts controller submodule binds to
 	ControllerPortPrefix = "icacontroller-"
 
+	// ControllerPortAccountIndexSeparator separates the owner from the account index in a controller port identifier
+	ControllerPortAccountIndexSeparator = "."
+
 	// Version defines the current version for interchain accounts
 	Version = "ics27-1"
 
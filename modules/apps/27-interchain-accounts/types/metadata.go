@@ -18,6 +18,9 @@ const (
 
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
+	// TxTypeSDKMultiMsgQuery defines the multi message transaction type supported by the Cosmos SDK,
+	// extended with support for executing gRPC queries on the host chain
+	TxTypeSDKMultiMsgQuery = "sdk_multi_msg_query"
 )
 
 // NewMetadata creates and returns a new ICS27 Metadata instance
@@ -63,6 +66,12 @@ func MetadataFromVersion(versionString string) (Metadata, error) {
 	return metadata, nil
 }
 
+// SupportsQueries returns true if the transaction type negotiated in the metadata allows
+// query packets to be sent over the channel, otherwise false
+func (m Metadata) SupportsQueries() bool {
+	return m.TxType == TxTypeSDKMultiMsgQuery
+}
+
 // IsPreviousMetadataEqual compares a metadata to a previous version string set in a channel struct.
 // It ensures all fields are equal except the Address string
 func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
@@ -160,7 +169,7 @@ func isSupportedTxType(txType string) bool {
 
 // getSupportedTxTypes returns a string slice of supported transaction types
 func getSupportedTxTypes() []string {
-	return []string{TxTypeSDKMultiMsg}
+	return []string{TxTypeSDKMultiMsg, TxTypeSDKMultiMsgQuery}
 }
 
 // validateConnectionParams compares the given the controller and host connection IDs to those set in the provided ICS27 Metadata
@@ -2,7 +2,7 @@ package types
 
 import (
 	"encoding/json"
-	"strings"
+	"strconv"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -10,6 +10,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -21,6 +22,14 @@ var (
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
 const MaxMemoCharLength = 32768
 
+const (
+	// ExecutionMemoKey is the key of the memo JSON object holding the options for the execution of the packet data on
+	// the host chain
+	ExecutionMemoKey = "ica_execution"
+	// ExecutionGasLimitKey is the key of the execution options holding the gas limit requested by the controller
+	ExecutionGasLimitKey = "gas_limit"
+)
+
 var (
 	// DefaultRelativePacketTimeoutHeight is the default packet timeout height (in blocks) relative
 	// to the current block height of the counterparty chain provided by the client state. The
@@ -49,6 +58,10 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	if _, err := iapd.GetExecutionGasLimit(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "invalid packet data memo: %s", err)
+	}
+
 	return nil
 }
 
@@ -75,7 +88,7 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 }
 
 // GetPacketSender returns the sender address of the interchain accounts packet data.
-// It is obtained from the source port ID by cutting off the ControllerPortPrefix.
+// It is obtained from the source port ID by cutting off the ControllerPortPrefix and the account index, if any.
 // If the source port ID does not have the ControllerPortPrefix, then an empty string is returned.
 //
 // NOTE:
@@ -83,8 +96,8 @@ func (ct CosmosTx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 //     check if the packet sender isn't the interchain accounts module.
 //   - The sender address must only be used by modules on the sending chain.
 func (InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
-	icaOwner, found := strings.CutPrefix(sourcePortID, ControllerPortPrefix)
-	if !found {
+	icaOwner, _, err := ParseControllerPortID(sourcePortID)
+	if err != nil {
 		return ""
 	}
 	return icaOwner
@@ -111,3 +124,38 @@ func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) interfac
 
 	return memoData
 }
+
+// GetExecutionGasLimit returns the gas limit requested by the controller for the execution of the packet data on the
+// host chain. The gas limit is provided as a string in the memo, in the following format:
+//
+//	{ "ica_execution": { "gas_limit": "{uint64}" } }
+//
+// Zero is returned if no gas limit is requested. An error is returned if the execution options are improperly formatted.
+func (iapd InterchainAccountPacketData) GetExecutionGasLimit() (uint64, error) {
+	executionData := iapd.GetCustomPacketData(ExecutionMemoKey)
+	if executionData == nil {
+		return 0, nil
+	}
+
+	executionOptions, ok := executionData.(map[string]interface{})
+	if !ok {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "expected %s memo value to be a JSON object", ExecutionMemoKey)
+	}
+
+	gasLimit, found := executionOptions[ExecutionGasLimitKey]
+	if !found {
+		return 0, nil
+	}
+
+	gasLimitStr, ok := gasLimit.(string)
+	if !ok {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "expected %s to be a string, got %T", ExecutionGasLimitKey, gasLimit)
+	}
+
+	gasLimitValue, err := strconv.ParseUint(gasLimitStr, 10, 64)
+	if err != nil {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid %s %s: %v", ExecutionGasLimitKey, gasLimitStr, err)
+	}
+
+	return gasLimitValue, nil
+}
@@ -33,16 +33,20 @@ const (
 	UNSPECIFIED Type = 0
 	// Execute a transaction on an interchain accounts host chain
 	EXECUTE_TX Type = 1
+	// Execute a list of gRPC queries on an interchain accounts host chain
+	QUERY Type = 2
 )
 
 var Type_name = map[int32]string{
 	0: "TYPE_UNSPECIFIED",
 	1: "TYPE_EXECUTE_TX",
+	2: "TYPE_QUERY",
 }
 
 var Type_value = map[string]int32{
 	"TYPE_UNSPECIFIED": 0,
 	"TYPE_EXECUTE_TX":  1,
+	"TYPE_QUERY":       2,
 }
 
 func (x Type) String() string {
@@ -159,10 +163,169 @@ func (m *CosmosTx) GetMessages() []*types.Any {
 	return nil
 }
 
+// CosmosQuery contains a list of gRPC query requests. It should be used when sending queries to an SDK host chain.
+type CosmosQuery struct {
+	Requests []QueryRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+}
+
+func (m *CosmosQuery) Reset()         { *m = CosmosQuery{} }
+func (m *CosmosQuery) String() string { return proto.CompactTextString(m) }
+func (*CosmosQuery) ProtoMessage()    {}
+func (*CosmosQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{2}
+}
+func (m *CosmosQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQuery.Merge(m, src)
+}
+func (m *CosmosQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQuery proto.InternalMessageInfo
+
+func (m *CosmosQuery) GetRequests() []QueryRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// QueryRequest defines a single gRPC query to be executed on the host chain.
+type QueryRequest struct {
+	// path defines the fully qualified gRPC method name, e.g. /cosmos.bank.v1beta1.Query/Balance
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// data defines the protobuf encoded query request
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{3}
+}
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// CosmosQueryResponse contains the results of the queries carried by a CosmosQuery. It is returned in the
+// acknowledgement result of a successfully executed query packet.
+type CosmosQueryResponse struct {
+	// responses defines the protobuf encoded query responses, in the same order as the requests
+	Responses [][]byte `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	// height defines the host chain block height at which the queries were executed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CosmosQueryResponse) Reset()         { *m = CosmosQueryResponse{} }
+func (m *CosmosQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*CosmosQueryResponse) ProtoMessage()    {}
+func (*CosmosQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89a080d7401cd393, []int{4}
+}
+func (m *CosmosQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmosQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmosQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmosQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmosQueryResponse.Merge(m, src)
+}
+func (m *CosmosQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmosQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmosQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmosQueryResponse proto.InternalMessageInfo
+
+func (m *CosmosQueryResponse) GetResponses() [][]byte {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *CosmosQueryResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.applications.interchain_accounts.v1.Type", Type_name, Type_value)
 	proto.RegisterType((*InterchainAccountPacketData)(nil), "ibc.applications.interchain_accounts.v1.InterchainAccountPacketData")
 	proto.RegisterType((*CosmosTx)(nil), "ibc.applications.interchain_accounts.v1.CosmosTx")
+	proto.RegisterType((*CosmosQuery)(nil), "ibc.applications.interchain_accounts.v1.CosmosQuery")
+	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.v1.QueryRequest")
+	proto.RegisterType((*CosmosQueryResponse)(nil), "ibc.applications.interchain_accounts.v1.CosmosQueryResponse")
 }
 
 func init() {
@@ -170,32 +333,39 @@ func init() {
 }
 
 var fileDescriptor_89a080d7401cd393 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xc1, 0xaa, 0xd3, 0x40,
-	0x14, 0xcd, 0xf8, 0x82, 0x3c, 0xe7, 0xc9, 0x7b, 0x25, 0xbc, 0x45, 0x8c, 0x10, 0xc2, 0x13, 0x31,
-	0x08, 0x99, 0xb1, 0x55, 0xd0, 0x85, 0x9b, 0xda, 0x46, 0xe8, 0x46, 0x4a, 0x4c, 0xa1, 0xba, 0x09,
-	0x93, 0xe9, 0x98, 0x0e, 0x36, 0x99, 0xd0, 0x99, 0x14, 0xf3, 0x07, 0xa5, 0x2b, 0x7f, 0xa0, 0x2b,
-	0x7f, 0xc6, 0x65, 0x97, 0x2e, 0xa5, 0xfd, 0x11, 0xc9, 0x04, 0xdb, 0x2e, 0x5c, 0xb8, 0x3b, 0x1c,
-	0xee, 0x39, 0xf7, 0x9e, 0x7b, 0xe0, 0x2b, 0x9e, 0x52, 0x4c, 0xca, 0x72, 0xc1, 0x29, 0x51, 0x5c,
-	0x14, 0x12, 0xf3, 0x42, 0xb1, 0x25, 0x9d, 0x13, 0x5e, 0x24, 0x84, 0x52, 0x51, 0x15, 0x4a, 0xe2,
-	0x55, 0x17, 0x97, 0x84, 0x7e, 0x65, 0x0a, 0x95, 0x4b, 0xa1, 0x84, 0xf5, 0x8c, 0xa7, 0x14, 0x9d,
-	0xab, 0xd0, 0x3f, 0x54, 0x68, 0xd5, 0x75, 0x1e, 0x65, 0x42, 0x64, 0x0b, 0x86, 0xb5, 0x2c, 0xad,
-	0xbe, 0x60, 0x52, 0xd4, 0xad, 0x87, 0x73, 0x9b, 0x89, 0x4c, 0x68, 0x88, 0x1b, 0xd4, 0xb2, 0x77,
-	0x6b, 0x00, 0x1f, 0x8f, 0x8e, 0x5e, 0xfd, 0xd6, 0x6a, 0xac, 0x77, 0x0f, 0x89, 0x22, 0x56, 0x1f,
-	0x9a, 0xaa, 0x2e, 0x99, 0x0d, 0x3c, 0xe0, 0x5f, 0xf7, 0x02, 0xf4, 0x9f, 0x87, 0xa0, 0xb8, 0x2e,
-	0x59, 0xa4, 0xa5, 0x96, 0x05, 0xcd, 0x19, 0x51, 0xc4, 0xbe, 0xe7, 0x01, 0xff, 0x61, 0xa4, 0x71,
-	0xc3, 0xe5, 0x2c, 0x17, 0xf6, 0x85, 0x07, 0xfc, 0x07, 0x91, 0xc6, 0x77, 0x6f, 0xe1, 0xe5, 0x40,
-	0xc8, 0x5c, 0xc8, 0xf8, 0x9b, 0xf5, 0x02, 0x5e, 0xe6, 0x4c, 0x4a, 0x92, 0x31, 0x69, 0x03, 0xef,
-	0xc2, 0xbf, 0xea, 0xdd, 0xa2, 0x36, 0x1a, 0xfa, 0x1b, 0x0d, 0xf5, 0x8b, 0x3a, 0x3a, 0x4e, 0x3d,
-	0x9f, 0x42, 0xb3, 0xd9, 0x69, 0x3d, 0x85, 0x9d, 0xf8, 0xd3, 0x38, 0x4c, 0x26, 0x1f, 0x3e, 0x8e,
-	0xc3, 0xc1, 0xe8, 0xfd, 0x28, 0x1c, 0x76, 0x0c, 0xe7, 0x66, 0xb3, 0xf5, 0xae, 0xce, 0x28, 0xeb,
-	0x09, 0xbc, 0xd1, 0x63, 0xe1, 0x34, 0x1c, 0x4c, 0xe2, 0x30, 0x89, 0xa7, 0x1d, 0xe0, 0x5c, 0x6f,
-	0xb6, 0x1e, 0x3c, 0x31, 0x8e, 0xb9, 0xfe, 0xe1, 0x1a, 0xef, 0x92, 0x9f, 0x7b, 0x17, 0xec, 0xf6,
-	0x2e, 0xf8, 0xbd, 0x77, 0xc1, 0xf7, 0x83, 0x6b, 0xec, 0x0e, 0xae, 0xf1, 0xeb, 0xe0, 0x1a, 0x9f,
-	0xc3, 0x8c, 0xab, 0x79, 0x95, 0x22, 0x2a, 0x72, 0x4c, 0xf5, 0xe9, 0x98, 0xa7, 0x34, 0xc8, 0x04,
-	0x5e, 0xbd, 0xc1, 0xb9, 0x98, 0x55, 0x0b, 0x26, 0x9b, 0xb2, 0x25, 0xee, 0xbd, 0x0e, 0x4e, 0x8f,
-	0x0a, 0x8e, 0x3d, 0x37, 0xff, 0x91, 0xe9, 0x7d, 0x1d, 0xe9, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf4, 0xb4, 0x4e, 0xa4, 0x1c, 0x02, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xda, 0x40,
+	0x10, 0xb5, 0x03, 0x8d, 0x60, 0x41, 0x09, 0xda, 0x46, 0x15, 0x71, 0x2b, 0xd7, 0xa2, 0xaa, 0x8a,
+	0x2a, 0xb1, 0xdb, 0xd0, 0xcf, 0x43, 0x2f, 0x84, 0xb8, 0x12, 0xaa, 0x54, 0x91, 0x2d, 0xa8, 0x49,
+	0x2f, 0x68, 0xbd, 0xd9, 0x18, 0xab, 0xd8, 0xeb, 0xb2, 0x6b, 0x54, 0xfe, 0x41, 0xc4, 0xa9, 0x7f,
+	0x80, 0x53, 0xff, 0x4c, 0x8e, 0x39, 0xf6, 0x54, 0x55, 0xf0, 0x47, 0x22, 0xaf, 0x13, 0xe0, 0x90,
+	0x43, 0x6e, 0x6f, 0x9e, 0xe7, 0x3d, 0xcf, 0x9b, 0x1d, 0xf0, 0x26, 0xf0, 0x18, 0xa6, 0x71, 0x3c,
+	0x0a, 0x18, 0x55, 0x81, 0x88, 0x24, 0x0e, 0x22, 0xc5, 0xc7, 0x6c, 0x48, 0x83, 0x68, 0x40, 0x19,
+	0x13, 0x49, 0xa4, 0x24, 0x9e, 0x1c, 0xe0, 0x98, 0xb2, 0x1f, 0x5c, 0xa1, 0x78, 0x2c, 0x94, 0x80,
+	0x2f, 0x02, 0x8f, 0xa1, 0x4d, 0x15, 0xba, 0x43, 0x85, 0x26, 0x07, 0xd6, 0xbe, 0x2f, 0x84, 0x3f,
+	0xe2, 0x58, 0xcb, 0xbc, 0xe4, 0x1c, 0xd3, 0x68, 0x9a, 0x79, 0x58, 0x7b, 0xbe, 0xf0, 0x85, 0x86,
+	0x38, 0x45, 0x19, 0x5b, 0xbb, 0x30, 0xc1, 0xe3, 0xce, 0xca, 0xab, 0x95, 0x59, 0x75, 0xf5, 0xbf,
+	0x8f, 0xa8, 0xa2, 0xb0, 0x05, 0xf2, 0x6a, 0x1a, 0xf3, 0xaa, 0xe9, 0x98, 0xf5, 0x9d, 0x66, 0x03,
+	0xdd, 0x73, 0x10, 0xd4, 0x9b, 0xc6, 0x9c, 0x68, 0x29, 0x84, 0x20, 0x7f, 0x46, 0x15, 0xad, 0x6e,
+	0x39, 0x66, 0xbd, 0x4c, 0x34, 0x4e, 0xb9, 0x90, 0x87, 0xa2, 0x9a, 0x73, 0xcc, 0x7a, 0x91, 0x68,
+	0x5c, 0xfb, 0x08, 0x0a, 0x6d, 0x21, 0x43, 0x21, 0x7b, 0xbf, 0xe0, 0x2b, 0x50, 0x08, 0xb9, 0x94,
+	0xd4, 0xe7, 0xb2, 0x6a, 0x3a, 0xb9, 0x7a, 0xa9, 0xb9, 0x87, 0xb2, 0x68, 0xe8, 0x36, 0x1a, 0x6a,
+	0x45, 0x53, 0xb2, 0xea, 0xaa, 0x9d, 0x83, 0x52, 0xa6, 0x3e, 0x4e, 0xf8, 0x78, 0x0a, 0xbf, 0x81,
+	0xc2, 0x98, 0xff, 0x4c, 0xb8, 0x54, 0xb7, 0x06, 0x6f, 0xef, 0x3d, 0xbb, 0x76, 0x20, 0x99, 0xfa,
+	0x30, 0x7f, 0xf9, 0xef, 0xa9, 0x41, 0x56, 0x66, 0xb5, 0x77, 0xa0, 0xbc, 0xf9, 0x3d, 0x4d, 0x12,
+	0x53, 0x35, 0xd4, 0x0b, 0x2a, 0x12, 0x8d, 0xef, 0x4a, 0x5c, 0xfb, 0x0c, 0x1e, 0x6e, 0xcc, 0x47,
+	0xb8, 0x8c, 0x45, 0x24, 0x39, 0x7c, 0x02, 0x8a, 0xe3, 0x1b, 0x9c, 0x0d, 0x5a, 0x26, 0x6b, 0x02,
+	0x3e, 0x02, 0xdb, 0x43, 0x1e, 0xf8, 0x43, 0xa5, 0xad, 0x72, 0xe4, 0xa6, 0x7a, 0x29, 0x41, 0x3e,
+	0x5d, 0x30, 0x7c, 0x0e, 0x2a, 0xbd, 0xd3, 0xae, 0x3b, 0xe8, 0x7f, 0xf9, 0xda, 0x75, 0xdb, 0x9d,
+	0x4f, 0x1d, 0xf7, 0xa8, 0x62, 0x58, 0xbb, 0xb3, 0xb9, 0x53, 0xda, 0xa0, 0xe0, 0x33, 0xb0, 0xab,
+	0xdb, 0xdc, 0x13, 0xb7, 0xdd, 0xef, 0xb9, 0x83, 0xde, 0x49, 0xc5, 0xb4, 0x76, 0x66, 0x73, 0x07,
+	0xac, 0x19, 0xb8, 0x0f, 0x80, 0x6e, 0x3a, 0xee, 0xbb, 0xe4, 0xb4, 0xb2, 0x65, 0x15, 0x67, 0x73,
+	0xe7, 0x81, 0x2e, 0xac, 0xfc, 0xc5, 0x1f, 0xdb, 0x38, 0x1c, 0x5c, 0x2e, 0x6c, 0xf3, 0x6a, 0x61,
+	0x9b, 0xff, 0x17, 0xb6, 0xf9, 0x7b, 0x69, 0x1b, 0x57, 0x4b, 0xdb, 0xf8, 0xbb, 0xb4, 0x8d, 0xef,
+	0xae, 0x1f, 0xa8, 0x61, 0xe2, 0x21, 0x26, 0x42, 0xcc, 0x74, 0x48, 0x1c, 0x78, 0xac, 0xe1, 0x0b,
+	0x3c, 0xf9, 0x80, 0x43, 0x71, 0x96, 0x8c, 0xb8, 0x4c, 0x8f, 0x5e, 0xe2, 0xe6, 0xfb, 0xc6, 0x7a,
+	0xe9, 0x8d, 0xd5, 0xbd, 0xa7, 0x77, 0x22, 0xbd, 0x6d, 0xfd, 0xb4, 0xaf, 0xaf, 0x07, 0x00, 0x88,
+	0xe7, 0x84, 0xbf, 0x24, 0x03, 0x00, 0x00,
 }
 
 func (m *InterchainAccountPacketData) Marshal() (dAtA []byte, err error) {
@@ -277,6 +447,117 @@ func (m *CosmosTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CosmosQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosmosQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmosQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmosQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Responses) > 0 {
+		for iNdEx := len(m.Responses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Responses[iNdEx])
+			copy(dAtA[i:], m.Responses[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.Responses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -323,6 +604,56 @@ func (m *CosmosTx) Size() (n int) {
 	return n
 }
 
+func (m *CosmosQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *CosmosQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, b := range m.Responses {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPacket(uint64(m.Height))
+	}
+	return n
+}
+
 func sovPacket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -548,6 +879,307 @@ func (m *CosmosTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CosmosQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, QueryRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosmosQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmosQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmosQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, make([]byte, postIndex-iNdEx))
+			copy(m.Responses[len(m.Responses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPacket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,6 +57,24 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"success, execution gas limit in memo",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: `{"ica_execution": {"gas_limit": "200000"}}`,
+			},
+			true,
+		},
+		{
+			"invalid execution gas limit in memo",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: `{"ica_execution": {"gas_limit": "-1"}}`,
+			},
+			false,
+		},
 		{
 			"memo too large",
 			types.InterchainAccountPacketData{
@@ -95,6 +113,11 @@ func (suite *TypesTestSuite) TestGetPacketSender() {
 			types.ControllerPortPrefix + ibctesting.TestAccAddress,
 			ibctesting.TestAccAddress,
 		},
+		{
+			"success: port id has prefix and account index",
+			types.ControllerPortPrefix + ibctesting.TestAccAddress + ".2",
+			ibctesting.TestAccAddress,
+		},
 		{
 			"failure: missing prefix",
 			ibctesting.TestAccAddress,
@@ -183,6 +206,45 @@ func (suite *TypesTestSuite) TestPacketDataProvider() {
 	}
 }
 
+func (suite *TypesTestSuite) TestGetExecutionGasLimit() {
+	testCases := []struct {
+		name        string
+		memo        string
+		expGasLimit uint64
+		expErr      bool
+	}{
+		{"success: gas limit in memo", `{"ica_execution": {"gas_limit": "200000"}}`, 200000, false},
+		{"success: empty memo", "", 0, false},
+		{"success: non-json memo", "memo", 0, false},
+		{"success: no execution options", `{"src_callback": {"gas_limit": "200000"}}`, 0, false},
+		{"success: no gas limit in execution options", `{"ica_execution": {}}`, 0, false},
+		{"failure: execution options are not an object", `{"ica_execution": "200000"}`, 0, true},
+		{"failure: gas limit is a number", `{"ica_execution": {"gas_limit": 200000}}`, 0, true},
+		{"failure: gas limit is negative", `{"ica_execution": {"gas_limit": "-1"}}`, 0, true},
+		{"failure: gas limit overflows", `{"ica_execution": {"gas_limit": "18446744073709551616"}}`, 0, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: tc.memo,
+			}
+
+			gasLimit, err := packetData.GetExecutionGasLimit()
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expGasLimit, gasLimit)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestPacketDataUnmarshalerInterface() {
 	expPacketData := types.InterchainAccountPacketData{
 		Type: types.EXECUTE_TX,
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,3 +16,42 @@ func NewControllerPortID(owner string) (string, error) {
 	ownerWithPrefix := ControllerPortPrefix + owner
 	return ownerWithPrefix, nil
 }
+
+// NewControllerPortIDWithAccountIndex creates and returns a new prefixed controller port identifier using the provided
+// owner string and account index. The account index is appended to the owner, allowing an owner to register multiple
+// interchain accounts on the same connection. The zero account index returns the port identifier of NewControllerPortID.
+func NewControllerPortIDWithAccountIndex(owner string, accountIndex uint64) (string, error) {
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if accountIndex == 0 {
+		return portID, nil
+	}
+
+	return portID + ControllerPortAccountIndexSeparator + strconv.FormatUint(accountIndex, 10), nil
+}
+
+// ParseControllerPortID returns the owner and the account index of the provided controller port identifier.
+// The zero account index is returned if the port identifier does not end with a non-zero account index.
+func ParseControllerPortID(portID string) (string, uint64, error) {
+	owner, found := strings.CutPrefix(portID, ControllerPortPrefix)
+	if !found || strings.TrimSpace(owner) == "" {
+		return "", 0, errorsmod.Wrapf(ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", ControllerPortPrefix, portID)
+	}
+
+	separatorIndex := strings.LastIndex(owner, ControllerPortAccountIndexSeparator)
+	if separatorIndex <= 0 {
+		return owner, 0, nil
+	}
+
+	// only canonical non-zero account indexes are parsed, so that each port identifier has a single owner and account index
+	indexStr := owner[separatorIndex+len(ControllerPortAccountIndexSeparator):]
+	accountIndex, err := strconv.ParseUint(indexStr, 10, 64)
+	if err != nil || accountIndex == 0 || strconv.FormatUint(accountIndex, 10) != indexStr {
+		return owner, 0, nil
+	}
+
+	return owner[:separatorIndex], accountIndex, nil
+}
@@ -55,3 +55,130 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestNewControllerPortIDWithAccountIndex() {
+	testCases := []struct {
+		name         string
+		owner        string
+		accountIndex uint64
+		expValue     string
+		expPass      bool
+	}{
+		{
+			"success: default account index",
+			TestOwnerAddress,
+			0,
+			types.ControllerPortPrefix + TestOwnerAddress,
+			true,
+		},
+		{
+			"success: non-zero account index",
+			TestOwnerAddress,
+			7,
+			types.ControllerPortPrefix + TestOwnerAddress + ".7",
+			true,
+		},
+		{
+			"invalid owner address",
+			"    ",
+			1,
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			portID, err := types.NewControllerPortIDWithAccountIndex(tc.owner, tc.accountIndex)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expValue, portID)
+
+				owner, accountIndex, err := types.ParseControllerPortID(portID)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.owner, owner)
+				suite.Require().Equal(tc.accountIndex, accountIndex)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Empty(portID)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestParseControllerPortID() {
+	testCases := []struct {
+		name            string
+		portID          string
+		expOwner        string
+		expAccountIndex uint64
+		expPass         bool
+	}{
+		{
+			"success: without account index",
+			types.ControllerPortPrefix + TestOwnerAddress,
+			TestOwnerAddress,
+			0,
+			true,
+		},
+		{
+			"success: with account index",
+			types.ControllerPortPrefix + TestOwnerAddress + ".12",
+			TestOwnerAddress,
+			12,
+			true,
+		},
+		{
+			"success: owner containing separator without account index",
+			types.ControllerPortPrefix + "owner.name",
+			"owner.name",
+			0,
+			true,
+		},
+		{
+			"success: zero account index is part of the owner",
+			types.ControllerPortPrefix + TestOwnerAddress + ".0",
+			TestOwnerAddress + ".0",
+			0,
+			true,
+		},
+		{
+			"success: non-canonical account index is part of the owner",
+			types.ControllerPortPrefix + TestOwnerAddress + ".01",
+			TestOwnerAddress + ".01",
+			0,
+			true,
+		},
+		{
+			"missing prefix",
+			TestOwnerAddress,
+			"",
+			0,
+			false,
+		},
+		{
+			"empty owner",
+			types.ControllerPortPrefix,
+			"",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			owner, accountIndex, err := types.ParseControllerPortID(tc.portID)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrInvalidControllerPort, tc.name)
+			}
+			suite.Require().Equal(tc.expOwner, owner)
+			suite.Require().Equal(tc.expAccountIndex, accountIndex)
+		})
+	}
+}
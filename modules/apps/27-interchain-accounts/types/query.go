@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewQueryRequest creates and returns a new QueryRequest for the provided gRPC query path and protobuf encoded request.
+func NewQueryRequest(path string, data []byte) QueryRequest {
+	return QueryRequest{
+		Path: path,
+		Data: data,
+	}
+}
+
+// ValidateBasic performs basic validation of the query request. The request data may be empty.
+func (qr QueryRequest) ValidateBasic() error {
+	if strings.TrimSpace(qr.Path) == "" {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, "query path cannot be empty")
+	}
+
+	if !strings.HasPrefix(qr.Path, "/") {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "query path must be a fully qualified gRPC method name, got %s", qr.Path)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation of the query requests contained in the CosmosQuery.
+func (cq CosmosQuery) ValidateBasic() error {
+	if len(cq.Requests) == 0 {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, "query requests cannot be empty")
+	}
+
+	for i, request := range cq.Requests {
+		if err := request.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid query request at index %d", i)
+		}
+	}
+
+	return nil
+}
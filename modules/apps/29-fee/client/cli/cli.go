@@ -26,6 +26,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdCounterpartyPayee(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdFeeModuleLock(),
+		GetCmdMinimumFee(),
+		GetCmdClientUpdateFees(),
+		GetCmdChannelUpgradeFees(),
+		GetCmdPayeeStatistics(),
+		GetCmdChannelPayeeStatistics(),
 	)
 
 	return queryCmd
@@ -45,6 +51,8 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterPayeeCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewPayClientUpdateFeeTxCmd(),
+		NewPayChannelUpgradeFeeTxCmd(),
 	)
 
 	return txCmd
@@ -437,3 +437,283 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdFeeModuleLock returns the command handler for the Query/FeeModuleLock rpc.
+func GetCmdFeeModuleLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lock",
+		Short:   "Query the lock status of the ibc-fee module",
+		Long:    "Query the lock status of the ibc-fee module, the reason it was locked and the deficit of the fee module account with respect to the fees in escrow",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee lock", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFeeModuleLockRequest{}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeModuleLock(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdMinimumFee returns the command handler for the Query/MinimumFee rpc.
+func GetCmdMinimumFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "minimum-fee [port-id] [channel-id]",
+		Short:   "Query the minimum fees required to send a packet over a channel",
+		Long:    "Query the minimum receive, acknowledgement and timeout fees which must be escrowed for a packet sent over a fee enabled channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee minimum-fee transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMinimumFeeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinimumFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdClientUpdateFees returns the command handler for the Query/ClientUpdateFees rpc.
+func GetCmdClientUpdateFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "client-update-fees [client-id]",
+		Short:   "Query the fees escrowed to incentivize the updates of a client",
+		Long:    "Query the fees escrowed to incentivize the updates of a client",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee client-update-fees 07-tendermint-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClientUpdateFeesRequest{
+				ClientId: args[0],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientUpdateFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChannelUpgradeFees returns the command handler for the Query/ChannelUpgradeFees rpc.
+func GetCmdChannelUpgradeFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upgrade-fees [port-id] [channel-id] [upgrade-sequence]",
+		Short:   "Query the fees escrowed to incentivize a channel upgrade handshake",
+		Long:    "Query the try, ack, confirm and open fees escrowed to incentivize the channel upgrade handshake at the given upgrade sequence",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee upgrade-fees transfer channel-6 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			upgradeSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelUpgradeFeesRequest{
+				PortId:          args[0],
+				ChannelId:       args[1],
+				UpgradeSequence: upgradeSequence,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelUpgradeFees(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdPayeeStatistics returns the command handler for the Query/PayeeStatistics rpc.
+func GetCmdPayeeStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payee-statistics [payee]",
+		Short: "Query the statistics of the packets for which a payee was paid fees",
+		Long: `Query the number of received, acknowledged and timed out packets for which a payee was paid fees, and the fees
+it earned or its share of the refunded fees, per channel and fee denomination. The statistics are aggregated over all time unless the time-buckets flag is set,
+in which case the statistics of each daily time bucket within the optional start and end time are returned.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee payee-statistics cosmos1... --channel-id channel-0 --time-buckets", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			timeBuckets, startTime, endTime, err := readTimeBucketFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPayeeStatisticsRequest{
+				Pagination:  pageReq,
+				Payee:       args[0],
+				ChannelId:   channelID,
+				TimeBuckets: timeBuckets,
+				StartTime:   startTime,
+				EndTime:     endTime,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PayeeStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagChannelID, "", "Channel identifier to filter the statistics by.")
+	addTimeBucketFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "payee-statistics")
+
+	return cmd
+}
+
+// GetCmdChannelPayeeStatistics returns the command handler for the Query/ChannelPayeeStatistics rpc.
+func GetCmdChannelPayeeStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-payee-statistics [channel-id]",
+		Short: "Query the statistics of the packets relayed over a channel for all payees",
+		Long: `Query the number of received, acknowledged and timed out packets relayed over a channel for which each payee was
+paid fees, and the fees it earned or its share of the refunded fees, per fee denomination. The statistics are aggregated over all time unless the time-buckets flag
+is set, in which case the statistics of each daily time bucket within the optional start and end time are returned.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee channel-payee-statistics channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			timeBuckets, startTime, endTime, err := readTimeBucketFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelPayeeStatisticsRequest{
+				Pagination:  pageReq,
+				ChannelId:   args[0],
+				TimeBuckets: timeBuckets,
+				StartTime:   startTime,
+				EndTime:     endTime,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelPayeeStatistics(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addTimeBucketFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel-payee-statistics")
+
+	return cmd
+}
+
+// addTimeBucketFlags adds the flags used to query payee statistics per time bucket to the command
+func addTimeBucketFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagTimeBuckets, false, "Query the statistics of each daily time bucket instead of the statistics aggregated over all time.")
+	cmd.Flags().Uint64(flagStartTime, 0, "Unix time in seconds, time buckets starting before it are omitted. Requires --time-buckets.")
+	cmd.Flags().Uint64(flagEndTime, 0, "Unix time in seconds, time buckets starting at or after it are omitted. Requires --time-buckets.")
+}
+
+// readTimeBucketFlags reads the flags used to query payee statistics per time bucket from the command
+func readTimeBucketFlags(cmd *cobra.Command) (timeBuckets bool, startTime, endTime uint64, err error) {
+	timeBuckets, err = cmd.Flags().GetBool(flagTimeBuckets)
+	if err != nil {
+		return false, 0, 0, err
+	}
+
+	startTime, err = cmd.Flags().GetUint64(flagStartTime)
+	if err != nil {
+		return false, 0, 0, err
+	}
+
+	endTime, err = cmd.Flags().GetUint64(flagEndTime)
+	if err != nil {
+		return false, 0, 0, err
+	}
+
+	return timeBuckets, startTime, endTime, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -18,19 +19,31 @@ import (
 )
 
 const (
-	flagRecvFee    = "recv-fee"
-	flagAckFee     = "ack-fee"
-	flagTimeoutFee = "timeout-fee"
+	flagRecvFee         = "recv-fee"
+	flagAckFee          = "ack-fee"
+	flagTimeoutFee      = "timeout-fee"
+	flagTryFee          = "try-fee"
+	flagConfirmFee      = "confirm-fee"
+	flagOpenFee         = "open-fee"
+	flagRefreshInterval = "refresh-interval"
+	flagExpiry          = "expiry"
+	flagChannelID       = "channel-id"
+	flagTimeBuckets     = "time-buckets"
+	flagStartTime       = "start-time"
+	flagEndTime         = "end-time"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
 func NewRegisterPayeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "register-payee [port-id] [channel-id] [relayer] [payee] ",
-		Short:   "Register a payee on a given channel.",
-		Long:    strings.TrimSpace(`Register a payee address on a given channel.`),
-		Example: fmt.Sprintf("%s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
-		Args:    cobra.ExactArgs(4),
+		Use:   "register-payee [port-id] [channel-id] [relayer] [payee] ",
+		Short: "Register a payee on a given channel.",
+		Long: strings.TrimSpace(`Register a payee address on a given channel.
+The fees paid out to the relayer may be split between multiple payees in proportion to their weights,
+by providing a comma separated list of address:weight pairs as payee.`),
+		Example: fmt.Sprintf(`%[1]s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5
+%[1]s tx ibc-fee register-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5:70,cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs:30`, version.AppName),
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -38,6 +51,14 @@ func NewRegisterPayeeCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgRegisterPayee(args[0], args[1], args[2], args[3])
+			if strings.Contains(args[3], ":") {
+				payeeShares, err := types.ParsePayeeShares(args[3])
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgRegisterPayeeShares(args[0], args[1], args[2], payeeShares)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -148,3 +169,102 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewPayClientUpdateFeeTxCmd returns the command to create a MsgPayClientUpdateFee
+func NewPayClientUpdateFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pay-client-update-fee [client-id] [fee] [max-updates]",
+		Short:   "Pay a fee to incentivize the updates of a light client",
+		Long:    strings.TrimSpace(`Pay a fee to incentivize up to max-updates updates of a light client, paid at most once per refresh interval. The unspent fees are refunded on expiry.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-client-update-fee 07-tendermint-0 10stake 24 --refresh-interval 1h --expiry 48h", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fee, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			maxUpdates, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			refreshInterval, err := cmd.Flags().GetDuration(flagRefreshInterval)
+			if err != nil {
+				return err
+			}
+
+			expiry, err := cmd.Flags().GetDuration(flagExpiry)
+			if err != nil {
+				return err
+			}
+
+			expiryTimestamp := uint64(time.Now().Add(expiry).UnixNano())
+
+			msg := types.NewMsgPayClientUpdateFee(args[0], fee, maxUpdates, uint64(refreshInterval.Nanoseconds()), expiryTimestamp, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagRefreshInterval, 0, "Minimum time between two paid client updates.")
+	cmd.Flags().Duration(flagExpiry, 24*time.Hour, "Time, relative to the current local time, after which the unspent fees are refunded.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewPayChannelUpgradeFeeTxCmd returns the command to create a MsgPayChannelUpgradeFee
+func NewPayChannelUpgradeFeeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pay-upgrade-fee [port-id] [channel-id] [upgrade-sequence]",
+		Short:   "Pay a fee to incentivize a channel upgrade handshake",
+		Long:    strings.TrimSpace(`Pay a fee to incentivize the relaying of the try, ack, confirm and open messages of a channel upgrade handshake.`),
+		Example: fmt.Sprintf("%s tx ibc-fee pay-upgrade-fee transfer channel-0 1 --try-fee 10stake --ack-fee 10stake --confirm-fee 10stake --open-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			upgradeSequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var stepFees []sdk.Coins
+			for _, flag := range []string{flagTryFee, flagAckFee, flagConfirmFee, flagOpenFee} {
+				feeStr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+
+				fee, err := sdk.ParseCoinsNormalized(feeStr)
+				if err != nil {
+					return err
+				}
+
+				stepFees = append(stepFees, fee)
+			}
+
+			fee := types.NewUpgradeFee(stepFees[0], stepFees[1], stepFees[2], stepFees[3])
+			msg := types.NewMsgPayChannelUpgradeFee(args[0], args[1], upgradeSequence, fee, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagTryFee, "", "Fee paid to a relayer for relaying a channel upgrade try.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a channel upgrade acknowledgement.")
+	cmd.Flags().String(flagConfirmFee, "", "Fee paid to a relayer for relaying a channel upgrade confirm.")
+	cmd.Flags().String(flagOpenFee, "", "Fee paid to a relayer for relaying a channel upgrade open.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -218,6 +218,8 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
+	ctx = types.WithinPacketCallback(ctx)
+
 	if !im.keeper.IsFeeEnabled(ctx, packet.DestinationPort, packet.DestinationChannel) {
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
@@ -244,6 +246,8 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	ctx = types.WithinPacketCallback(ctx)
+
 	if !im.keeper.IsFeeEnabled(ctx, packet.SourcePort, packet.SourceChannel) {
 		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	}
@@ -272,17 +276,18 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
+	payeeShares, found := im.keeper.GetPayeeShares(ctx, relayer.String(), packet.SourceChannel)
 	if !found {
-		payee = relayer.String()
+		payeeShares = []types.PayeeShare{types.NewPayeeShare(relayer.String(), 1)}
 	}
 
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+	for _, payeeShare := range payeeShares {
+		if _, err := sdk.AccAddressFromBech32(payeeShare.Address); err != nil {
+			return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payeeShare.Address)
+		}
 	}
 
-	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, payeeShares, feesInEscrow.PacketFees, packetID)
 
 	// call underlying callback
 	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
@@ -295,6 +300,8 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	ctx = types.WithinPacketCallback(ctx)
+
 	// if the fee keeper is locked then fee logic should be skipped
 	// this may occur in the presence of a severe bug which leads to invalid state
 	// the fee keeper will be unlocked after manual intervention
@@ -311,17 +318,18 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return im.app.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
+	payeeShares, found := im.keeper.GetPayeeShares(ctx, relayer.String(), packet.SourceChannel)
 	if !found {
-		payee = relayer.String()
+		payeeShares = []types.PayeeShare{types.NewPayeeShare(relayer.String(), 1)}
 	}
 
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+	for _, payeeShare := range payeeShares {
+		if _, err := sdk.AccAddressFromBech32(payeeShare.Address); err != nil {
+			return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payeeShare.Address)
+		}
 	}
 
-	im.keeper.DistributePacketFeesOnTimeout(ctx, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnTimeout(ctx, payeeShares, feesInEscrow.PacketFees, packetID)
 
 	// call underlying callback
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
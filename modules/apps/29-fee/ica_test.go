@@ -155,7 +155,7 @@ func (suite *FeeTestSuite) TestFeeInterchainAccounts() {
 	}
 
 	// ensure chainB is allowed to execute stakingtypes.MsgDelegate
-	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)})
+	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)}, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	// build the interchain accounts packet
@@ -9,7 +9,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // escrowPacketFee sends the packet fee to the 29-fee module account to hold in escrow
@@ -46,7 +48,10 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 }
 
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
-func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+// The acknowledgement fees are split between the payee shares of the reverse relayer in proportion to their weights.
+// The fees earned by the forward relayer and the payees of the reverse relayer, and refunded on acknowledgement, are recorded
+// in the payee statistics.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reversePayeeShares []types.PayeeShare, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
@@ -54,6 +59,8 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 	// forward relayer address will be empty if conversion fails
 	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
 
+	var recvFees, refundedFees sdk.Coins
+	ackFeeShares := make([]sdk.Coins, len(reversePayeeShares))
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -62,7 +69,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			// fee disabled channels
 			// NOTE: we use the uncached context to lock the fee module so that the state changes from
 			// locking the fee module are persisted
-			k.lockFeeModule(ctx)
+			k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to distribute fees on acknowledgement of packet with port ID %s, channel ID %s and sequence %d", packetID.PortId, packetID.ChannelId, packetID.Sequence))
 			return
 		}
 
@@ -72,9 +79,23 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reversePayeeShares, packetFee)
+
+		recvFees = recvFees.Add(packetFee.Fee.RecvFee...)
+		addFeeShares(ackFeeShares, types.SplitFee(reversePayeeShares, packetFee.Fee.AckFee))
+		refundedFees = refundedFees.Add(packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)...)
+	}
+
+	// the receive fees are refunded if the forward relayer is not a valid address
+	if !forwardAddr.Empty() && !k.bankKeeper.BlockedAddr(forwardAddr) {
+		forwardPayeeShares := []types.PayeeShare{types.NewPayeeShare(forwardAddr.String(), 1)}
+		k.recordPayeeStatistics(cacheCtx, forwardPayeeShares, packetID.ChannelId, types.PacketStepRecv, []sdk.Coins{recvFees}, nil)
+	} else {
+		refundedFees = refundedFees.Add(recvFees...)
 	}
 
+	k.recordPayeeStatistics(cacheCtx, reversePayeeShares, packetID.ChannelId, types.PacketStepAck, ackFeeShares, refundedFees)
+
 	// write the cache
 	writeFn()
 
@@ -84,7 +105,7 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer sdk.AccAddress, reversePayeeShares []types.PayeeShare, packetFee types.PacketFee) {
 	// distribute fee to valid forward relayer address otherwise refund the fee
 	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
 		// distribute fee for forward relaying
@@ -95,7 +116,7 @@ func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr
 	}
 
 	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	k.distributeFeeToPayees(ctx, reversePayeeShares, refundAddr, packetFee.Fee.AckFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
@@ -103,11 +124,15 @@ func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr
 }
 
 // DistributePacketFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
-func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+// The timeout fees are split between the payee shares of the timeout relayer in proportion to their weights.
+// The fees earned by the payees of the timeout relayer, and refunded on timeout, are recorded in the payee statistics.
+func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutPayeeShares []types.PayeeShare, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var refundedFees sdk.Coins
+	timeoutFeeShares := make([]sdk.Coins, len(timeoutPayeeShares))
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
 			// if the escrow account does not have sufficient funds then there must exist a severe bug
@@ -116,7 +141,7 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			// fee disabled channels
 			// NOTE: we use the uncached context to lock the fee module so that the state changes from
 			// locking the fee module are persisted
-			k.lockFeeModule(ctx)
+			k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to distribute fees on timeout of packet with port ID %s, channel ID %s and sequence %d", packetID.PortId, packetID.ChannelId, packetID.Sequence))
 			return
 		}
 
@@ -126,9 +151,14 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutPayeeShares, packetFee)
+
+		addFeeShares(timeoutFeeShares, types.SplitFee(timeoutPayeeShares, packetFee.Fee.TimeoutFee))
+		refundedFees = refundedFees.Add(packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)...)
 	}
 
+	k.recordPayeeStatistics(cacheCtx, timeoutPayeeShares, packetID.ChannelId, types.PacketStepTimeout, timeoutFeeShares, refundedFees)
+
 	// write the cache
 	writeFn()
 
@@ -137,15 +167,73 @@ func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sd
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr sdk.AccAddress, timeoutPayeeShares []types.PayeeShare, packetFee types.PacketFee) {
 	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+	k.distributeFeeToPayees(ctx, timeoutPayeeShares, refundAddr, packetFee.Fee.TimeoutFee)
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
 	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
 }
 
+// recordPayeeStatistics adds a packet relayed over the channel at the given step to the statistics of each payee share,
+// aggregated over all time and over the current time bucket, for each denomination of the fees earned by the payee and
+// of its share of the refunded fees. The refunded fees are split between the payee shares in proportion to their weights.
+// The time buckets which are no longer retained are pruned once a payee records statistics in a new time bucket.
+func (k Keeper) recordPayeeStatistics(ctx sdk.Context, payeeShares []types.PayeeShare, channelID string, step types.PacketStep, earnedShares []sdk.Coins, refunded sdk.Coins) {
+	currentBucketStart := types.PayeeStatisticsBucketStart(ctx.BlockTime())
+	bucketStarts := []uint64{0}
+	if currentBucketStart != 0 {
+		bucketStarts = append(bucketStarts, currentBucketStart)
+	}
+
+	refundedShares := types.SplitFee(payeeShares, refunded)
+	for i, payeeShare := range payeeShares {
+		var newBucket bool
+		for _, denom := range earnedShares[i].Add(refundedShares[i]...).Denoms() {
+			for _, bucketStart := range bucketStarts {
+				payeeStatistics, found := k.GetPayeeStatistics(ctx, payeeShare.Address, channelID, denom, bucketStart)
+				if !found {
+					payeeStatistics = types.NewPayeeStatistics(payeeShare.Address, channelID, denom, bucketStart)
+					newBucket = newBucket || payeeStatistics.IsTimeBucket()
+				}
+
+				k.SetPayeeStatistics(ctx, payeeStatistics.Add(step, earnedShares[i].AmountOf(denom), refundedShares[i].AmountOf(denom)))
+			}
+		}
+
+		if newBucket {
+			k.PrunePayeeStatisticsBuckets(ctx, payeeShare.Address, channelID, currentBucketStart)
+		}
+	}
+}
+
+// addFeeShares adds the shares of a fee split between payee shares to the fee shares accumulated for the payee shares
+func addFeeShares(feeShares, shares []sdk.Coins) {
+	for i, share := range shares {
+		feeShares[i] = feeShares[i].Add(share...)
+	}
+}
+
+// distributeFeeToPayees splits the escrowed fee between the payee shares in proportion to their weights and attempts to
+// distribute each share to its payee address. When the fee is split between multiple payees, shares which are rounded
+// down to zero are skipped.
+// If a payee address is invalid, its share is refunded.
+func (k Keeper) distributeFeeToPayees(ctx sdk.Context, payeeShares []types.PayeeShare, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+	for i, share := range types.SplitFee(payeeShares, fee) {
+		if len(payeeShares) > 1 && share.IsZero() {
+			continue
+		}
+
+		payeeAddr, err := sdk.AccAddressFromBech32(payeeShares[i].Address)
+		if err != nil {
+			payeeAddr = refundAccAddress
+		}
+
+		k.distributeFee(ctx, payeeAddr, refundAccAddress, share)
+	}
+}
+
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
 // the state changes will be discarded.
@@ -199,7 +287,7 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 				// fee disabled channels
 				// NOTE: we use the uncached context to lock the fee module so that the state changes from
 				// locking the fee module are persisted
-				k.lockFeeModule(ctx)
+				k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to refund fees on closure of channel with port ID %s and channel ID %s", portID, channelID))
 
 				// return a nil error so state changes are committed but distribution stops
 				return nil
@@ -228,3 +316,300 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 
 	return nil
 }
+
+// escrowClientUpdateFee sends the total client update fee to the 29-fee module account to hold in escrow
+func (k Keeper) escrowClientUpdateFee(ctx sdk.Context, clientID string, clientUpdateFee types.ClientUpdateFee) error {
+	// check if the refund address is valid
+	refundAddr, err := sdk.AccAddressFromBech32(clientUpdateFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	refundAcc := k.authKeeper.GetAccount(ctx, refundAddr)
+	if refundAcc == nil {
+		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", clientUpdateFee.RefundAddress)
+	}
+
+	fees := []types.ClientUpdateFee{clientUpdateFee}
+	if clientUpdateFees, found := k.GetClientUpdateFees(ctx, clientID); found {
+		fees = append(fees, clientUpdateFees.ClientUpdateFees...)
+	}
+
+	if len(fees) > types.MaxClientUpdateFees {
+		return errorsmod.Wrapf(types.ErrTooManyClientUpdateFees, "client %s already has the maximum of %d client update fees in escrow", clientID, types.MaxClientUpdateFees)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, clientUpdateFee.Total()); err != nil {
+		return err
+	}
+
+	k.SetClientUpdateFees(ctx, clientID, types.NewClientUpdateFees(fees))
+
+	emitIncentivizedClientUpdateEvent(ctx, clientID, clientUpdateFee)
+
+	return nil
+}
+
+// escrowChannelUpgradeFee sends the total channel upgrade fee to the 29-fee module account to hold in escrow
+func (k Keeper) escrowChannelUpgradeFee(ctx sdk.Context, portID, channelID string, upgradeSequence uint64, channelUpgradeFee types.ChannelUpgradeFee) error {
+	// check if the refund address is valid
+	refundAddr, err := sdk.AccAddressFromBech32(channelUpgradeFee.RefundAddress)
+	if err != nil {
+		return err
+	}
+
+	refundAcc := k.authKeeper.GetAccount(ctx, refundAddr)
+	if refundAcc == nil {
+		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", channelUpgradeFee.RefundAddress)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, channelUpgradeFee.Fee.Total()); err != nil {
+		return err
+	}
+
+	fees := []types.ChannelUpgradeFee{channelUpgradeFee}
+	if channelUpgradeFees, found := k.GetChannelUpgradeFees(ctx, portID, channelID, upgradeSequence); found {
+		fees = append(fees, channelUpgradeFees.ChannelUpgradeFees...)
+	}
+
+	channelUpgradeFees := types.NewChannelUpgradeFees(fees)
+	k.SetChannelUpgradeFees(ctx, portID, channelID, upgradeSequence, channelUpgradeFees)
+
+	emitIncentivizedChannelUpgradeEvent(ctx, portID, channelID, upgradeSequence, channelUpgradeFees)
+
+	return nil
+}
+
+// DistributeClientUpdateFees pays the relayer of an update of the given client which resulted in the provided latest client height.
+// Each client update fee escrowed for the client pays its fee at most once per refresh interval, only for updates which advance
+// the latest client height beyond the height of its last paid update and only until it expires or all of its incentivized
+// updates are paid. The fee module is locked if the escrow account has insufficient balance. No fees are distributed while
+// the fee module is locked.
+func (k Keeper) DistributeClientUpdateFees(ctx sdk.Context, clientID string, latestHeight exported.Height, relayer sdk.AccAddress) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	clientUpdateFees, found := k.GetClientUpdateFees(ctx, clientID)
+	if !found {
+		return
+	}
+
+	// cache context before trying to distribute fees
+	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	var unpaidFees []types.ClientUpdateFee
+	for _, clientUpdateFee := range clientUpdateFees.ClientUpdateFees {
+		if clientUpdateFee.IsExpired(blockTime) || !clientUpdateFee.IsRefreshed(blockTime) || !latestHeight.GT(clientUpdateFee.LastPaidHeight) {
+			unpaidFees = append(unpaidFees, clientUpdateFee)
+			continue
+		}
+
+		if !k.EscrowAccountHasBalance(cacheCtx, clientUpdateFee.Fee) {
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to distribute fees on update of client %s", clientID))
+			return
+		}
+
+		refundAddr, err := sdk.AccAddressFromBech32(clientUpdateFee.RefundAddress)
+		if err != nil {
+			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", clientUpdateFee.RefundAddress))
+		}
+
+		k.distributeFee(cacheCtx, relayer, refundAddr, clientUpdateFee.Fee)
+
+		clientUpdateFee.RemainingUpdates--
+		clientUpdateFee.LastPaidTimestamp = blockTime
+		clientUpdateFee.LastPaidHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight())
+		if clientUpdateFee.RemainingUpdates > 0 {
+			unpaidFees = append(unpaidFees, clientUpdateFee)
+		}
+	}
+
+	if len(unpaidFees) == 0 {
+		k.DeleteClientUpdateFees(cacheCtx, clientID)
+	} else {
+		k.SetClientUpdateFees(cacheCtx, clientID, types.NewClientUpdateFees(unpaidFees))
+	}
+
+	// write the cache
+	writeFn()
+}
+
+// RefundExpiredClientUpdateFees refunds the unspent fees of all the client update fees whose expiry timestamp
+// has been reached. Only the clients indexed with an expired client update fee are visited. The fee module is
+// locked if the escrow account has insufficient balance. No fees are refunded while the fee module is locked.
+func (k Keeper) RefundExpiredClientUpdateFees(ctx sdk.Context) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+
+	clientIDs := k.GetExpiredClientUpdateFeesClientIDs(ctx, blockTime)
+	if len(clientIDs) == 0 {
+		return
+	}
+
+	// cache context before trying to refund fees
+	// if the escrow account has insufficient balance then we want to avoid partially refunding fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, clientID := range clientIDs {
+		clientUpdateFees, found := k.GetClientUpdateFees(cacheCtx, clientID)
+		if !found {
+			continue
+		}
+
+		var unexpiredFees []types.ClientUpdateFee
+		for _, clientUpdateFee := range clientUpdateFees.ClientUpdateFees {
+			if !clientUpdateFee.IsExpired(blockTime) {
+				unexpiredFees = append(unexpiredFees, clientUpdateFee)
+				continue
+			}
+
+			if !k.EscrowAccountHasBalance(cacheCtx, clientUpdateFee.Total()) {
+				// NOTE: we use the uncached context to lock the fee module so that the state changes from
+				// locking the fee module are persisted
+				k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to refund expired fees for updates of client %s", clientID))
+				return
+			}
+
+			refundAddr, err := sdk.AccAddressFromBech32(clientUpdateFee.RefundAddress)
+			if err != nil {
+				panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", clientUpdateFee.RefundAddress))
+			}
+
+			k.distributeFee(cacheCtx, refundAddr, refundAddr, clientUpdateFee.Total())
+		}
+
+		if len(unexpiredFees) == 0 {
+			k.DeleteClientUpdateFees(cacheCtx, clientID)
+		} else {
+			k.SetClientUpdateFees(cacheCtx, clientID, types.NewClientUpdateFees(unexpiredFees))
+		}
+	}
+
+	// write the cache
+	writeFn()
+}
+
+// DistributeChannelUpgradeFees pays the fees of the given step of the channel upgrade handshake, escrowed for the current
+// upgrade sequence of the channel, to the relayer of the step. The fees are split between the payee shares registered
+// by the relayer for the channel in proportion to their weights. Once the step is paid, the fees escrowed for channel
+// upgrades which are no longer in progress are refunded. The fee module is locked if the escrow account has insufficient
+// balance. No fees are distributed while the fee module is locked.
+func (k Keeper) DistributeChannelUpgradeFees(ctx sdk.Context, portID, channelID string, step types.UpgradeStep, relayer string) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	payeeShares, found := k.GetPayeeShares(ctx, relayer, channelID)
+	if !found {
+		payeeShares = []types.PayeeShare{types.NewPayeeShare(relayer, 1)}
+	}
+
+	if channelUpgradeFees, found := k.GetChannelUpgradeFees(ctx, portID, channelID, channel.UpgradeSequence); found {
+		// cache context before trying to distribute fees
+		// if the escrow account has insufficient balance then we want to avoid partially distributing fees
+		cacheCtx, writeFn := ctx.CacheContext()
+
+		var unpaidFees []types.ChannelUpgradeFee
+		for _, channelUpgradeFee := range channelUpgradeFees.ChannelUpgradeFees {
+			stepFee := channelUpgradeFee.Fee.StepFee(step)
+			if stepFee.IsZero() {
+				unpaidFees = append(unpaidFees, channelUpgradeFee)
+				continue
+			}
+
+			if !k.EscrowAccountHasBalance(cacheCtx, stepFee) {
+				// NOTE: we use the uncached context to lock the fee module so that the state changes from
+				// locking the fee module are persisted
+				k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to distribute fees on upgrade of channel with port ID %s, channel ID %s and upgrade sequence %d", portID, channelID, channel.UpgradeSequence))
+				return
+			}
+
+			refundAddr, err := sdk.AccAddressFromBech32(channelUpgradeFee.RefundAddress)
+			if err != nil {
+				panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", channelUpgradeFee.RefundAddress))
+			}
+
+			k.distributeFeeToPayees(cacheCtx, payeeShares, refundAddr, stepFee)
+
+			channelUpgradeFee.Fee = channelUpgradeFee.Fee.WithoutStepFee(step)
+			if !channelUpgradeFee.Fee.IsZero() {
+				unpaidFees = append(unpaidFees, channelUpgradeFee)
+			}
+		}
+
+		if len(unpaidFees) == 0 {
+			k.DeleteChannelUpgradeFees(cacheCtx, portID, channelID, channel.UpgradeSequence)
+		} else {
+			k.SetChannelUpgradeFees(cacheCtx, portID, channelID, channel.UpgradeSequence, types.NewChannelUpgradeFees(unpaidFees))
+		}
+
+		// write the cache
+		writeFn()
+	}
+
+	k.RefundChannelUpgradeFees(ctx, portID, channelID)
+}
+
+// RefundChannelUpgradeFees refunds the unspent fees escrowed for the channel upgrades of the given channel which are no
+// longer in progress, i.e. channel upgrades with a sequence lower than the current upgrade sequence of the channel, or
+// equal to it if no upgrade is in progress. All the fees are refunded if the channel is closed. The fee module is locked
+// if the escrow account has insufficient balance. No fees are refunded while the fee module is locked.
+func (k Keeper) RefundChannelUpgradeFees(ctx sdk.Context, portID, channelID string) {
+	if k.IsLocked(ctx) {
+		return
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	_, upgradeInProgress := k.GetUpgrade(ctx, portID, channelID)
+	isClosed := channel.State == channeltypes.CLOSED
+
+	// cache context before trying to refund fees
+	// if the escrow account has insufficient balance then we want to avoid partially refunding fees
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	for _, identifiedFees := range k.GetIdentifiedChannelUpgradeFeesForChannel(ctx, portID, channelID) {
+		isCurrentUpgrade := identifiedFees.UpgradeSequence == channel.UpgradeSequence && upgradeInProgress
+		if !isClosed && (identifiedFees.UpgradeSequence > channel.UpgradeSequence || isCurrentUpgrade) {
+			continue
+		}
+
+		for _, channelUpgradeFee := range identifiedFees.ChannelUpgradeFees {
+			if !k.EscrowAccountHasBalance(cacheCtx, channelUpgradeFee.Fee.Total()) {
+				// NOTE: we use the uncached context to lock the fee module so that the state changes from
+				// locking the fee module are persisted
+				k.lockFeeModule(ctx, fmt.Sprintf("insufficient escrow balance to refund fees for upgrade of channel with port ID %s, channel ID %s and upgrade sequence %d", portID, channelID, identifiedFees.UpgradeSequence))
+				return
+			}
+
+			refundAddr, err := sdk.AccAddressFromBech32(channelUpgradeFee.RefundAddress)
+			if err != nil {
+				panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", channelUpgradeFee.RefundAddress))
+			}
+
+			k.distributeFee(cacheCtx, refundAddr, refundAddr, channelUpgradeFee.Fee.Total())
+		}
+
+		k.DeleteChannelUpgradeFees(cacheCtx, portID, channelID, identifiedFees.UpgradeSequence)
+	}
+
+	// write the cache
+	writeFn()
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"slices"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,21 +12,24 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
 func (suite *KeeperTestSuite) TestDistributeFee() {
 	var (
-		forwardRelayer    string
-		forwardRelayerBal sdk.Coin
-		reverseRelayer    sdk.AccAddress
-		reverseRelayerBal sdk.Coin
-		refundAcc         sdk.AccAddress
-		refundAccBal      sdk.Coin
-		packetFee         types.PacketFee
-		packetFees        []types.PacketFee
-		fee               types.Fee
+		forwardRelayer     string
+		forwardRelayerBal  sdk.Coin
+		reverseRelayer     sdk.AccAddress
+		reverseRelayerBal  sdk.Coin
+		reversePayeeShares []types.PayeeShare
+		refundAcc          sdk.AccAddress
+		refundAccBal       sdk.Coin
+		packetFee          types.PacketFee
+		packetFees         []types.PacketFee
+		fee                types.Fee
 	)
 
 	testCases := []struct {
@@ -63,6 +69,65 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				// check the module acc wallet is now empty
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+
+				// check the payee statistics are recorded
+				forwardStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), forwardRelayer, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), forwardStatistics.RecvPackets)
+				suite.Require().Equal(defaultRecvFee[0].Amount.MulRaw(2), forwardStatistics.FeesEarned)
+				suite.Require().True(forwardStatistics.FeesRefunded.IsZero())
+
+				reverseStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), reverseStatistics.AckPackets)
+				suite.Require().Equal(defaultAckFee[0].Amount.MulRaw(2), reverseStatistics.FeesEarned)
+				suite.Require().True(reverseStatistics.FeesRefunded.IsZero())
+
+				bucketStart := types.PayeeStatisticsBucketStart(suite.chainA.GetContext().BlockTime())
+				bucketStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, bucketStart)
+				suite.Require().True(found)
+				suite.Require().Equal(reverseStatistics.AckPackets, bucketStatistics.AckPackets)
+				suite.Require().Equal(reverseStatistics.FeesEarned, bucketStatistics.FeesEarned)
+			},
+		},
+		{
+			"success: ack fee split between payee shares",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				reversePayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(reverseRelayer.String(), 2),
+					types.NewPayeeShare(payee.String(), 1),
+				}
+			},
+			func() {
+				// the ack fee of 200 is split 134/66, the remainder of the split being paid to the payee with the largest weight
+				expectedReverseAccBal := reverseRelayerBal.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(268)))
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				payee, err := sdk.AccAddressFromBech32(reversePayeeShares[1].Address)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(132)), balance)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+
+				// check the ack fee shares are recorded in the statistics of each payee
+				reverseStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), reverseStatistics.AckPackets)
+				suite.Require().Equal(sdkmath.NewInt(268), reverseStatistics.FeesEarned)
+
+				payeeStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), payee.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), payeeStatistics.AckPackets)
+				suite.Require().Equal(sdkmath.NewInt(132), payeeStatistics.FeesEarned)
 			},
 		},
 		{
@@ -138,12 +203,16 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().NotEmpty(suite.chainA.GetSimApp().IBCFeeKeeper.GetLockReason(suite.chainA.GetContext()))
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
 
 				// check if the module acc contains all the fees
 				expectedModuleAccBal := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
 				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
 				suite.Require().Equal(expectedModuleAccBal, balance)
+
+				// check no payee statistics are recorded
+				suite.Require().Empty(suite.chainA.GetSimApp().IBCFeeKeeper.GetAllPayeeStatistics(suite.chainA.GetContext()))
 			},
 		},
 		{
@@ -159,6 +228,11 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				expectedRefundAccBal := refundAccBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
 				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check the refunded recvFee is recorded in the reverse payee statistics
+				reverseStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(defaultRecvFee[0].Amount.MulRaw(2), reverseStatistics.FeesRefunded)
 			},
 		},
 		{
@@ -227,9 +301,14 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 
 			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			reversePayeeShares = nil
 
 			tc.malleate()
 
+			if reversePayeeShares == nil {
+				reversePayeeShares = []types.PayeeShare{types.NewPayeeShare(reverseRelayer.String(), 1)}
+			}
+
 			// escrow the packet fees & store the fees in state
 			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
 			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, packetFee.Fee.Total().Add(packetFee.Fee.Total()...))
@@ -241,7 +320,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			reverseRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees, packetID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reversePayeeShares, packetFees, packetID)
 			tc.expResult()
 		})
 	}
@@ -249,13 +328,14 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 
 func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 	var (
-		timeoutRelayer    sdk.AccAddress
-		timeoutRelayerBal sdk.Coin
-		refundAcc         sdk.AccAddress
-		refundAccBal      sdk.Coin
-		fee               types.Fee
-		packetFee         types.PacketFee
-		packetFees        []types.PacketFee
+		timeoutRelayer     sdk.AccAddress
+		timeoutRelayerBal  sdk.Coin
+		timeoutPayeeShares []types.PayeeShare
+		refundAcc          sdk.AccAddress
+		refundAccBal       sdk.Coin
+		fee                types.Fee
+		packetFee          types.PacketFee
+		packetFees         []types.PacketFee
 	)
 
 	testCases := []struct {
@@ -280,6 +360,50 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				// check the module acc wallet is now empty
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+
+				// check the payee statistics are recorded
+				timeoutStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), timeoutStatistics.TimeoutPackets)
+				suite.Require().Equal(defaultTimeoutFee[0].Amount.MulRaw(2), timeoutStatistics.FeesEarned)
+				suite.Require().True(timeoutStatistics.FeesRefunded.IsZero())
+			},
+		},
+		{
+			"success: timeout fee split between payee shares",
+			func() {
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				timeoutPayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(timeoutRelayer.String(), 3),
+					types.NewPayeeShare(payee.String(), 4),
+				}
+			},
+			func() {
+				// the timeout fee of 300 is split 128/172, the remainder of the split being paid to the payee with the largest weight
+				expectedTimeoutAccBal := timeoutRelayerBal.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(256)))
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+
+				payee, err := sdk.AccAddressFromBech32(timeoutPayeeShares[1].Address)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(344)), balance)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+
+				// check the timeout fee shares are recorded in the statistics of each payee
+				timeoutStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), timeoutStatistics.TimeoutPackets)
+				suite.Require().Equal(sdkmath.NewInt(256), timeoutStatistics.FeesEarned)
+
+				payeeStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), payee.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), payeeStatistics.TimeoutPackets)
+				suite.Require().Equal(sdkmath.NewInt(344), payeeStatistics.FeesEarned)
 			},
 		},
 		{
@@ -302,6 +426,11 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 				suite.Require().Equal(expectedRefundAccBal, balance)
 
+				// check the refund amount is recorded in the timeout payee statistics
+				timeoutStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, 0)
+				suite.Require().True(found)
+				suite.Require().Equal(refundCoins[0].Amount, timeoutStatistics.FeesRefunded)
+
 				// check the module acc wallet is now empty
 				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
@@ -316,6 +445,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().NotEmpty(suite.chainA.GetSimApp().IBCFeeKeeper.GetLockReason(suite.chainA.GetContext()))
 				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
 
 				// check if the module acc contains all the fees
@@ -375,9 +505,14 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 			// escrow the packet fees & store the fees in state
 			packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
 			packetFees = []types.PacketFee{packetFee, packetFee}
+			timeoutPayeeShares = nil
 
 			tc.malleate()
 
+			if timeoutPayeeShares == nil {
+				timeoutPayeeShares = []types.PayeeShare{types.NewPayeeShare(timeoutRelayer.String(), 1)}
+			}
+
 			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
 			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total().Add(fee.Total()...))
 			suite.Require().NoError(err)
@@ -386,7 +521,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 			timeoutRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutRelayer, packetFees, packetID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutPayeeShares, packetFees, packetID)
 
 			tc.expResult()
 		})
@@ -595,3 +730,402 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDistributeClientUpdateFees() {
+	var (
+		relayer         sdk.AccAddress
+		refundAcc       sdk.AccAddress
+		refundAccBal    sdk.Coin
+		clientUpdateFee types.ClientUpdateFee
+		latestHeight    clienttypes.Height
+		blockTime       time.Time
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success",
+			func() {},
+			func() {
+				// check if the relayer is paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0], balance)
+
+				// check the client update fee is updated
+				clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+				suite.Require().True(found)
+				suite.Require().Equal(clientUpdateFee.RemainingUpdates-1, clientUpdateFees.ClientUpdateFees[0].RemainingUpdates)
+				suite.Require().Equal(uint64(blockTime.UnixNano()), clientUpdateFees.ClientUpdateFees[0].LastPaidTimestamp)
+				suite.Require().Equal(latestHeight, clientUpdateFees.ClientUpdateFees[0].LastPaidHeight)
+			},
+		},
+		{
+			"success: last incentivized update deletes the client update fee",
+			func() {
+				clientUpdateFee.RemainingUpdates = 1
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0], balance)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"no payment: refresh interval has not elapsed",
+			func() {
+				clientUpdateFee.LastPaidTimestamp = uint64(blockTime.Add(-time.Second).UnixNano())
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.ClientUpdateFee{clientUpdateFee}, clientUpdateFees.ClientUpdateFees)
+			},
+		},
+		{
+			"no payment: latest height does not advance beyond last paid height",
+			func() {
+				clientUpdateFee.LastPaidHeight = latestHeight
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.ClientUpdateFee{clientUpdateFee}, clientUpdateFees.ClientUpdateFees)
+			},
+		},
+		{
+			"no payment: client update fee has expired",
+			func() {
+				clientUpdateFee.ExpiryTimestamp = uint64(blockTime.UnixNano())
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				// expired fees are left to be refunded at the end of the block
+				clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+				suite.Require().True(found)
+				suite.Require().Equal([]types.ClientUpdateFee{clientUpdateFee}, clientUpdateFees.ClientUpdateFees)
+			},
+		},
+		{
+			"no payment: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"escrow account out of balance, fee module becomes locked",
+			func() {
+				// pass in an extra client update fee which has not been escrowed
+				clientUpdateFee.Fee = clientUpdateFee.Fee.Add(defaultRecvFee...)
+				clientUpdateFee.RemainingUpdates = 1
+			},
+			func() {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				// check the refund account is not refunded
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			blockTime = suite.chainA.GetContext().BlockTime()
+			latestHeight = clienttypes.NewHeight(1, 10)
+
+			clientUpdateFee = types.NewClientUpdateFee(defaultRecvFee, refundAcc.String(), 2, uint64(time.Minute), uint64(blockTime.Add(time.Hour).UnixNano()))
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID, types.NewClientUpdateFees([]types.ClientUpdateFee{clientUpdateFee}))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, defaultRecvFee.MulInt(sdkmath.NewIntFromUint64(clientUpdateFee.RemainingUpdates)))
+			suite.Require().NoError(err)
+
+			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributeClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID, latestHeight, relayer)
+
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundExpiredClientUpdateFees() {
+	suite.SetupTest() // reset
+
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	blockTime := suite.chainA.GetContext().BlockTime()
+
+	expiredFee := types.NewClientUpdateFee(defaultRecvFee, refundAcc.String(), 2, uint64(time.Minute), uint64(blockTime.UnixNano()))
+	unexpiredFee := types.NewClientUpdateFee(defaultAckFee, refundAcc.String(), 1, uint64(time.Minute), uint64(blockTime.Add(time.Hour).UnixNano()))
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID, types.NewClientUpdateFees([]types.ClientUpdateFee{expiredFee, unexpiredFee}))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), "07-tendermint-1", types.NewClientUpdateFees([]types.ClientUpdateFee{expiredFee}))
+
+	escrowed := expiredFee.Total().Add(expiredFee.Total()...).Add(unexpiredFee.Total()...)
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, escrowed)
+	suite.Require().NoError(err)
+
+	refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+	clientIDs := suite.chainA.GetSimApp().IBCFeeKeeper.GetExpiredClientUpdateFeesClientIDs(suite.chainA.GetContext(), uint64(blockTime.UnixNano()))
+	suite.Require().ElementsMatch([]string{ibctesting.FirstClientID, "07-tendermint-1"}, clientIDs)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.RefundExpiredClientUpdateFees(suite.chainA.GetContext())
+
+	// check the expiry index only holds the unexpired fees
+	clientIDs = suite.chainA.GetSimApp().IBCFeeKeeper.GetExpiredClientUpdateFeesClientIDs(suite.chainA.GetContext(), uint64(blockTime.UnixNano()))
+	suite.Require().Empty(clientIDs)
+
+	clientIDs = suite.chainA.GetSimApp().IBCFeeKeeper.GetExpiredClientUpdateFeesClientIDs(suite.chainA.GetContext(), unexpiredFee.ExpiryTimestamp)
+	suite.Require().Equal([]string{ibctesting.FirstClientID}, clientIDs)
+
+	// check the expired fees are refunded
+	expRefundAccBal := refundAccBal.Add(expiredFee.Total()[0]).Add(expiredFee.Total()[0])
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+	suite.Require().Equal(expRefundAccBal, balance)
+
+	// check only the unexpired fees remain in escrow
+	clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.ClientUpdateFee{unexpiredFee}, clientUpdateFees.ClientUpdateFees)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), "07-tendermint-1")
+	suite.Require().False(found)
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(unexpiredFee.Total()[0], balance)
+}
+
+func (suite *KeeperTestSuite) TestDistributeChannelUpgradeFees() {
+	var (
+		relayer           sdk.AccAddress
+		refundAcc         sdk.AccAddress
+		refundAccBal      sdk.Coin
+		channelUpgradeFee types.ChannelUpgradeFee
+		upgradeSequence   uint64
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success",
+			func() {},
+			func() {
+				// check if the relayer is paid the try fee
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0], balance)
+
+				// check the try fee is removed from escrow
+				channelUpgradeFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence)
+				suite.Require().True(found)
+				suite.Require().Equal(channelUpgradeFee.Fee.WithoutStepFee(types.UpgradeStepTry), channelUpgradeFees.ChannelUpgradeFees[0].Fee)
+			},
+		},
+		{
+			"success: try fee split between payee shares",
+			func() {
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeShares(suite.chainA.GetContext(), relayer.String(), []types.PayeeShare{
+					types.NewPayeeShare(relayer.String(), 1),
+					types.NewPayeeShare(payee.String(), 1),
+				}, suite.path.EndpointA.ChannelID)
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, defaultRecvFee[0].Amount.QuoRaw(2)), balance)
+			},
+		},
+		{
+			"success: last step deletes the channel upgrade fee",
+			func() {
+				channelUpgradeFee.Fee = types.NewUpgradeFee(defaultRecvFee, nil, nil, nil)
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultRecvFee[0], balance)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"success: fees for a previous upgrade are refunded",
+			func() {
+				upgradeSequence--
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal.Add(channelUpgradeFee.Fee.Total()[0]), balance)
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence)
+				suite.Require().False(found)
+			},
+		},
+		{
+			"no payment: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"escrow account out of balance, fee module becomes locked",
+			func() {
+				// pass in an extra try fee which has not been escrowed
+				channelUpgradeFee.Fee.TryFee = channelUpgradeFee.Fee.TryFee.Add(channelUpgradeFee.Fee.Total()...)
+			},
+			func() {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			// move the channel into an upgrade in progress
+			suite.path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.Version
+			suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit())
+
+			relayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			upgradeSequence = suite.path.EndpointA.GetChannel().UpgradeSequence
+
+			channelUpgradeFee = types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), refundAcc.String())
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence, types.NewChannelUpgradeFees([]types.ChannelUpgradeFee{channelUpgradeFee}))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee).Total())
+			suite.Require().NoError(err)
+
+			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributeChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, types.UpgradeStepTry, relayer.String())
+
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundChannelUpgradeFees() {
+	var (
+		refundAcc   sdk.AccAddress
+		fee         types.UpgradeFee
+		currentSeq  uint64
+		expRefunded []uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"no upgrade in progress: current and previous upgrades are refunded",
+			func() {
+				expRefunded = []uint64{currentSeq - 1, currentSeq}
+			},
+		},
+		{
+			"upgrade in progress: previous upgrades are refunded",
+			func() {
+				suite.path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.Version
+				suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit())
+
+				// the upgrade sequence is incremented by the upgrade init
+				expRefunded = []uint64{currentSeq - 1, currentSeq}
+			},
+		},
+		{
+			"channel is closed: all upgrades are refunded",
+			func() {
+				suite.path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+				expRefunded = []uint64{currentSeq - 1, currentSeq, currentSeq + 1}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			// set the current upgrade sequence so that a previous upgrade sequence exists
+			suite.path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.UpgradeSequence = 2 })
+			currentSeq = 2
+
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+			fee = types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee)
+
+			// escrow fees for the previous, current and next upgrade sequences
+			for seq := currentSeq - 1; seq <= currentSeq+1; seq++ {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, seq, types.NewChannelUpgradeFees([]types.ChannelUpgradeFee{types.NewChannelUpgradeFee(fee, refundAcc.String())}))
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+			}
+
+			tc.malleate()
+
+			refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.RefundChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+
+			expRefundAccBal := refundAccBal
+			for seq := currentSeq - 1; seq <= currentSeq+1; seq++ {
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, seq)
+				if slices.Contains(expRefunded, seq) {
+					suite.Require().False(found)
+					expRefundAccBal = expRefundAccBal.Add(fee.Total()[0])
+				} else {
+					suite.Require().True(found)
+				}
+			}
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+			suite.Require().Equal(expRefundAccBal, balance)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
@@ -90,3 +91,83 @@ func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 		),
 	})
 }
+
+// emitRecoverFeeModuleEvent emits an event containing the funder account and the escrow deficit topped up on recovery of the fee module
+func emitRecoverFeeModuleEvent(ctx sdk.Context, funder string, deficit sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecoverFeeModule,
+			sdk.NewAttribute(types.AttributeKeyFunder, funder),
+			sdk.NewAttribute(types.AttributeKeyDeficit, deficit.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitUpdateMinimumFeeEvent emits an event containing the minimum fee set for a port and channel
+func emitUpdateMinimumFeeEvent(ctx sdk.Context, portID, channelID string, fee types.Fee) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateMinimumFee,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyRecvFee, fee.RecvFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, fee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyTimeoutFee, fee.TimeoutFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitIncentivizedClientUpdateEvent emits an event containing information on a fee escrowed to incentivize the updates of a client
+func emitIncentivizedClientUpdateEvent(ctx sdk.Context, clientID string, clientUpdateFee types.ClientUpdateFee) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIncentivizedClientUpdate,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyFee, clientUpdateFee.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxUpdates, fmt.Sprint(clientUpdateFee.RemainingUpdates)),
+			sdk.NewAttribute(types.AttributeKeyRefreshInterval, fmt.Sprint(clientUpdateFee.RefreshInterval)),
+			sdk.NewAttribute(types.AttributeKeyExpiryTimestamp, fmt.Sprint(clientUpdateFee.ExpiryTimestamp)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitIncentivizedChannelUpgradeEvent emits an event containing information on the total amount of fees incentivizing
+// a specific channel upgrade handshake. It should be emitted on every fee escrowed for the given channel upgrade.
+func emitIncentivizedChannelUpgradeEvent(ctx sdk.Context, portID, channelID string, upgradeSequence uint64, channelUpgradeFees types.ChannelUpgradeFees) {
+	var totalFee types.UpgradeFee
+	for _, channelUpgradeFee := range channelUpgradeFees.ChannelUpgradeFees {
+		totalFee.TryFee = totalFee.TryFee.Add(channelUpgradeFee.Fee.TryFee...)
+		totalFee.AckFee = totalFee.AckFee.Add(channelUpgradeFee.Fee.AckFee...)
+		totalFee.ConfirmFee = totalFee.ConfirmFee.Add(channelUpgradeFee.Fee.ConfirmFee...)
+		totalFee.OpenFee = totalFee.OpenFee.Add(channelUpgradeFee.Fee.OpenFee...)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeIncentivizedChannelUpgrade,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, portID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(channeltypes.AttributeKeyUpgradeSequence, fmt.Sprint(upgradeSequence)),
+			sdk.NewAttribute(types.AttributeKeyTryFee, totalFee.TryFee.String()),
+			sdk.NewAttribute(types.AttributeKeyAckFee, totalFee.AckFee.String()),
+			sdk.NewAttribute(types.AttributeKeyConfirmFee, totalFee.ConfirmFee.String()),
+			sdk.NewAttribute(types.AttributeKeyOpenFee, totalFee.OpenFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
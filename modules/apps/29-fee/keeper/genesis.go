@@ -13,6 +13,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 
 	for _, registeredPayee := range state.RegisteredPayees {
+		if len(registeredPayee.PayeeShares) != 0 {
+			k.SetPayeeShares(ctx, registeredPayee.Relayer, registeredPayee.PayeeShares, registeredPayee.ChannelId)
+			continue
+		}
+
 		k.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ChannelId)
 	}
 
@@ -27,6 +32,22 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, minimumFee := range state.MinimumFees {
+		k.SetMinimumFee(ctx, minimumFee.PortId, minimumFee.ChannelId, minimumFee.Fee)
+	}
+
+	for _, identifiedFees := range state.ClientUpdateFees {
+		k.SetClientUpdateFees(ctx, identifiedFees.ClientId, types.NewClientUpdateFees(identifiedFees.ClientUpdateFees))
+	}
+
+	for _, identifiedFees := range state.ChannelUpgradeFees {
+		k.SetChannelUpgradeFees(ctx, identifiedFees.PortId, identifiedFees.ChannelId, identifiedFees.UpgradeSequence, types.NewChannelUpgradeFees(identifiedFees.ChannelUpgradeFees))
+	}
+
+	for _, payeeStatistics := range state.PayeeStatistics {
+		k.SetPayeeStatistics(ctx, payeeStatistics)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
@@ -37,5 +58,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		MinimumFees:                  k.GetAllMinimumFees(ctx),
+		ClientUpdateFees:             k.GetAllClientUpdateFees(ctx),
+		ChannelUpgradeFees:           k.GetAllChannelUpgradeFees(ctx),
+		PayeeStatistics:              k.GetAllPayeeStatistics(ctx),
 	}
 }
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -42,6 +46,22 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		MinimumFees: []types.MinimumFee{
+			types.NewMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)),
+		},
+		ClientUpdateFees: []types.IdentifiedClientUpdateFees{
+			types.NewIdentifiedClientUpdateFees(ibctesting.FirstClientID, []types.ClientUpdateFee{
+				types.NewClientUpdateFee(defaultRecvFee, suite.chainA.SenderAccount.GetAddress().String(), 10, 100, 1000),
+			}),
+		},
+		ChannelUpgradeFees: []types.IdentifiedChannelUpgradeFees{
+			types.NewIdentifiedChannelUpgradeFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, []types.ChannelUpgradeFee{
+				types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), suite.chainA.SenderAccount.GetAddress().String()),
+			}),
+		},
+		PayeeStatistics: []types.PayeeStatistics{
+			types.NewPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, 86400).Add(types.PacketStepAck, defaultAckFee.AmountOf(sdk.DefaultBondDenom), sdkmath.ZeroInt()),
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -56,14 +76,34 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(isEnabled)
 
 	// check payee addresses
-	payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
-	suite.Require().True(found)
+	payeeAddr, err := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().NoError(err)
 	suite.Require().Equal(genesisState.RegisteredPayees[0].Payee, payeeAddr)
 
 	// check relayers
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check minimum fees
+	minimumFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinimumFee(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.MinimumFees[0].Fee, minimumFee)
+
+	// check client update fees
+	clientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ClientUpdateFees[0].ClientUpdateFees, clientUpdateFees.ClientUpdateFees)
+
+	// check channel upgrade fees
+	channelUpgradeFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.ChannelUpgradeFees[0].ChannelUpgradeFees, channelUpgradeFees.ChannelUpgradeFees)
+
+	// check payee statistics
+	payeeStatistics, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeStatistics(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, 86400)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PayeeStatistics[0], payeeStatistics)
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -97,6 +137,25 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set minimum fee
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), ibctesting.MockFeePort, "", fee)
+
+	// set client update and channel upgrade fees
+	clientUpdateFee := types.NewClientUpdateFee(defaultRecvFee, refundAcc.String(), 10, 100, 1000)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), ibctesting.FirstClientID, types.NewClientUpdateFees([]types.ClientUpdateFee{clientUpdateFee}))
+
+	channelUpgradeFee := types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), refundAcc.String())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelUpgradeFees(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, types.NewChannelUpgradeFees([]types.ChannelUpgradeFee{channelUpgradeFee}))
+
+	// set payee statistics aggregated over all time and per time bucket
+	payeeStatistics := []types.PayeeStatistics{
+		types.NewPayeeStatistics(refundAcc.String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, 0).Add(types.PacketStepRecv, defaultRecvFee.AmountOf(sdk.DefaultBondDenom), sdkmath.ZeroInt()),
+		types.NewPayeeStatistics(refundAcc.String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, 86400).Add(types.PacketStepRecv, defaultRecvFee.AmountOf(sdk.DefaultBondDenom), sdkmath.ZeroInt()),
+	}
+	for _, stats := range payeeStatistics {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeStatistics(suite.chainA.GetContext(), stats)
+	}
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -123,4 +182,14 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check minimum fees
+	suite.Require().Equal([]types.MinimumFee{types.NewMinimumFee(ibctesting.MockFeePort, "", fee)}, genesisState.MinimumFees)
+
+	// check client update and channel upgrade fees
+	suite.Require().Equal([]types.IdentifiedClientUpdateFees{types.NewIdentifiedClientUpdateFees(ibctesting.FirstClientID, []types.ClientUpdateFee{clientUpdateFee})}, genesisState.ClientUpdateFees)
+	suite.Require().Equal([]types.IdentifiedChannelUpgradeFees{types.NewIdentifiedChannelUpgradeFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, []types.ChannelUpgradeFee{channelUpgradeFee})}, genesisState.ChannelUpgradeFees)
+
+	// check payee statistics
+	suite.Require().Equal(payeeStatistics, genesisState.PayeeStatistics)
 }
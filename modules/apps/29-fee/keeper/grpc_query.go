@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -179,7 +180,8 @@ func (k Keeper) TotalTimeoutFees(goCtx context.Context, req *types.QueryTotalTim
 	}, nil
 }
 
-// Payee implements the Query/Payee gRPC method and returns the registered payee address to which packet fees are paid out
+// Payee implements the Query/Payee gRPC method and returns the registered payee address, or weighted payee addresses,
+// to which packet fees are paid out
 func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -187,13 +189,20 @@ func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*typ
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	payeeAddr, found := k.GetPayeeAddress(ctx, req.Relayer, req.ChannelId)
+	payeeShares, found := k.GetPayeeShares(ctx, req.Relayer, req.ChannelId)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "payee address not found for address: %s on channel: %s", req.Relayer, req.ChannelId)
 	}
 
+	// the payee address is only set if the fees are paid out to a single payee
+	var payeeAddr string
+	if len(payeeShares) == 1 {
+		payeeAddr = payeeShares[0].Address
+	}
+
 	return &types.QueryPayeeResponse{
 		PayeeAddress: payeeAddr,
+		PayeeShares:  payeeShares,
 	}, nil
 }
 
@@ -265,3 +274,182 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// FeeModuleLock implements the Query/FeeModuleLock gRPC method and returns the lock status of the fee module,
+// the reason it was locked and the deficit of the fee module account with respect to the fees in escrow
+func (k Keeper) FeeModuleLock(goCtx context.Context, req *types.QueryFeeModuleLockRequest) (*types.QueryFeeModuleLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	escrowedFees, moduleBalance, deficit := k.ReconcileEscrow(ctx)
+
+	return &types.QueryFeeModuleLockResponse{
+		Locked:        k.IsLocked(ctx),
+		Reason:        k.GetLockReason(ctx),
+		EscrowedFees:  escrowedFees,
+		ModuleBalance: moduleBalance,
+		Deficit:       deficit,
+	}, nil
+}
+
+// MinimumFee implements the Query/MinimumFee gRPC method and returns the minimum fee required to send
+// packets over the given channel
+func (k Keeper) MinimumFee(goCtx context.Context, req *types.QueryMinimumFeeRequest) (*types.QueryMinimumFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the fee is empty if no minimum fee is set for the channel or port
+	fee, _ := k.GetMinimumFeeForChannel(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryMinimumFeeResponse{
+		Fee: fee,
+	}, nil
+}
+
+// ClientUpdateFees implements the Query/ClientUpdateFees gRPC method and returns the fees escrowed to incentivize
+// the updates of the given client
+func (k Keeper) ClientUpdateFees(goCtx context.Context, req *types.QueryClientUpdateFeesRequest) (*types.QueryClientUpdateFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientUpdateFees, _ := k.GetClientUpdateFees(ctx, req.ClientId)
+
+	return &types.QueryClientUpdateFeesResponse{
+		ClientUpdateFees: clientUpdateFees.ClientUpdateFees,
+	}, nil
+}
+
+// ChannelUpgradeFees implements the Query/ChannelUpgradeFees gRPC method and returns the fees escrowed to incentivize
+// the given channel upgrade handshake
+func (k Keeper) ChannelUpgradeFees(goCtx context.Context, req *types.QueryChannelUpgradeFeesRequest) (*types.QueryChannelUpgradeFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelUpgradeFees, _ := k.GetChannelUpgradeFees(ctx, req.PortId, req.ChannelId, req.UpgradeSequence)
+
+	return &types.QueryChannelUpgradeFeesResponse{
+		ChannelUpgradeFees: channelUpgradeFees.ChannelUpgradeFees,
+	}, nil
+}
+
+// PayeeStatistics implements the Query/PayeeStatistics gRPC method and returns the statistics of the packets for which
+// the given payee was paid fees, aggregated over all time or per time bucket, optionally filtered by channel
+func (k Keeper) PayeeStatistics(goCtx context.Context, req *types.QueryPayeeStatisticsRequest) (*types.QueryPayeeStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Payee); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payee address: %s", err)
+	}
+
+	if req.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if err := validateTimeRange(req.TimeBuckets, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyPrefix := types.KeyPayeeStatisticsPrefix(req.Payee, req.ChannelId, req.TimeBuckets)
+	payeeStatistics, pagination, err := k.paginatePayeeStatistics(ctx, keyPrefix, "", req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPayeeStatisticsResponse{
+		PayeeStatistics: payeeStatistics,
+		Pagination:      pagination,
+	}, nil
+}
+
+// ChannelPayeeStatistics implements the Query/ChannelPayeeStatistics gRPC method and returns the statistics of the
+// packets relayed over the given channel for all payees, aggregated over all time or per time bucket
+func (k Keeper) ChannelPayeeStatistics(goCtx context.Context, req *types.QueryChannelPayeeStatisticsRequest) (*types.QueryChannelPayeeStatisticsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := validateTimeRange(req.TimeBuckets, req.StartTime, req.EndTime); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	keyPrefix := types.KeyPayeeStatisticsPrefix("", "", req.TimeBuckets)
+	payeeStatistics, pagination, err := k.paginatePayeeStatistics(ctx, keyPrefix, req.ChannelId, req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelPayeeStatisticsResponse{
+		PayeeStatistics: payeeStatistics,
+		Pagination:      pagination,
+	}, nil
+}
+
+// paginatePayeeStatistics paginates the payee statistics stored under the given key prefix, skipping the statistics
+// of other channels if a channel identifier is provided and the time buckets outside of the given time range
+func (k Keeper) paginatePayeeStatistics(ctx sdk.Context, keyPrefix []byte, channelID string, startTime, endTime uint64, pageRequest *query.PageRequest) ([]types.PayeeStatistics, *query.PageResponse, error) {
+	var payeeStatistics []types.PayeeStatistics
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pagination, err := query.FilteredPaginate(store, pageRequest, func(_, value []byte, accumulate bool) (bool, error) {
+		var statistics types.PayeeStatistics
+		if err := k.cdc.Unmarshal(value, &statistics); err != nil {
+			return false, err
+		}
+
+		if channelID != "" && statistics.ChannelId != channelID {
+			return false, nil
+		}
+
+		if statistics.IsTimeBucket() && !statistics.InTimeRange(startTime, endTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			payeeStatistics = append(payeeStatistics, statistics)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return payeeStatistics, pagination, nil
+}
+
+// validateTimeRange returns an error if a time range is provided for statistics aggregated over all time, or if the
+// end of the time range is not after its start
+func validateTimeRange(timeBuckets bool, startTime, endTime uint64) error {
+	if !timeBuckets && (startTime != 0 || endTime != 0) {
+		return status.Error(codes.InvalidArgument, "a time range can only be provided for time bucket statistics")
+	}
+
+	if endTime != 0 && endTime <= startTime {
+		return status.Errorf(codes.InvalidArgument, "end time (%d) must be greater than start time (%d)", endTime, startTime)
+	}
+
+	return nil
+}
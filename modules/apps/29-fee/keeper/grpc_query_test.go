@@ -790,3 +790,534 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryFeeModuleLock() {
+	var (
+		req        *types.QueryFeeModuleLockRequest
+		expLocked  bool
+		expDeficit sdk.Coins
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: locked with deficit",
+			func() {
+				// remove the fees from the escrow account
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), defaultRecvFee)
+				suite.Require().NoError(err)
+
+				lockFeeModule(suite.chainA)
+
+				expLocked = true
+				expDeficit = defaultRecvFee
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expLocked = false
+			expDeficit = nil
+
+			suite.path.Setup()
+
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			req = &types.QueryFeeModuleLockRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeModuleLock(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expLocked, res.Locked)
+				suite.Require().Equal(fee.Total(), res.EscrowedFees)
+				suite.Require().Equal(fee.Total().Sub(expDeficit...), res.ModuleBalance)
+				suite.Require().Equal(expDeficit, res.Deficit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMinimumFee() {
+	var (
+		req    *types.QueryMinimumFeeRequest
+		expFee types.Fee
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: channel minimum fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), req.PortId, "", types.NewFee(defaultRecvFee, nil, nil))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), req.PortId, req.ChannelId, fee)
+
+				expFee = fee
+			},
+			true,
+		},
+		{
+			"success: port minimum fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), req.PortId, "", fee)
+
+				expFee = fee
+			},
+			true,
+		},
+		{
+			"success: no minimum fee",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expFee = types.Fee{}
+
+			req = &types.QueryMinimumFeeRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.MinimumFee(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFee, res.Fee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientUpdateFees() {
+	var (
+		req                 *types.QueryClientUpdateFeesRequest
+		expClientUpdateFees []types.ClientUpdateFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				clientUpdateFee := types.NewClientUpdateFee(defaultRecvFee, suite.chainA.SenderAccount.GetAddress().String(), 10, 100, 1000)
+				expClientUpdateFees = []types.ClientUpdateFee{clientUpdateFee}
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), req.ClientId, types.NewClientUpdateFees(expClientUpdateFees))
+			},
+			true,
+		},
+		{
+			"success: no client update fees",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expClientUpdateFees = nil
+
+			req = &types.QueryClientUpdateFeesRequest{
+				ClientId: ibctesting.FirstClientID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ClientUpdateFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expClientUpdateFees, res.ClientUpdateFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelUpgradeFees() {
+	var (
+		req                   *types.QueryChannelUpgradeFeesRequest
+		expChannelUpgradeFees []types.ChannelUpgradeFee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				channelUpgradeFee := types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), suite.chainA.SenderAccount.GetAddress().String())
+				expChannelUpgradeFees = []types.ChannelUpgradeFee{channelUpgradeFee}
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelUpgradeFees(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.UpgradeSequence, types.NewChannelUpgradeFees(expChannelUpgradeFees))
+			},
+			true,
+		},
+		{
+			"success: fees escrowed for another upgrade sequence",
+			func() {
+				channelUpgradeFee := types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), suite.chainA.SenderAccount.GetAddress().String())
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelUpgradeFees(suite.chainA.GetContext(), req.PortId, req.ChannelId, req.UpgradeSequence+1, types.NewChannelUpgradeFees([]types.ChannelUpgradeFee{channelUpgradeFee}))
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expChannelUpgradeFees = nil
+
+			req = &types.QueryChannelUpgradeFeesRequest{
+				PortId:          ibctesting.MockFeePort,
+				ChannelId:       ibctesting.FirstChannelID,
+				UpgradeSequence: 1,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ChannelUpgradeFees(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expChannelUpgradeFees, res.ChannelUpgradeFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPayeeStatistics() {
+	var (
+		req                *types.QueryPayeeStatisticsRequest
+		expPayeeStatistics []types.PayeeStatistics
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expPayeeStatistics = suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 0)
+				expPayeeStatistics = append(expPayeeStatistics, suite.setPayeeStatistics(req.Payee, "channel-1", 0)...)
+
+				// statistics of other relayers and time buckets are omitted
+				suite.setPayeeStatistics(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, 0)
+				suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 86400)
+			},
+			true,
+		},
+		{
+			"success: filtered by channel",
+			func() {
+				expPayeeStatistics = suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 0)
+				suite.setPayeeStatistics(req.Payee, "channel-1", 0)
+
+				req.ChannelId = ibctesting.FirstChannelID
+			},
+			true,
+		},
+		{
+			"success: time buckets",
+			func() {
+				expPayeeStatistics = suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 86400)
+				expPayeeStatistics = append(expPayeeStatistics, suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 2*86400)...)
+				suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 0)
+
+				req.TimeBuckets = true
+			},
+			true,
+		},
+		{
+			"success: time buckets within time range",
+			func() {
+				suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 86400)
+				expPayeeStatistics = suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 2*86400)
+				suite.setPayeeStatistics(req.Payee, ibctesting.FirstChannelID, 3*86400)
+
+				req.TimeBuckets = true
+				req.StartTime = 2 * 86400
+				req.EndTime = 3 * 86400
+			},
+			true,
+		},
+		{
+			"success: no payee statistics",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				req.Payee = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = "invalid/channel"
+			},
+			false,
+		},
+		{
+			"time range without time buckets",
+			func() {
+				req.StartTime = 86400
+			},
+			false,
+		},
+		{
+			"end time not after start time",
+			func() {
+				req.TimeBuckets = true
+				req.StartTime = 86400
+				req.EndTime = 86400
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expPayeeStatistics = nil
+
+			req = &types.QueryPayeeStatisticsRequest{
+				Payee: suite.chainA.SenderAccount.GetAddress().String(),
+				Pagination: &query.PageRequest{
+					Limit:      10,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayeeStatistics(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().ElementsMatch(expPayeeStatistics, res.PayeeStatistics)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelPayeeStatistics() {
+	var (
+		req                *types.QueryChannelPayeeStatisticsRequest
+		expPayeeStatistics []types.PayeeStatistics
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expPayeeStatistics = suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), req.ChannelId, 0)
+				expPayeeStatistics = append(expPayeeStatistics, suite.setPayeeStatistics(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), req.ChannelId, 0)...)
+
+				// statistics of other channels and time buckets are omitted
+				suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), "channel-1", 0)
+				suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), req.ChannelId, 86400)
+			},
+			true,
+		},
+		{
+			"success: time buckets within time range",
+			func() {
+				expPayeeStatistics = suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), req.ChannelId, 2*86400)
+				expPayeeStatistics = append(expPayeeStatistics, suite.setPayeeStatistics(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), req.ChannelId, 2*86400)...)
+				suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), req.ChannelId, 86400)
+				suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), "channel-1", 2*86400)
+
+				req.TimeBuckets = true
+				req.StartTime = 2 * 86400
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				suite.setPayeeStatistics(suite.chainA.SenderAccount.GetAddress().String(), req.ChannelId, 0)
+				suite.setPayeeStatistics(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), req.ChannelId, 0)
+
+				req.Pagination.Limit = 1
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"time range without time buckets",
+			func() {
+				req.EndTime = 86400
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			expPayeeStatistics = nil
+
+			req = &types.QueryChannelPayeeStatisticsRequest{
+				ChannelId: ibctesting.FirstChannelID,
+				Pagination: &query.PageRequest{
+					Limit:      10,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ChannelPayeeStatistics(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				if req.Pagination.Limit == 1 {
+					suite.Require().Len(res.PayeeStatistics, 1)
+					suite.Require().NotEmpty(res.Pagination.NextKey)
+					return
+				}
+
+				suite.Require().ElementsMatch(expPayeeStatistics, res.PayeeStatistics)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// setPayeeStatistics stores statistics of the given relayer, channel and time bucket in two denominations and returns them
+func (suite *KeeperTestSuite) setPayeeStatistics(relayer, channelID string, bucketStart uint64) []types.PayeeStatistics {
+	payeeStatistics := []types.PayeeStatistics{
+		types.NewPayeeStatistics(relayer, channelID, sdk.DefaultBondDenom, bucketStart).Add(types.PacketStepRecv, defaultRecvFee.AmountOf(sdk.DefaultBondDenom), sdkmath.ZeroInt()),
+		types.NewPayeeStatistics(relayer, channelID, "atom", bucketStart).Add(types.PacketStepAck, sdkmath.NewInt(200), sdkmath.NewInt(100)),
+	}
+
+	for _, stats := range payeeStatistics {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeStatistics(suite.chainA.GetContext(), stats)
+	}
+
+	return payeeStatistics
+}
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -29,24 +33,36 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+
+	// the address capable of executing a MsgRecoverLockedFeeModule message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
-	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper, clientKeeper types.ClientKeeper,
 	portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
 		ics4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -57,6 +73,11 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
@@ -83,6 +104,16 @@ func (k Keeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (
 	return k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
 }
 
+// GetUpgrade wraps IBC ChannelKeeper's GetUpgrade function
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (channeltypes.Upgrade, bool) {
+	return k.channelKeeper.GetUpgrade(ctx, portID, channelID)
+}
+
+// GetUpgradeErrorReceipt wraps IBC ChannelKeeper's GetUpgradeErrorReceipt function
+func (k Keeper) GetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID string) (channeltypes.ErrorReceipt, bool) {
+	return k.channelKeeper.GetUpgradeErrorReceipt(ctx, portID, channelID)
+}
+
 // GetFeeModuleAddress returns the ICS29 Fee ModuleAccount address
 func (k Keeper) GetFeeModuleAddress() sdk.AccAddress {
 	return k.authKeeper.GetModuleAddress(types.ModuleName)
@@ -100,11 +131,63 @@ func (k Keeper) EscrowAccountHasBalance(ctx sdk.Context, coins sdk.Coins) bool {
 }
 
 // lockFeeModule sets a flag to determine if fee handling logic should run for the given channel
-// identified by channel and port identifiers.
+// identified by channel and port identifiers. The reason for which the fee module is locked is stored alongside.
 // Please see ADR 004 for more information.
-func (k Keeper) lockFeeModule(ctx sdk.Context) {
+func (k Keeper) lockFeeModule(ctx sdk.Context, reason string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyLocked(), []byte{1})
+	store.Set(types.KeyLockReason(), []byte(reason))
+
+	k.Logger(ctx).Error("fee module locked", "reason", reason)
+}
+
+// unlockFeeModule removes the flag locking the fee module and the reason for which it was locked
+func (k Keeper) unlockFeeModule(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLocked())
+	store.Delete(types.KeyLockReason())
+}
+
+// GetLockReason returns the reason for which the fee module was locked. An empty string is returned
+// if the fee module is not locked or if it was locked before lock reasons were stored.
+func (k Keeper) GetLockReason(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.KeyLockReason()))
+}
+
+// ReconcileEscrow compares the total fees in escrow against the balance of the fee module account.
+// It returns the total fees in escrow, the module account balance in the denominations of the fees in escrow
+// and the deficit, i.e. the amount of each denomination by which the fees in escrow exceed the module account balance.
+func (k Keeper) ReconcileEscrow(ctx sdk.Context) (escrowedFees, moduleBalance, deficit sdk.Coins) {
+	for _, identifiedPacketFees := range k.GetAllIdentifiedPacketFees(ctx) {
+		for _, packetFee := range identifiedPacketFees.PacketFees {
+			escrowedFees = escrowedFees.Add(packetFee.Fee.Total()...)
+		}
+	}
+
+	for _, identifiedClientUpdateFees := range k.GetAllClientUpdateFees(ctx) {
+		for _, clientUpdateFee := range identifiedClientUpdateFees.ClientUpdateFees {
+			escrowedFees = escrowedFees.Add(clientUpdateFee.Total()...)
+		}
+	}
+
+	for _, identifiedChannelUpgradeFees := range k.GetAllChannelUpgradeFees(ctx) {
+		for _, channelUpgradeFee := range identifiedChannelUpgradeFees.ChannelUpgradeFees {
+			escrowedFees = escrowedFees.Add(channelUpgradeFee.Fee.Total()...)
+		}
+	}
+
+	moduleAddr := k.GetFeeModuleAddress()
+	for _, coin := range escrowedFees {
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
+		moduleBalance = moduleBalance.Add(balance)
+
+		if balance.Amount.LT(coin.Amount) {
+			deficit = deficit.Add(coin.Sub(balance))
+		}
+	}
+
+	return escrowedFees, moduleBalance, deficit
 }
 
 // IsLocked indicates if the fee module is locked
@@ -157,22 +240,44 @@ func (k Keeper) GetAllFeeEnabledChannels(ctx sdk.Context) []types.FeeEnabledChan
 	return enabledChArr
 }
 
-// GetPayeeAddress retrieves the fee payee address stored in state given the provided channel identifier and relayer address
-func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, channelID string) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
-	key := types.KeyPayee(relayerAddr, channelID)
+// GetPayeeAddress retrieves the fee payee address stored in state given the provided channel identifier and relayer address.
+// An ErrPayeeNotFound error is returned if no payee is registered for the relayer. If the fees of the relayer are split
+// between multiple payees, an ErrMultiplePayees error is returned and the payees must be retrieved using GetPayeeShares.
+func (k Keeper) GetPayeeAddress(ctx sdk.Context, relayerAddr, channelID string) (string, error) {
+	payeeShares, found := k.GetPayeeShares(ctx, relayerAddr, channelID)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrPayeeNotFound, "relayer %s on channel %s", relayerAddr, channelID)
+	}
 
-	if !store.Has(key) {
-		return "", false
+	if len(payeeShares) != 1 {
+		return "", errorsmod.Wrapf(types.ErrMultiplePayees, "relayer %s on channel %s has %d payees", relayerAddr, channelID, len(payeeShares))
 	}
 
-	return string(store.Get(key)), true
+	return payeeShares[0].Address, nil
 }
 
 // SetPayeeAddress stores the fee payee address in state keyed by the provided channel identifier and relayer address
 func (k Keeper) SetPayeeAddress(ctx sdk.Context, relayerAddr, payeeAddr, channelID string) {
+	k.SetPayeeShares(ctx, relayerAddr, []types.PayeeShare{types.NewPayeeShare(payeeAddr, 1)}, channelID)
+}
+
+// GetPayeeShares retrieves the payee shares stored in state given the provided channel identifier and relayer address
+func (k Keeper) GetPayeeShares(ctx sdk.Context, relayerAddr, channelID string) ([]types.PayeeShare, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPayee(relayerAddr, channelID))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return k.MustUnmarshalPayeeShares(bz).PayeeShares, true
+}
+
+// SetPayeeShares stores the payee shares between which the fees paid out to the relayer are split in state keyed by
+// the provided channel identifier and relayer address
+func (k Keeper) SetPayeeShares(ctx sdk.Context, relayerAddr string, payeeShares []types.PayeeShare, channelID string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.KeyPayee(relayerAddr, channelID), []byte(payeeAddr))
+	bz := k.MustMarshalPayeeShares(types.NewPayeeShares(payeeShares))
+	store.Set(types.KeyPayee(relayerAddr, channelID), bz)
 }
 
 // GetAllPayees returns all registered payees addresses
@@ -190,10 +295,17 @@ func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
 
 		payee := types.RegisteredPayee{
 			Relayer:   relayerAddr,
-			Payee:     string(iterator.Value()),
 			ChannelId: channelID,
 		}
 
+		// a single payee is exported as a payee address, as prior to the introduction of payee shares
+		payeeShares := k.MustUnmarshalPayeeShares(iterator.Value()).PayeeShares
+		if len(payeeShares) == 1 {
+			payee.Payee = payeeShares[0].Address
+		} else {
+			payee.PayeeShares = payeeShares
+		}
+
 		registeredPayees = append(registeredPayees, payee)
 	}
 
@@ -377,6 +489,305 @@ func (k Keeper) GetAllIdentifiedPacketFees(ctx sdk.Context) []types.IdentifiedPa
 	return identifiedFees
 }
 
+// SetMinimumFee stores the minimum fee required to send packets over the given channel, or over all channels
+// of the port if the channel identifier is empty
+func (k Keeper) SetMinimumFee(ctx sdk.Context, portID, channelID string, fee types.Fee) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyMinimumFee(portID, channelID), k.cdc.MustMarshal(&fee))
+}
+
+// GetMinimumFee returns the minimum fee stored for the given port and channel identifiers. The channel identifier
+// is empty for the minimum fee of all channels of the port.
+func (k Keeper) GetMinimumFee(ctx sdk.Context, portID, channelID string) (types.Fee, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyMinimumFee(portID, channelID))
+	if len(bz) == 0 {
+		return types.Fee{}, false
+	}
+
+	var fee types.Fee
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
+
+// DeleteMinimumFee removes the minimum fee stored for the given port and channel identifiers
+func (k Keeper) DeleteMinimumFee(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMinimumFee(portID, channelID))
+}
+
+// GetMinimumFeeForChannel returns the minimum fee required to send packets over the given channel.
+// The minimum fee of the channel takes precedence over the minimum fee of all channels of the port.
+func (k Keeper) GetMinimumFeeForChannel(ctx sdk.Context, portID, channelID string) (types.Fee, bool) {
+	if fee, found := k.GetMinimumFee(ctx, portID, channelID); found {
+		return fee, true
+	}
+
+	return k.GetMinimumFee(ctx, portID, "")
+}
+
+// GetAllMinimumFees returns all the minimum fees stored in state
+func (k Keeper) GetAllMinimumFees(ctx sdk.Context) []types.MinimumFee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.MinimumFeeKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var minimumFees []types.MinimumFee
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, err := types.ParseKeyMinimumFee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var fee types.Fee
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+
+		minimumFees = append(minimumFees, types.NewMinimumFee(portID, channelID, fee))
+	}
+
+	return minimumFees
+}
+
+// GetClientUpdateFees returns all the fees escrowed for the updates of the given client
+func (k Keeper) GetClientUpdateFees(ctx sdk.Context, clientID string) (types.ClientUpdateFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyClientUpdateFees(clientID))
+	if len(bz) == 0 {
+		return types.ClientUpdateFees{}, false
+	}
+
+	var clientUpdateFees types.ClientUpdateFees
+	k.cdc.MustUnmarshal(bz, &clientUpdateFees)
+	return clientUpdateFees, true
+}
+
+// SetClientUpdateFees sets the given client update fees in escrow keyed by the client identifier
+// and indexes the client by the expiry timestamps of the fees
+func (k Keeper) SetClientUpdateFees(ctx sdk.Context, clientID string, clientUpdateFees types.ClientUpdateFees) {
+	k.DeleteClientUpdateFees(ctx, clientID)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyClientUpdateFees(clientID), k.cdc.MustMarshal(&clientUpdateFees))
+
+	for _, clientUpdateFee := range clientUpdateFees.ClientUpdateFees {
+		store.Set(types.KeyClientUpdateFeesExpiry(clientUpdateFee.ExpiryTimestamp, clientID), []byte{1})
+	}
+}
+
+// DeleteClientUpdateFees deletes the client update fees associated with the given client identifier
+// and their entries in the expiry index
+func (k Keeper) DeleteClientUpdateFees(ctx sdk.Context, clientID string) {
+	clientUpdateFees, found := k.GetClientUpdateFees(ctx, clientID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, clientUpdateFee := range clientUpdateFees.ClientUpdateFees {
+		store.Delete(types.KeyClientUpdateFeesExpiry(clientUpdateFee.ExpiryTimestamp, clientID))
+	}
+
+	store.Delete(types.KeyClientUpdateFees(clientID))
+}
+
+// GetExpiredClientUpdateFeesClientIDs returns the identifiers of the clients with client update fees whose expiry
+// timestamp has been reached at the given block time, in order of expiry. Only the expired entries of the expiry
+// index are read.
+func (k Keeper) GetExpiredClientUpdateFeesClientIDs(ctx sdk.Context, blockTime uint64) []string {
+	store := ctx.KVStore(k.storeKey)
+	start := []byte(types.ClientUpdateFeesExpiryPrefix + "/")
+	end := types.KeyClientUpdateFeesExpiryPrefix(blockTime + 1)
+	iterator := store.Iterator(start, end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var clientIDs []string
+	seen := make(map[string]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		_, clientID, err := types.ParseKeyClientUpdateFeesExpiry(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		if seen[clientID] {
+			continue
+		}
+
+		seen[clientID] = true
+		clientIDs = append(clientIDs, clientID)
+	}
+
+	return clientIDs
+}
+
+// GetAllClientUpdateFees returns a list of all IdentifiedClientUpdateFees that are stored in state
+func (k Keeper) GetAllClientUpdateFees(ctx sdk.Context) []types.IdentifiedClientUpdateFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ClientUpdateFeesPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var identifiedFees []types.IdentifiedClientUpdateFees
+	for ; iterator.Valid(); iterator.Next() {
+		clientID, err := types.ParseKeyClientUpdateFees(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var clientUpdateFees types.ClientUpdateFees
+		k.cdc.MustUnmarshal(iterator.Value(), &clientUpdateFees)
+
+		identifiedFees = append(identifiedFees, types.NewIdentifiedClientUpdateFees(clientID, clientUpdateFees.ClientUpdateFees))
+	}
+
+	return identifiedFees
+}
+
+// GetChannelUpgradeFees returns all the fees escrowed for the channel upgrade handshake at the given upgrade sequence
+func (k Keeper) GetChannelUpgradeFees(ctx sdk.Context, portID, channelID string, upgradeSequence uint64) (types.ChannelUpgradeFees, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyChannelUpgradeFees(portID, channelID, upgradeSequence))
+	if len(bz) == 0 {
+		return types.ChannelUpgradeFees{}, false
+	}
+
+	var channelUpgradeFees types.ChannelUpgradeFees
+	k.cdc.MustUnmarshal(bz, &channelUpgradeFees)
+	return channelUpgradeFees, true
+}
+
+// SetChannelUpgradeFees sets the given channel upgrade fees in escrow keyed by the port and channel identifiers and the upgrade sequence
+func (k Keeper) SetChannelUpgradeFees(ctx sdk.Context, portID, channelID string, upgradeSequence uint64, channelUpgradeFees types.ChannelUpgradeFees) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyChannelUpgradeFees(portID, channelID, upgradeSequence), k.cdc.MustMarshal(&channelUpgradeFees))
+}
+
+// DeleteChannelUpgradeFees deletes the channel upgrade fees associated with the given channel and upgrade sequence
+func (k Keeper) DeleteChannelUpgradeFees(ctx sdk.Context, portID, channelID string, upgradeSequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyChannelUpgradeFees(portID, channelID, upgradeSequence))
+}
+
+// GetIdentifiedChannelUpgradeFeesForChannel returns all the channel upgrade fees currently escrowed on a given channel
+func (k Keeper) GetIdentifiedChannelUpgradeFeesForChannel(ctx sdk.Context, portID, channelID string) []types.IdentifiedChannelUpgradeFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyChannelUpgradeFeesChannelPrefix(portID, channelID))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	return k.collectIdentifiedChannelUpgradeFees(iterator)
+}
+
+// GetAllChannelUpgradeFees returns a list of all IdentifiedChannelUpgradeFees that are stored in state
+func (k Keeper) GetAllChannelUpgradeFees(ctx sdk.Context) []types.IdentifiedChannelUpgradeFees {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ChannelUpgradeFeesPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	return k.collectIdentifiedChannelUpgradeFees(iterator)
+}
+
+// collectIdentifiedChannelUpgradeFees decodes the channel upgrade fees stored under each key of the iterator
+func (k Keeper) collectIdentifiedChannelUpgradeFees(iterator storetypes.Iterator) []types.IdentifiedChannelUpgradeFees {
+	var identifiedFees []types.IdentifiedChannelUpgradeFees
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID, upgradeSequence, err := types.ParseKeyChannelUpgradeFees(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		var channelUpgradeFees types.ChannelUpgradeFees
+		k.cdc.MustUnmarshal(iterator.Value(), &channelUpgradeFees)
+
+		identifiedFees = append(identifiedFees, types.NewIdentifiedChannelUpgradeFees(portID, channelID, upgradeSequence, channelUpgradeFees.ChannelUpgradeFees))
+	}
+
+	return identifiedFees
+}
+
+// GetPayeeStatistics returns the statistics of the given payee, channel and denomination, aggregated over all time if
+// the bucket start is zero or over the time bucket starting at the given time otherwise
+func (k Keeper) GetPayeeStatistics(ctx sdk.Context, payee, channelID, denom string, bucketStart uint64) (types.PayeeStatistics, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(payeeStatisticsKey(payee, channelID, denom, bucketStart))
+	if bz == nil {
+		return types.PayeeStatistics{}, false
+	}
+
+	var payeeStatistics types.PayeeStatistics
+	k.cdc.MustUnmarshal(bz, &payeeStatistics)
+
+	return payeeStatistics, true
+}
+
+// SetPayeeStatistics stores the payee statistics, under the key of their time bucket if they are aggregated over a time bucket
+func (k Keeper) SetPayeeStatistics(ctx sdk.Context, payeeStatistics types.PayeeStatistics) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(payeeStatisticsKey(payeeStatistics.Payee, payeeStatistics.ChannelId, payeeStatistics.Denom, payeeStatistics.BucketStart), k.cdc.MustMarshal(&payeeStatistics))
+}
+
+// PrunePayeeStatisticsBuckets deletes the statistics of the given payee and channel aggregated over time buckets which
+// started before the oldest time bucket retained while statistics are recorded in the time bucket starting at the given time
+func (k Keeper) PrunePayeeStatisticsBuckets(ctx sdk.Context, payee, channelID string, bucketStart uint64) {
+	oldestBucketStart := types.OldestRetainedBucketStart(bucketStart)
+	if oldestBucketStart == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, statistics := range k.getPayeeStatisticsBucketsBefore(ctx, payee, channelID, oldestBucketStart) {
+		store.Delete(payeeStatisticsKey(statistics.Payee, statistics.ChannelId, statistics.Denom, statistics.BucketStart))
+	}
+}
+
+// getPayeeStatisticsBucketsBefore returns the statistics of the given payee and channel aggregated over time buckets
+// which started before the given time. The statistics are collected before they are deleted so that the store is not
+// written while it is iterated.
+func (k Keeper) getPayeeStatisticsBucketsBefore(ctx sdk.Context, payee, channelID string, bucketStart uint64) []types.PayeeStatistics {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPayeeStatisticsPrefix(payee, channelID, true))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var statisticsBefore []types.PayeeStatistics
+	for ; iterator.Valid(); iterator.Next() {
+		var statistics types.PayeeStatistics
+		k.cdc.MustUnmarshal(iterator.Value(), &statistics)
+
+		if statistics.BucketStart < bucketStart {
+			statisticsBefore = append(statisticsBefore, statistics)
+		}
+	}
+
+	return statisticsBefore
+}
+
+// GetAllPayeeStatistics returns the statistics of all payees that are stored in state, aggregated over all time and per time bucket
+func (k Keeper) GetAllPayeeStatistics(ctx sdk.Context) []types.PayeeStatistics {
+	store := ctx.KVStore(k.storeKey)
+
+	var payeeStatistics []types.PayeeStatistics
+	for _, timeBuckets := range []bool{false, true} {
+		iterator := storetypes.KVStorePrefixIterator(store, types.KeyPayeeStatisticsPrefix("", "", timeBuckets))
+		for ; iterator.Valid(); iterator.Next() {
+			var statistics types.PayeeStatistics
+			k.cdc.MustUnmarshal(iterator.Value(), &statistics)
+
+			payeeStatistics = append(payeeStatistics, statistics)
+		}
+
+		sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	}
+
+	return payeeStatistics
+}
+
+// payeeStatisticsKey returns the key of the payee statistics aggregated over all time if the bucket start is zero
+// or over the time bucket starting at the given time otherwise
+func payeeStatisticsKey(payee, channelID, denom string, bucketStart uint64) []byte {
+	if bucketStart == 0 {
+		return types.KeyPayeeStatistics(payee, channelID, denom)
+	}
+
+	return types.KeyPayeeStatisticsBucket(payee, channelID, bucketStart, denom)
+}
+
 // MustMarshalFees attempts to encode a Fee object and returns the
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustMarshalFees(fees types.PacketFees) []byte {
@@ -390,3 +801,17 @@ func (k Keeper) MustUnmarshalFees(bz []byte) types.PacketFees {
 	k.cdc.MustUnmarshal(bz, &fees)
 	return fees
 }
+
+// MustMarshalPayeeShares attempts to encode a PayeeShares object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalPayeeShares(payeeShares types.PayeeShares) []byte {
+	return k.cdc.MustMarshal(&payeeShares)
+}
+
+// MustUnmarshalPayeeShares attempts to decode and return a PayeeShares object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalPayeeShares(bz []byte) types.PayeeShares {
+	var payeeShares types.PayeeShares
+	k.cdc.MustUnmarshal(bz, &payeeShares)
+	return payeeShares
+}
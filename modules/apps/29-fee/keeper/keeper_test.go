@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	testifysuite "github.com/stretchr/testify/suite"
@@ -92,8 +93,8 @@ func (suite *KeeperTestSuite) TestEscrowAccountHasBalance() {
 func (suite *KeeperTestSuite) TestGetSetPayeeAddress() {
 	suite.path.Setup()
 
-	payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
-	suite.Require().False(found)
+	payeeAddr, err := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
+	suite.Require().ErrorIs(err, types.ErrPayeeNotFound)
 	suite.Require().Empty(payeeAddr)
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(
@@ -103,9 +104,20 @@ func (suite *KeeperTestSuite) TestGetSetPayeeAddress() {
 		suite.path.EndpointA.ChannelID,
 	)
 
-	payeeAddr, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
-	suite.Require().True(found)
+	payeeAddr, err = suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
 	suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), payeeAddr)
+
+	// the fees of the relayer are split between multiple payees
+	payeeShares := []types.PayeeShare{
+		types.NewPayeeShare(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 1),
+		types.NewPayeeShare(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 2),
+	}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeShares(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), payeeShares, suite.path.EndpointA.ChannelID)
+
+	payeeAddr, err = suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
+	suite.Require().ErrorIs(err, types.ErrMultiplePayees)
+	suite.Require().Empty(payeeAddr)
 }
 
 func (suite *KeeperTestSuite) TestFeesInEscrow() {
@@ -300,6 +312,34 @@ func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
 	suite.Require().Equal(counterpartyPayeeAddr, expectedCounterpartyPayee)
 }
 
+func (suite *KeeperTestSuite) TestPrunePayeeStatisticsBuckets() {
+	payee := suite.chainA.SenderAccount.GetAddress().String()
+	bucketDuration := uint64(types.PayeeStatisticsBucketDuration.Seconds())
+	bucketStart := 2 * types.MaxPayeeStatisticsBuckets * bucketDuration
+	oldestBucketStart := bucketStart - (types.MaxPayeeStatisticsBuckets-1)*bucketDuration
+
+	prunedStatistics := []types.PayeeStatistics{
+		types.NewPayeeStatistics(payee, ibctesting.FirstChannelID, sdk.DefaultBondDenom, bucketDuration),
+		types.NewPayeeStatistics(payee, ibctesting.FirstChannelID, "stake2", oldestBucketStart-bucketDuration),
+	}
+
+	retainedStatistics := []types.PayeeStatistics{
+		types.NewPayeeStatistics(payee, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 0),
+		types.NewPayeeStatistics(payee, ibctesting.FirstChannelID, sdk.DefaultBondDenom, oldestBucketStart),
+		types.NewPayeeStatistics(payee, ibctesting.FirstChannelID, sdk.DefaultBondDenom, bucketStart),
+		types.NewPayeeStatistics(payee, "channel-1", sdk.DefaultBondDenom, bucketDuration),
+		types.NewPayeeStatistics(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, bucketDuration),
+	}
+
+	for _, statistics := range append(slices.Clone(prunedStatistics), retainedStatistics...) {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeStatistics(suite.chainA.GetContext(), statistics)
+	}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.PrunePayeeStatisticsBuckets(suite.chainA.GetContext(), payee, ibctesting.FirstChannelID, bucketStart)
+
+	suite.Require().ElementsMatch(retainedStatistics, suite.chainA.GetSimApp().IBCFeeKeeper.GetAllPayeeStatistics(suite.chainA.GetContext()))
+}
+
 func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	suite.SetupTest()
 
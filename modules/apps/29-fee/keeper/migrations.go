@@ -45,6 +45,34 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by storing each registered payee address as a list of payee shares containing the payee as the only payee.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PayeeKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var registeredPayees []types.RegisteredPayee
+	for ; iterator.Valid(); iterator.Next() {
+		relayerAddr, channelID, err := types.ParseKeyPayeeAddress(string(iterator.Key()))
+		if err != nil {
+			return err
+		}
+
+		registeredPayees = append(registeredPayees, types.RegisteredPayee{
+			Relayer:   relayerAddr,
+			Payee:     string(iterator.Value()),
+			ChannelId: channelID,
+		})
+	}
+
+	for _, registeredPayee := range registeredPayees {
+		m.keeper.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ChannelId)
+	}
+
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
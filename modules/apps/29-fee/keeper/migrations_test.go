@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestLegacyTotal() {
@@ -199,3 +200,24 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	relayerAddr := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	payeeAddr := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	// store the payee address using the legacy encoding
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Set(types.KeyPayee(relayerAddr, ibctesting.FirstChannelID), []byte(payeeAddr))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	payeeShares, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeShares(suite.chainA.GetContext(), relayerAddr, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal([]types.PayeeShare{types.NewPayeeShare(payeeAddr, 1)}, payeeShares)
+
+	payee, err := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), relayerAddr, ibctesting.FirstChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(payeeAddr, payee)
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
@@ -18,17 +19,21 @@ var _ types.MsgServer = (*Keeper)(nil)
 // RegisterPayee is called by the relayer on each channelEnd and allows them to set an optional
 // payee to which reverse and timeout relayer packet fees will be paid out. The payee should be registered on
 // the source chain from which packets originate as this is where fee distribution takes place. This function may be
-// called more than once by a relayer, in which case, the latest payee is always used.
+// called more than once by a relayer, in which case, the latest payee is always used. Instead of a single payee, the
+// relayer may register a list of weighted payees between which fees are split.
 func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	payee, err := sdk.AccAddressFromBech32(msg.Payee)
-	if err != nil {
-		return nil, err
-	}
+	payeeShares := msg.Payees()
+	for _, payeeShare := range payeeShares {
+		payee, err := sdk.AccAddressFromBech32(payeeShare.Address)
+		if err != nil {
+			return nil, err
+		}
 
-	if k.bankKeeper.BlockedAddr(payee) {
-		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+		if k.bankKeeper.BlockedAddr(payee) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+		}
 	}
 
 	// only register payee address if the channel exists and is fee enabled
@@ -40,11 +45,13 @@ func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee
 		return nil, types.ErrFeeNotEnabled
 	}
 
-	k.SetPayeeAddress(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+	k.SetPayeeShares(ctx, msg.Relayer, payeeShares, msg.ChannelId)
 
-	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "channel", msg.ChannelId)
+	payee := types.FormatPayeeShares(payeeShares)
 
-	emitRegisterPayeeEvent(ctx, msg.Relayer, msg.Payee, msg.ChannelId)
+	k.Logger(ctx).Info("registering payee address for relayer", "relayer", msg.Relayer, "payee", payee, "channel", msg.ChannelId)
+
+	emitRegisterPayeeEvent(ctx, msg.Relayer, payee, msg.ChannelId)
 
 	return &types.MsgRegisterPayeeResponse{}, nil
 }
@@ -168,3 +175,159 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// RecoverLockedFeeModule defines a rpc handler method for MsgRecoverLockedFeeModule
+// RecoverLockedFeeModule reconciles the fees in escrow against the balance of the fee module account and unlocks the
+// fee module. If the balance of the fee module account is less than the fees in escrow, the deficit is transferred
+// from the funder account, or the recovery fails if no funder is provided. As the signer is the only account
+// authorizing the message, the funder must be the signer.
+func (k Keeper) RecoverLockedFeeModule(goCtx context.Context, msg *types.MsgRecoverLockedFeeModule) (*types.MsgRecoverLockedFeeModuleResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	if msg.Funder != "" && msg.Funder != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "funder %s must be the signer %s", msg.Funder, msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleNotLocked
+	}
+
+	_, _, deficit := k.ReconcileEscrow(ctx)
+	if !deficit.IsZero() {
+		if msg.Funder == "" {
+			return nil, errorsmod.Wrapf(types.ErrEscrowDeficit, "deficit of %s must be topped up from a funder account", deficit)
+		}
+
+		funder, err := sdk.AccAddressFromBech32(msg.Funder)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, deficit); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to top up escrow deficit of %s from funder %s", deficit, msg.Funder)
+		}
+	}
+
+	k.unlockFeeModule(ctx)
+
+	k.Logger(ctx).Info("fee module unlocked", "funder", msg.Funder, "deficit", deficit.String())
+
+	emitRecoverFeeModuleEvent(ctx, msg.Funder, deficit)
+
+	return &types.MsgRecoverLockedFeeModuleResponse{Deficit: deficit}, nil
+}
+
+// UpdateMinimumFee defines a rpc handler method for MsgUpdateMinimumFee
+// UpdateMinimumFee sets the minimum fee required to send packets over a fee enabled channel, or over all channels of
+// a port if the channel identifier is empty. An empty fee removes the minimum fee.
+func (k Keeper) UpdateMinimumFee(goCtx context.Context, msg *types.MsgUpdateMinimumFee) (*types.MsgUpdateMinimumFeeResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Fee.IsZero() {
+		k.DeleteMinimumFee(ctx, msg.PortId, msg.ChannelId)
+	} else {
+		k.SetMinimumFee(ctx, msg.PortId, msg.ChannelId, msg.Fee)
+	}
+
+	k.Logger(ctx).Info("minimum fee updated", "port-id", msg.PortId, "channel-id", msg.ChannelId, "recv-fee", msg.Fee.RecvFee.String(), "ack-fee", msg.Fee.AckFee.String(), "timeout-fee", msg.Fee.TimeoutFee.String())
+
+	emitUpdateMinimumFeeEvent(ctx, msg.PortId, msg.ChannelId, msg.Fee)
+
+	return &types.MsgUpdateMinimumFeeResponse{}, nil
+}
+
+// PayClientUpdateFee defines a rpc handler method for MsgPayClientUpdateFee
+// PayClientUpdateFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize the relaying of a bounded number of updates of a light client, paid at most once per refresh interval.
+// The unspent fees are refunded once the expiry timestamp is reached.
+func (k Keeper) PayClientUpdateFee(goCtx context.Context, msg *types.MsgPayClientUpdateFee) (*types.MsgPayClientUpdateFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if _, found := k.clientKeeper.GetClientState(ctx, msg.ClientId); !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client (%s) not found", msg.ClientId)
+	}
+
+	if blockTime := uint64(ctx.BlockTime().UnixNano()); msg.ExpiryTimestamp <= blockTime {
+		return nil, errorsmod.Wrapf(types.ErrClientUpdateFeeExpired, "expiry timestamp (%d) must be greater than the block time (%d)", msg.ExpiryTimestamp, blockTime)
+	}
+
+	refundAcc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	clientUpdateFee := types.NewClientUpdateFee(msg.Fee, msg.Signer, msg.MaxUpdates, msg.RefreshInterval, msg.ExpiryTimestamp)
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, clientUpdateFee.Total()...); err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(refundAcc) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
+	}
+
+	if err := k.escrowClientUpdateFee(ctx, msg.ClientId, clientUpdateFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayClientUpdateFeeResponse{}, nil
+}
+
+// PayChannelUpgradeFee defines a rpc handler method for MsgPayChannelUpgradeFee
+// PayChannelUpgradeFee is an open callback that may be called by any module/user that wishes to escrow funds in order to
+// incentivize the relaying of the try, ack, confirm and open messages of a channel upgrade handshake. Only the upgrade
+// in progress on the channel or future upgrades of the channel may be incentivized.
+func (k Keeper) PayChannelUpgradeFee(goCtx context.Context, msg *types.MsgPayChannelUpgradeFee) (*types.MsgPayChannelUpgradeFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	channel, found := k.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	if channel.State == channeltypes.CLOSED {
+		return nil, errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "channel with port ID (%s) and channel ID (%s) is closed", msg.PortId, msg.ChannelId)
+	}
+
+	// the current upgrade sequence may only be incentivized while the upgrade is in progress
+	_, upgradeInProgress := k.GetUpgrade(ctx, msg.PortId, msg.ChannelId)
+	if msg.UpgradeSequence < channel.UpgradeSequence || (msg.UpgradeSequence == channel.UpgradeSequence && !upgradeInProgress) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUpgradeSequence, "upgrade with sequence %d is no longer in progress, current upgrade sequence is %d", msg.UpgradeSequence, channel.UpgradeSequence)
+	}
+
+	refundAcc, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Fee.Total()...); err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(refundAcc) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
+	}
+
+	channelUpgradeFee := types.NewChannelUpgradeFee(msg.Fee, msg.Signer)
+	if err := k.escrowChannelUpgradeFee(ctx, msg.PortId, msg.ChannelId, msg.UpgradeSequence, channelUpgradeFee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPayChannelUpgradeFeeResponse{}, nil
+}
@@ -2,16 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -29,6 +32,17 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 			true,
 			func() {},
 		},
+		{
+			"success: payee shares",
+			true,
+			func() {
+				msg.Payee = ""
+				msg.PayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 70),
+					types.NewPayeeShare(suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String(), 30),
+				}
+			},
+		},
 		{
 			"channel does not exist",
 			false,
@@ -57,6 +71,17 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 				msg.Payee = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
 			},
 		},
+		{
+			"payee shares include a blocked address",
+			false,
+			func() {
+				msg.Payee = ""
+				msg.PayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), 1),
+					types.NewPayeeShare(suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String(), 1),
+				}
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,20 +106,20 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 			suite.Require().NoError(err)
 			suite.Require().NotNil(res)
 
-			payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(
+			payeeShares, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeShares(
 				suite.chainA.GetContext(),
 				suite.chainA.SenderAccount.GetAddress().String(),
 				suite.path.EndpointA.ChannelID,
 			)
 
 			suite.Require().True(found)
-			suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), payeeAddr)
+			suite.Require().Equal(msg.Payees(), payeeShares)
 
 			expectedEvents := sdk.Events{
 				sdk.NewEvent(
 					types.EventTypeRegisterPayee,
 					sdk.NewAttribute(types.AttributeKeyRelayer, suite.chainA.SenderAccount.GetAddress().String()),
-					sdk.NewAttribute(types.AttributeKeyPayee, payeeAddr),
+					sdk.NewAttribute(types.AttributeKeyPayee, types.FormatPayeeShares(payeeShares)),
 					sdk.NewAttribute(types.AttributeKeyChannelID, suite.path.EndpointA.ChannelID),
 				),
 			}.ToABCIEvents()
@@ -605,3 +630,475 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRecoverLockedFeeModule() {
+	var (
+		msg       *types.MsgRecoverLockedFeeModule
+		packetFee types.PacketFee
+		funder    sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expDeficit bool
+		expErr     error
+	}{
+		{
+			"success: no deficit",
+			func() {
+				msg.Funder = ""
+
+				// fund the escrow account with the fees in escrow
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, packetFee.Fee.Total())
+				suite.Require().NoError(err)
+			},
+			false,
+			nil,
+		},
+		{
+			"success: deficit topped up from funder",
+			func() {
+				// fund the authority account, which is the funder, with the deficit
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), funder, packetFee.Fee.Total())
+				suite.Require().NoError(err)
+			},
+			true,
+			nil,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"funder is not the signer",
+			func() {
+				funder = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+				msg.Funder = funder.String()
+			},
+			false,
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"fee module is not locked",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+				store.Delete(types.KeyLocked())
+			},
+			false,
+			types.ErrFeeModuleNotLocked,
+		},
+		{
+			"deficit without funder",
+			func() {
+				msg.Funder = ""
+			},
+			false,
+			types.ErrEscrowDeficit,
+		},
+		{
+			"funder has insufficient funds",
+			func() {},
+			false,
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee = types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+			// store the fees in escrow without funding the escrow account
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+			lockFeeModule(suite.chainA)
+
+			authority := suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+			funder = sdk.MustAccAddressFromBech32(authority)
+			msg = types.NewMsgRecoverLockedFeeModule(authority, authority)
+
+			tc.malleate()
+
+			funderBalBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), funder, sdk.DefaultBondDenom)
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RecoverLockedFeeModule(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				_, _, deficit := suite.chainA.GetSimApp().IBCFeeKeeper.ReconcileEscrow(suite.chainA.GetContext())
+				suite.Require().True(deficit.IsZero())
+
+				funderBalAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), funder, sdk.DefaultBondDenom)
+				if tc.expDeficit {
+					suite.Require().Equal(packetFee.Fee.Total(), res.Deficit)
+					suite.Require().True(funderBalBefore.Sub(packetFee.Fee.Total()[0]).IsEqual(funderBalAfter))
+				} else {
+					suite.Require().True(res.Deficit.IsZero())
+					suite.Require().Equal(funderBalBefore, funderBalAfter)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateMinimumFee() {
+	var msg *types.MsgUpdateMinimumFee
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: port minimum fee",
+			func() {
+				msg.ChannelId = ""
+			},
+			nil,
+		},
+		{
+			"success: empty fee removes the minimum fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, fee)
+
+				msg.Fee = types.Fee{}
+			},
+			nil,
+		},
+		{
+			"unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgUpdateMinimumFee(suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(), ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateMinimumFee(suite.chainA.GetContext(), msg)
+
+			minimumFee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetMinimumFee(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				if msg.Fee.IsZero() {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(msg.Fee, minimumFee)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayClientUpdateFee() {
+	var msg *types.MsgPayClientUpdateFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success with existing client update fees in escrow",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayClientUpdateFee(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"maximum number of client update fees already in escrow",
+			func() {
+				fees := make([]types.ClientUpdateFee, types.MaxClientUpdateFees)
+				for i := range fees {
+					fees[i] = types.NewClientUpdateFee(msg.Fee, msg.Signer, msg.MaxUpdates, msg.RefreshInterval, msg.ExpiryTimestamp)
+				}
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetClientUpdateFees(suite.chainA.GetContext(), msg.ClientId, types.NewClientUpdateFees(fees))
+			},
+			types.ErrTooManyClientUpdateFees,
+		},
+		{
+			"expiry timestamp has passed",
+			func() {
+				msg.ExpiryTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			},
+			types.ErrClientUpdateFeeExpired,
+		},
+		{
+			"refund account does not exist",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			types.ErrRefundAccNotFound,
+		},
+		{
+			"refund account is a blocked address",
+			func() {
+				msg.Signer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"bank send disabled for fee denom",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SetParams(suite.chainA.GetContext(),
+					banktypes.Params{
+						SendEnabled: []*banktypes.SendEnabled{{Denom: sdk.DefaultBondDenom, Enabled: false}},
+					},
+				)
+				suite.Require().NoError(err)
+			},
+			banktypes.ErrSendDisabled,
+		},
+		{
+			"fee balance not found",
+			func() {
+				msg.Fee = invalidCoins
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.SetupClients()
+
+			expiryTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			msg = types.NewMsgPayClientUpdateFee(suite.path.EndpointA.ClientID, defaultRecvFee, 10, uint64(time.Minute), expiryTimestamp, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			clientUpdateFees, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), msg.ClientId)
+			expEscrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayClientUpdateFee(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				clientUpdateFee := types.NewClientUpdateFee(msg.Fee, msg.Signer, msg.MaxUpdates, msg.RefreshInterval, msg.ExpiryTimestamp)
+				expClientUpdateFees := append([]types.ClientUpdateFee{clientUpdateFee}, clientUpdateFees.ClientUpdateFees...)
+
+				actualClientUpdateFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().True(found)
+				suite.Require().Equal(expClientUpdateFees, actualClientUpdateFees.ClientUpdateFees)
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Equal(expEscrowBalance.Add(clientUpdateFee.Total()...), escrowBalance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+
+				actualClientUpdateFees, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), msg.ClientId)
+				suite.Require().Equal(clientUpdateFees, actualClientUpdateFees)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayChannelUpgradeFee() {
+	var msg *types.MsgPayChannelUpgradeFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: next channel upgrade",
+			func() {},
+			nil,
+		},
+		{
+			"success: channel upgrade in progress",
+			func() {
+				suite.path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version
+				suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit())
+
+				msg.UpgradeSequence = suite.path.EndpointA.GetChannel().UpgradeSequence
+			},
+			nil,
+		},
+		{
+			"success with existing channel upgrade fees in escrow",
+			func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayChannelUpgradeFee(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"channel is closed",
+			func() {
+				suite.path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"channel upgrade is not in progress",
+			func() {
+				msg.UpgradeSequence = suite.path.EndpointA.GetChannel().UpgradeSequence
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"channel upgrade has already completed",
+			func() {
+				suite.path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.UpgradeSequence = msg.UpgradeSequence + 1 })
+			},
+			types.ErrInvalidUpgradeSequence,
+		},
+		{
+			"refund account does not exist",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			types.ErrRefundAccNotFound,
+		},
+		{
+			"refund account is a blocked address",
+			func() {
+				msg.Signer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"bank send disabled for fee denom",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SetParams(suite.chainA.GetContext(),
+					banktypes.Params{
+						SendEnabled: []*banktypes.SendEnabled{{Denom: sdk.DefaultBondDenom, Enabled: false}},
+					},
+				)
+				suite.Require().NoError(err)
+			},
+			banktypes.ErrSendDisabled,
+		},
+		{
+			"try fee balance not found",
+			func() {
+				msg.Fee.TryFee = invalidCoins
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			fee := types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee)
+			upgradeSequence := suite.path.EndpointA.GetChannel().UpgradeSequence + 1
+			msg = types.NewMsgPayChannelUpgradeFee(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence, fee, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			channelUpgradeFees, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.UpgradeSequence)
+			expEscrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayChannelUpgradeFee(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				channelUpgradeFee := types.NewChannelUpgradeFee(msg.Fee, msg.Signer)
+				expChannelUpgradeFees := append([]types.ChannelUpgradeFee{channelUpgradeFee}, channelUpgradeFees.ChannelUpgradeFees...)
+
+				actualChannelUpgradeFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.UpgradeSequence)
+				suite.Require().True(found)
+				suite.Require().Equal(expChannelUpgradeFees, actualChannelUpgradeFees.ChannelUpgradeFees)
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Equal(expEscrowBalance.Add(fee.Total()...), escrowBalance)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+
+				actualChannelUpgradeFees, _ := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), msg.PortId, msg.ChannelId, msg.UpgradeSequence)
+				suite.Require().Equal(channelUpgradeFees, actualChannelUpgradeFees)
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ import (
 )
 
 // SendPacket wraps the ICS4Wrapper SendPacket function
+// If a minimum fee is set for a fee enabled channel, the fees escrowed for a packet initiated by a user, which must be
+// paid with MsgPayPacketFee before the packet is sent, are required to be greater than or equal to the minimum fee.
+// Packets sent by an application, while processing a packet callback or outside of a transaction, are exempt.
 func (k Keeper) SendPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -24,7 +27,44 @@ func (k Keeper) SendPacket(
 	timeoutTimestamp uint64,
 	data []byte,
 ) (uint64, error) {
-	return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// a locked fee module skips fee logic, in which case fees cannot be escrowed and the minimum fee is not enforced
+	if !k.IsFeeEnabled(ctx, sourcePort, sourceChannel) || k.IsLocked(ctx) {
+		return sequence, nil
+	}
+
+	// the fees of packets sent by an application cannot be paid before the packet is sent
+	if !types.IsUserInitiatedSend(ctx) {
+		return sequence, nil
+	}
+
+	minimumFee, found := k.GetMinimumFeeForChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sequence, nil
+	}
+
+	var escrowedFee types.Fee
+	packetID := channeltypes.NewPacketID(sourcePort, sourceChannel, sequence)
+	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+		for _, packetFee := range feesInEscrow.PacketFees {
+			escrowedFee.RecvFee = escrowedFee.RecvFee.Add(packetFee.Fee.RecvFee...)
+			escrowedFee.AckFee = escrowedFee.AckFee.Add(packetFee.Fee.AckFee...)
+			escrowedFee.TimeoutFee = escrowedFee.TimeoutFee.Add(packetFee.Fee.TimeoutFee...)
+		}
+	}
+
+	if !escrowedFee.IsAllGTE(minimumFee) {
+		return 0, errorsmod.Wrapf(
+			types.ErrMinimumFeeNotMet, "escrowed fees (recv: %s, ack: %s, timeout: %s) are less than the minimum fees (recv: %s, ack: %s, timeout: %s) for port ID %s and channel ID %s",
+			escrowedFee.RecvFee, escrowedFee.AckFee, escrowedFee.TimeoutFee, minimumFee.RecvFee, minimumFee.AckFee, minimumFee.TimeoutFee, sourcePort, sourceChannel,
+		)
+	}
+
+	return sequence, nil
 }
 
 // WriteAcknowledgement wraps IBC ChannelKeeper's WriteAcknowledgement function
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -170,3 +172,133 @@ func (suite *KeeperTestSuite) TestGetAppVersion() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSendPacketMinimumFee() {
+	var (
+		packetFees []types.PacketFee
+		ctx        sdk.Context
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no minimum fee",
+			func() {
+				packetFees = nil
+			},
+			nil,
+		},
+		{
+			"success: escrowed fees meet the channel minimum fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+			},
+			nil,
+		},
+		{
+			"success: escrowed fees of multiple packet fees meet the port minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, "", minimumFee)
+
+				packetFees = append(packetFees, packetFees[0])
+			},
+			nil,
+		},
+		{
+			"success: channel minimum fee takes precedence over port minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, "", minimumFee)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+			},
+			nil,
+		},
+		{
+			"success: fee module is locked",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+				lockFeeModule(suite.chainA)
+
+				packetFees = nil
+			},
+			nil,
+		},
+		{
+			"success: packet sent outside of a transaction is exempt",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+				ctx = ctx.WithTxBytes(nil)
+
+				packetFees = nil
+			},
+			nil,
+		},
+		{
+			"success: packet sent within a packet callback is exempt",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, fee)
+				ctx = types.WithinPacketCallback(ctx)
+
+				packetFees = nil
+			},
+			nil,
+		},
+		{
+			"escrowed fees do not meet the minimum fee",
+			func() {
+				minimumFee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee.Add(defaultTimeoutFee...))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, minimumFee)
+			},
+			types.ErrMinimumFeeNotMet,
+		},
+		{
+			"no fees escrowed",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, "", fee)
+
+				packetFees = nil
+			},
+			types.ErrMinimumFeeNotMet,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			packetFees = []types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)}
+			// the minimum fee is enforced for packets sent within a transaction
+			ctx = suite.chainA.GetContext().WithTxBytes([]byte("tx"))
+
+			tc.malleate()
+
+			// escrow the packet fees for the next sequence, as done by MsgPayPacketFee
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			if len(packetFees) != 0 {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+			}
+
+			chanCap := suite.chainA.GetChannelCapability(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			sequence, err := suite.chainA.GetSimApp().IBCFeeKeeper.SendPacket(
+				ctx, chanCap, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				clienttypes.ZeroHeight(), ^uint64(0), ibcmock.MockPacketData,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// latestHeightsKey is the context key of the latest heights of the clients updated by a transaction, recorded
+// before the messages of the transaction are executed.
+type latestHeightsKey struct{}
+
+type IncentivizedMsgsDecorator struct {
+	k            keeper.Keeper
+	clientKeeper types.ClientKeeper
+}
+
+func NewIncentivizedMsgsDecorator(k keeper.Keeper, clientKeeper types.ClientKeeper) IncentivizedMsgsDecorator {
+	return IncentivizedMsgsDecorator{k: k, clientKeeper: clientKeeper}
+}
+
+// AnteHandle records the latest heights of the clients updated by the MsgUpdateClient messages of the transaction
+// before the messages are executed, so that PostHandle only pays the fees of client updates which advanced the client.
+func (imd IncentivizedMsgsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	latestHeights := make(map[string]exported.Height)
+	for _, m := range tx.GetMsgs() {
+		msg, ok := m.(*clienttypes.MsgUpdateClient)
+		if !ok {
+			continue
+		}
+
+		if _, recorded := latestHeights[msg.ClientId]; recorded {
+			continue
+		}
+
+		clientState, found := imd.clientKeeper.GetClientState(ctx, msg.ClientId)
+		if !found {
+			continue
+		}
+
+		latestHeights[msg.ClientId] = clientState.GetLatestHeight()
+	}
+
+	return next(ctx.WithValue(latestHeightsKey{}, latestHeights), tx, simulate)
+}
+
+// PostHandle pays the ICS-29 fees escrowed for client updates and channel upgrade handshakes to the relayers of the
+// messages of a successfully executed transaction. The fees of a client are paid on MsgUpdateClient if the latest
+// height of the client, recorded by AnteHandle before the transaction is executed, has advanced. The fees of each step
+// of a channel upgrade are paid on the MsgChannelUpgradeTry, MsgChannelUpgradeAck, MsgChannelUpgradeConfirm and
+// MsgChannelUpgradeOpen messages for the channel, unless core IBC aborted the upgrade and wrote an error receipt,
+// in which case the fees escrowed for the upgrade are refunded. The fees escrowed for channel upgrades which are no
+// longer in progress are refunded once the upgrade completes, is cancelled or times out, or the channel is closed.
+// Only messages included directly in the transaction are considered. An error is returned if the decorator is not
+// also set in the ante handler of the application.
+func (imd IncentivizedMsgsDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// the latest heights are recorded by AnteHandle, without them updates which advanced a client cannot be told
+	// apart from updates which did not
+	latestHeights, found := ctx.Value(latestHeightsKey{}).(map[string]exported.Height)
+	if !found {
+		return ctx, errorsmod.Wrap(ibcerrors.ErrLogic, "latest client heights not recorded: the IncentivizedMsgsDecorator must be set in both the ante handler and the post handler of the application")
+	}
+
+	for _, m := range tx.GetMsgs() {
+		switch msg := m.(type) {
+		case *clienttypes.MsgUpdateClient:
+			relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+			if err != nil {
+				continue
+			}
+
+			clientState, found := imd.clientKeeper.GetClientState(ctx, msg.ClientId)
+			if !found {
+				continue
+			}
+
+			// the update is only paid if it advanced the latest height of the client
+			previousHeight, found := latestHeights[msg.ClientId]
+			if !found || !clientState.GetLatestHeight().GT(previousHeight) {
+				continue
+			}
+
+			imd.k.DistributeClientUpdateFees(ctx, msg.ClientId, clientState.GetLatestHeight(), relayer)
+		case *channeltypes.MsgChannelUpgradeTry:
+			imd.distributeChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId, types.UpgradeStepTry, msg.Signer)
+		case *channeltypes.MsgChannelUpgradeAck:
+			imd.distributeChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId, types.UpgradeStepAck, msg.Signer)
+		case *channeltypes.MsgChannelUpgradeConfirm:
+			imd.distributeChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId, types.UpgradeStepConfirm, msg.Signer)
+		case *channeltypes.MsgChannelUpgradeOpen:
+			imd.k.DistributeChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId, types.UpgradeStepOpen, msg.Signer)
+		case *channeltypes.MsgChannelUpgradeCancel:
+			imd.k.RefundChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId)
+		case *channeltypes.MsgChannelUpgradeTimeout:
+			imd.k.RefundChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId)
+		case *channeltypes.MsgChannelCloseInit:
+			imd.k.RefundChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId)
+		case *channeltypes.MsgChannelCloseConfirm:
+			imd.k.RefundChannelUpgradeFees(ctx, msg.PortId, msg.ChannelId)
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// distributeChannelUpgradeFees pays the fees of the upgrade step executed by a MsgChannelUpgradeTry,
+// MsgChannelUpgradeAck or MsgChannelUpgradeConfirm message. These messages succeed with a failure result when core
+// IBC aborts the upgrade, in which case the channel is restored to OPEN and an error receipt is written for its
+// current upgrade sequence, and the fees escrowed for the aborted upgrade are refunded. A MsgChannelUpgradeTry
+// which fails while an upgrade initialized by the channel remains in progress pays no fees.
+func (imd IncentivizedMsgsDecorator) distributeChannelUpgradeFees(ctx sdk.Context, portID, channelID string, step types.UpgradeStep, relayer string) {
+	channel, found := imd.k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	if channel.State != channeltypes.OPEN {
+		imd.k.DistributeChannelUpgradeFees(ctx, portID, channelID, step, relayer)
+		return
+	}
+
+	errorReceipt, found := imd.k.GetUpgradeErrorReceipt(ctx, portID, channelID)
+	if found && errorReceipt.Sequence >= channel.UpgradeSequence {
+		imd.k.RefundChannelUpgradeFees(ctx, portID, channelID)
+		return
+	}
+
+	// only a MsgChannelUpgradeConfirm may successfully complete the upgrade and restore the channel to OPEN
+	if step == types.UpgradeStepConfirm {
+		imd.k.DistributeChannelUpgradeFees(ctx, portID, channelID, step, relayer)
+	}
+}
@@ -0,0 +1,222 @@
+package post_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/post"
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+var (
+	defaultTryFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(200)}}
+	defaultConfirmFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(300)}}
+	defaultOpenFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(400)}}
+)
+
+type PostTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+// SetupTest creates a coordinator with 2 test chains and a fee enabled mock channel between them.
+func (suite *PostTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	mockFeeVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: ibcmock.Version}))
+	path.EndpointA.ChannelConfig.Version = mockFeeVersion
+	path.EndpointB.ChannelConfig.Version = mockFeeVersion
+	path.EndpointA.ChannelConfig.PortID = ibctesting.MockFeePort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.MockFeePort
+	suite.path = path
+	suite.path.Setup()
+}
+
+// TestPostTestSuite runs all the tests within this package.
+func TestPostTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(PostTestSuite))
+}
+
+func (suite *PostTestSuite) TestClientUpdateFees() {
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	expiryTimestamp := uint64(suite.chainA.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	msg := types.NewMsgPayClientUpdateFee(suite.path.EndpointA.ClientID, defaultTryFee, 2, uint64(time.Minute), expiryTimestamp, refundAcc.String())
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayClientUpdateFee(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	relayerBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+
+	// the first update is paid
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBal.Add(defaultTryFee[0]), balance)
+
+	trustedHeight := suite.path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+
+	// an update within the refresh interval is not paid
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBal.Add(defaultTryFee[0]), balance)
+
+	suite.coordinator.IncrementTimeBy(time.Minute)
+
+	// an update with the header of the latest height of the client does not advance the client and is not paid
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	updateMsg, err := clienttypes.NewMsgUpdateClient(suite.path.EndpointA.ClientID, header, relayer.String())
+	suite.Require().NoError(err)
+
+	_, err = suite.chainA.SendMsgs(updateMsg)
+	suite.Require().NoError(err)
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBal.Add(defaultTryFee[0]), balance)
+
+	// the last incentivized update is paid once the refresh interval has elapsed
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayer, sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBal.Add(defaultTryFee[0]).Add(defaultTryFee[0]), balance)
+
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetClientUpdateFees(suite.chainA.GetContext(), suite.path.EndpointA.ClientID)
+	suite.Require().False(found)
+}
+
+func (suite *PostTestSuite) TestPostHandleRequiresAnteHandle() {
+	decorator := post.NewIncentivizedMsgsDecorator(suite.chainA.GetSimApp().IBCFeeKeeper, suite.chainA.GetSimApp().IBCKeeper.ClientKeeper)
+
+	updateMsg := &clienttypes.MsgUpdateClient{ClientId: suite.path.EndpointA.ClientID, Signer: suite.chainA.SenderAccount.GetAddress().String()}
+	txBuilder := suite.chainA.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(updateMsg))
+	tx := txBuilder.GetTx()
+
+	postHandler := func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		return decorator.PostHandle(ctx, tx, simulate, success, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+			return ctx, nil
+		})
+	}
+
+	// the post handler fails if the latest client heights were not recorded by the ante handler
+	_, err := postHandler(suite.chainA.GetContext(), tx, false, true)
+	suite.Require().ErrorIs(err, ibcerrors.ErrLogic)
+
+	_, err = decorator.AnteHandle(suite.chainA.GetContext(), tx, false, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return postHandler(ctx, tx, simulate, true)
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *PostTestSuite) TestChannelUpgradeFees() {
+	fee := types.NewUpgradeFee(defaultTryFee, defaultAckFee, defaultConfirmFee, defaultOpenFee)
+
+	suite.path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version
+	suite.path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version
+
+	suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit())
+
+	// escrow the fees for the upgrade on both chains
+	upgradeSequence := suite.path.EndpointA.GetChannel().UpgradeSequence
+	for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+		refundAcc := endpoint.Chain.SenderAccounts[1].SenderAccount.GetAddress()
+		msg := types.NewMsgPayChannelUpgradeFee(endpoint.ChannelConfig.PortID, endpoint.ChannelID, upgradeSequence, fee, refundAcc.String())
+		_, err := endpoint.Chain.GetSimApp().IBCFeeKeeper.PayChannelUpgradeFee(endpoint.Chain.GetContext(), msg)
+		suite.Require().NoError(err)
+	}
+
+	relayerBalA := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	refundAccBalA := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	relayerBalB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	refundAccBalB := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	suite.Require().NoError(suite.path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(suite.path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(suite.path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(suite.path.EndpointA.ChanUpgradeOpen())
+
+	// chain A pays the ack and open fees and refunds the try and confirm fees once the upgrade completes
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBalA.Add(defaultAckFee[0]).Add(defaultOpenFee[0]), balance)
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(refundAccBalA.Add(defaultTryFee[0]).Add(defaultConfirmFee[0]), balance)
+
+	// chain B pays the try and confirm fees and refunds the ack and open fees once the upgrade completes
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBalB.Add(defaultTryFee[0]).Add(defaultConfirmFee[0]), balance)
+
+	balance = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(refundAccBalB.Add(defaultAckFee[0]).Add(defaultOpenFee[0]), balance)
+
+	for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+		_, found := endpoint.Chain.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, upgradeSequence)
+		suite.Require().False(found)
+	}
+}
+
+func (suite *PostTestSuite) TestChannelUpgradeFeesRefundedOnAbort() {
+	fee := types.NewUpgradeFee(defaultTryFee, defaultAckFee, defaultConfirmFee, defaultOpenFee)
+
+	suite.path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version
+	suite.path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.Version
+
+	suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit())
+
+	upgradeSequence := suite.path.EndpointA.GetChannel().UpgradeSequence
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	msg := types.NewMsgPayChannelUpgradeFee(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence, fee, refundAcc.String())
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayChannelUpgradeFee(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	relayerBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+
+	suite.Require().NoError(suite.path.EndpointB.ChanUpgradeTry())
+
+	// force core IBC to abort the upgrade on ack by modifying the upgrade ordering to an incompatible value
+	upgrade := suite.path.EndpointA.GetChannelUpgrade()
+	upgrade.Fields.Ordering = channeltypes.NONE
+	suite.path.EndpointA.SetChannelUpgrade(upgrade)
+
+	suite.Require().NoError(suite.path.EndpointA.ChanUpgradeAck())
+
+	errorReceipt, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetUpgradeErrorReceipt(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(upgradeSequence, errorReceipt.Sequence)
+
+	// the ack fee is not paid and the fees escrowed for the aborted upgrade are refunded
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(relayerBal, balance)
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+	suite.Require().Equal(refundAccBal.Add(fee.Total()[0]), balance)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelUpgradeFees(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, upgradeSequence)
+	suite.Require().False(found)
+}
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgPayClientUpdateFee{}, "cosmos-sdk/MsgPayClientUpdateFee")
+	legacy.RegisterAminoMsg(cdc, &MsgPayChannelUpgradeFee{}, "cosmos-sdk/MsgPayChannelUpgradeFee")
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -26,6 +28,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgRecoverLockedFeeModule{},
+		&MsgUpdateMinimumFee{},
+		&MsgPayClientUpdateFee{},
+		&MsgPayChannelUpgradeFee{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -38,6 +38,16 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgRegisterCounterpartyPayee{}),
 			true,
 		},
+		{
+			"success: MsgPayClientUpdateFee",
+			sdk.MsgTypeURL(&types.MsgPayClientUpdateFee{}),
+			true,
+		},
+		{
+			"success: MsgPayChannelUpgradeFee",
+			sdk.MsgTypeURL(&types.MsgPayChannelUpgradeFee{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
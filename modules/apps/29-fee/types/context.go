@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// packetCallbackKey is the context key marking the execution of a packet callback of the fee middleware.
+type packetCallbackKey struct{}
+
+// WithinPacketCallback returns a context marking the execution of a packet callback. Packets sent by the underlying
+// application while processing the callback, such as forwarded ICS-20 packets, are not initiated by a user.
+func WithinPacketCallback(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(packetCallbackKey{}, true)
+}
+
+// IsUserInitiatedSend returns true if a packet sent with the provided context is initiated by a user transaction.
+// Packets sent while processing a packet callback, or outside of a transaction such as in a begin or end blocker,
+// are sent by an application, in which case the fees for the packet cannot be paid before it is sent.
+func IsUserInitiatedSend(ctx sdk.Context) bool {
+	if len(ctx.TxBytes()) == 0 {
+		return false
+	}
+
+	withinPacketCallback, _ := ctx.Value(packetCallbackKey{}).(bool)
+	return !withinPacketCallback
+}
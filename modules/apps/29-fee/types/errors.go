@@ -17,4 +17,14 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrInvalidPayeeShares            = errorsmod.Register(ModuleName, 13, "invalid payee shares")
+	ErrFeeModuleNotLocked            = errorsmod.Register(ModuleName, 14, "the fee module is not locked")
+	ErrEscrowDeficit                 = errorsmod.Register(ModuleName, 15, "fee module account balance is less than the fees in escrow")
+	ErrMinimumFeeNotMet              = errorsmod.Register(ModuleName, 16, "escrowed packet fees do not meet the minimum fee")
+	ErrInvalidUpgradeSequence        = errorsmod.Register(ModuleName, 17, "invalid channel upgrade sequence")
+	ErrClientUpdateFeeExpired        = errorsmod.Register(ModuleName, 18, "client update fee expiry has already passed")
+	ErrInvalidPayeeStatistics        = errorsmod.Register(ModuleName, 19, "invalid payee statistics")
+	ErrPayeeNotFound                 = errorsmod.Register(ModuleName, 20, "payee not found")
+	ErrMultiplePayees                = errorsmod.Register(ModuleName, 21, "fees are split between multiple payees")
+	ErrTooManyClientUpdateFees       = errorsmod.Register(ModuleName, 22, "too many client update fees escrowed for client")
 )
@@ -2,10 +2,14 @@ package types
 
 // 29-fee events
 const (
-	EventTypeIncentivizedPacket        = "incentivized_ibc_packet"
-	EventTypeRegisterPayee             = "register_payee"
-	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
-	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeIncentivizedPacket         = "incentivized_ibc_packet"
+	EventTypeRegisterPayee              = "register_payee"
+	EventTypeRegisterCounterpartyPayee  = "register_counterparty_payee"
+	EventTypeDistributeFee              = "distribute_fee"
+	EventTypeRecoverFeeModule           = "recover_fee_module"
+	EventTypeUpdateMinimumFee           = "update_minimum_fee"
+	EventTypeIncentivizedClientUpdate   = "incentivized_client_update"
+	EventTypeIncentivizedChannelUpgrade = "incentivized_channel_upgrade"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -16,4 +20,12 @@ const (
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyFunder            = "funder"
+	AttributeKeyDeficit           = "deficit"
+	AttributeKeyMaxUpdates        = "max_updates"
+	AttributeKeyRefreshInterval   = "refresh_interval"
+	AttributeKeyExpiryTimestamp   = "expiry_timestamp"
+	AttributeKeyTryFee            = "try_fee"
+	AttributeKeyConfirmFee        = "confirm_fee"
+	AttributeKeyOpenFee           = "open_fee"
 )
@@ -4,10 +4,12 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
@@ -67,6 +69,17 @@ func (f Fee) Total() sdk.Coins {
 	return f.RecvFee.Add(f.AckFee...).Max(f.TimeoutFee)
 }
 
+// IsZero returns true if the receive, acknowledgement and timeout fees are all zero or empty
+func (f Fee) IsZero() bool {
+	return f.RecvFee.IsZero() && f.AckFee.IsZero() && f.TimeoutFee.IsZero()
+}
+
+// IsAllGTE returns true if each of the receive, acknowledgement and timeout fees is greater than or equal to
+// the corresponding fee of the provided minimum fee, for every denomination of the minimum fee
+func (f Fee) IsAllGTE(minimumFee Fee) bool {
+	return f.RecvFee.IsAllGTE(minimumFee.RecvFee) && f.AckFee.IsAllGTE(minimumFee.AckFee) && f.TimeoutFee.IsAllGTE(minimumFee.TimeoutFee)
+}
+
 // Validate asserts that each Fee is valid and all three Fees are not empty or zero
 func (f Fee) Validate() error {
 	var errFees []string
@@ -91,3 +104,224 @@ func (f Fee) Validate() error {
 
 	return nil
 }
+
+// NewMinimumFee creates and returns a new MinimumFee struct for the given port and channel identifiers.
+// An empty channel identifier defines the minimum fee of all channels of the port.
+func NewMinimumFee(portID, channelID string, fee Fee) MinimumFee {
+	return MinimumFee{
+		PortId:    portID,
+		ChannelId: channelID,
+		Fee:       fee,
+	}
+}
+
+// Validate performs basic stateless validation of the associated MinimumFee
+func (m MinimumFee) Validate() error {
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if m.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid channel ID")
+		}
+	}
+
+	return m.Fee.Validate()
+}
+
+// MaxClientUpdateFees is the maximum number of client update fees which may be escrowed for the updates of a single client
+const MaxClientUpdateFees = 100
+
+// NewClientUpdateFee creates and returns a new ClientUpdateFee struct paying the fee for up to maxUpdates client updates
+// relayed at least refreshInterval nanoseconds apart, before the expiry timestamp
+func NewClientUpdateFee(fee sdk.Coins, refundAddr string, maxUpdates, refreshInterval, expiryTimestamp uint64) ClientUpdateFee {
+	return ClientUpdateFee{
+		Fee:              fee,
+		RefundAddress:    refundAddr,
+		RemainingUpdates: maxUpdates,
+		RefreshInterval:  refreshInterval,
+		ExpiryTimestamp:  expiryTimestamp,
+	}
+}
+
+// Total returns the total amount escrowed for the remaining client updates
+func (f ClientUpdateFee) Total() sdk.Coins {
+	return f.Fee.MulInt(sdkmath.NewIntFromUint64(f.RemainingUpdates))
+}
+
+// IsExpired returns true if the provided block time in nanoseconds has reached the expiry timestamp
+func (f ClientUpdateFee) IsExpired(blockTime uint64) bool {
+	return blockTime >= f.ExpiryTimestamp
+}
+
+// IsRefreshed returns true if at least the refresh interval has elapsed since the last paid client update
+func (f ClientUpdateFee) IsRefreshed(blockTime uint64) bool {
+	return f.LastPaidTimestamp == 0 || blockTime >= f.LastPaidTimestamp+f.RefreshInterval
+}
+
+// Validate performs basic stateless validation of the associated ClientUpdateFee
+func (f ClientUpdateFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.RefundAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	if !f.Fee.IsValid() || f.Fee.IsZero() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "client update fee must be valid and non-zero: %s", f.Fee)
+	}
+
+	if f.RemainingUpdates == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "remaining client updates must be greater than zero")
+	}
+
+	if f.ExpiryTimestamp == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "expiry timestamp must be greater than zero")
+	}
+
+	return nil
+}
+
+// NewClientUpdateFees creates and returns a new ClientUpdateFees struct including a list of type ClientUpdateFee
+func NewClientUpdateFees(clientUpdateFees []ClientUpdateFee) ClientUpdateFees {
+	return ClientUpdateFees{
+		ClientUpdateFees: clientUpdateFees,
+	}
+}
+
+// NewIdentifiedClientUpdateFees creates and returns a new IdentifiedClientUpdateFees struct containing a client
+// identifier and client update fees
+func NewIdentifiedClientUpdateFees(clientID string, clientUpdateFees []ClientUpdateFee) IdentifiedClientUpdateFees {
+	return IdentifiedClientUpdateFees{
+		ClientId:         clientID,
+		ClientUpdateFees: clientUpdateFees,
+	}
+}
+
+// UpgradeStep defines a message of the channel upgrade handshake which may be incentivized
+type UpgradeStep int
+
+const (
+	// UpgradeStepTry is the channel upgrade try step
+	UpgradeStepTry UpgradeStep = iota + 1
+	// UpgradeStepAck is the channel upgrade acknowledgement step
+	UpgradeStepAck
+	// UpgradeStepConfirm is the channel upgrade confirm step
+	UpgradeStepConfirm
+	// UpgradeStepOpen is the channel upgrade open step
+	UpgradeStepOpen
+)
+
+// NewUpgradeFee creates and returns a new UpgradeFee struct encapsulating the try, ack, confirm and open fees as sdk.Coins
+func NewUpgradeFee(tryFee, ackFee, confirmFee, openFee sdk.Coins) UpgradeFee {
+	return UpgradeFee{
+		TryFee:     tryFee,
+		AckFee:     ackFee,
+		ConfirmFee: confirmFee,
+		OpenFee:    openFee,
+	}
+}
+
+// Total returns the total amount for a given UpgradeFee. Each step of the handshake may be
+// relayed to the same chain, so the total amount is the sum of the fees of all the steps.
+func (f UpgradeFee) Total() sdk.Coins {
+	return f.TryFee.Add(f.AckFee...).Add(f.ConfirmFee...).Add(f.OpenFee...)
+}
+
+// IsZero returns true if the try, ack, confirm and open fees are all zero or empty
+func (f UpgradeFee) IsZero() bool {
+	return f.TryFee.IsZero() && f.AckFee.IsZero() && f.ConfirmFee.IsZero() && f.OpenFee.IsZero()
+}
+
+// StepFee returns the fee paid to the relayer of the given step of the channel upgrade handshake
+func (f UpgradeFee) StepFee(step UpgradeStep) sdk.Coins {
+	switch step {
+	case UpgradeStepTry:
+		return f.TryFee
+	case UpgradeStepAck:
+		return f.AckFee
+	case UpgradeStepConfirm:
+		return f.ConfirmFee
+	case UpgradeStepOpen:
+		return f.OpenFee
+	default:
+		return nil
+	}
+}
+
+// WithoutStepFee returns a copy of the UpgradeFee in which the fee of the given step is removed
+func (f UpgradeFee) WithoutStepFee(step UpgradeStep) UpgradeFee {
+	switch step {
+	case UpgradeStepTry:
+		f.TryFee = nil
+	case UpgradeStepAck:
+		f.AckFee = nil
+	case UpgradeStepConfirm:
+		f.ConfirmFee = nil
+	case UpgradeStepOpen:
+		f.OpenFee = nil
+	}
+
+	return f
+}
+
+// Validate asserts that each fee is valid and the try, ack, confirm and open fees are not all empty or zero
+func (f UpgradeFee) Validate() error {
+	var errFees []string
+	if !f.TryFee.IsValid() {
+		errFees = append(errFees, "try fee invalid")
+	}
+	if !f.AckFee.IsValid() {
+		errFees = append(errFees, "ack fee invalid")
+	}
+	if !f.ConfirmFee.IsValid() {
+		errFees = append(errFees, "confirm fee invalid")
+	}
+	if !f.OpenFee.IsValid() {
+		errFees = append(errFees, "open fee invalid")
+	}
+
+	if len(errFees) > 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "contains invalid fees: %s", strings.Join(errFees, " , "))
+	}
+
+	if f.IsZero() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "all fees are zero")
+	}
+
+	return nil
+}
+
+// NewChannelUpgradeFee creates and returns a new ChannelUpgradeFee struct including the incentivization fees and refund address
+func NewChannelUpgradeFee(fee UpgradeFee, refundAddr string) ChannelUpgradeFee {
+	return ChannelUpgradeFee{
+		Fee:           fee,
+		RefundAddress: refundAddr,
+	}
+}
+
+// Validate performs basic stateless validation of the associated ChannelUpgradeFee
+func (f ChannelUpgradeFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(f.RefundAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to convert RefundAddress into sdk.AccAddress")
+	}
+
+	return f.Fee.Validate()
+}
+
+// NewChannelUpgradeFees creates and returns a new ChannelUpgradeFees struct including a list of type ChannelUpgradeFee
+func NewChannelUpgradeFees(channelUpgradeFees []ChannelUpgradeFee) ChannelUpgradeFees {
+	return ChannelUpgradeFees{
+		ChannelUpgradeFees: channelUpgradeFees,
+	}
+}
+
+// NewIdentifiedChannelUpgradeFees creates and returns a new IdentifiedChannelUpgradeFees struct containing the port
+// and channel identifiers, the upgrade sequence and the channel upgrade fees
+func NewIdentifiedChannelUpgradeFees(portID, channelID string, upgradeSequence uint64, channelUpgradeFees []ChannelUpgradeFee) IdentifiedChannelUpgradeFees {
+	return IdentifiedChannelUpgradeFees{
+		PortId:             portID,
+		ChannelId:          channelID,
+		UpgradeSequence:    upgradeSequence,
+		ChannelUpgradeFees: channelUpgradeFees,
+	}
+}
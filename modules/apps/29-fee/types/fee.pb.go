@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types2 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	types1 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	io "io"
 	math "math"
@@ -257,348 +259,3292 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
-	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
-	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
-	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+// PayeeShare defines a payee receiving a weighted share of the fees paid out to a relayer
+type PayeeShare struct {
+	// the payee address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the weight of the payee, relative to the sum of the weights of all the payees of the relayer
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
+func (m *PayeeShare) Reset()         { *m = PayeeShare{} }
+func (m *PayeeShare) String() string { return proto.CompactTextString(m) }
+func (*PayeeShare) ProtoMessage()    {}
+func (*PayeeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *PayeeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayeeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayeeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayeeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayeeShare.Merge(m, src)
+}
+func (m *PayeeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayeeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayeeShare.DiscardUnknown(m)
+}
 
-var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x12, 0x30, 0xd1, 0x20, 0x45, 0xca, 0x04, 0xca, 0x82, 0xc4, 0x12,
-	0x9d, 0xcf, 0x2f, 0xee, 0x29, 0xb6, 0xcf, 0xf2, 0xd9, 0x41, 0x19, 0x58, 0xf8, 0x04, 0xac, 0xb0,
-	0xb2, 0x31, 0xf5, 0x63, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x19, 0xfa, 0x05, 0xf8, 0x00, 0xe8, 0x9d,
-	0x0f, 0xab, 0x2a, 0xea, 0xc2, 0xd0, 0xc5, 0x77, 0xef, 0xde, 0xbb, 0xf7, 0xfb, 0xdf, 0xbb, 0xe7,
-	0x23, 0x0f, 0x65, 0x20, 0x18, 0xcf, 0xb2, 0x58, 0x0a, 0x5e, 0x48, 0x95, 0x6a, 0x36, 0x03, 0x60,
-	0x8b, 0x3e, 0x0e, 0x34, 0xcb, 0x55, 0xa1, 0xdc, 0x1d, 0x19, 0x08, 0x7a, 0x31, 0x84, 0xa2, 0x6f,
-	0xd1, 0xdf, 0xbb, 0xc3, 0x13, 0x99, 0x2a, 0x66, 0xbe, 0x55, 0xec, 0x9e, 0x27, 0x94, 0x4e, 0x94,
-	0x66, 0x01, 0xd7, 0x98, 0x25, 0x80, 0x82, 0xf7, 0x99, 0x50, 0x32, 0xb5, 0xfe, 0xed, 0x48, 0x45,
-	0xca, 0x4c, 0x19, 0xce, 0xec, 0xaa, 0x11, 0x21, 0x54, 0x0e, 0x4c, 0x1c, 0xf3, 0x34, 0x85, 0x18,
-	0x05, 0xd8, 0xa9, 0x0d, 0xd9, 0xb1, 0x89, 0x13, 0x1d, 0xa1, 0x33, 0xd1, 0x51, 0xe5, 0xf0, 0x7f,
-	0x37, 0x49, 0x6b, 0x04, 0xe0, 0xbe, 0x27, 0x5b, 0x39, 0x88, 0xc5, 0x74, 0x06, 0xb0, 0xeb, 0x1c,
-	0xb4, 0xba, 0x9d, 0xc1, 0x3d, 0x5a, 0xed, 0xa1, 0x28, 0x86, 0x5a, 0x31, 0xf4, 0x95, 0x92, 0xe9,
-	0xf0, 0xe8, 0xf4, 0xc7, 0x7e, 0xe3, 0xdb, 0xcf, 0xfd, 0x6e, 0x24, 0x8b, 0xe3, 0x32, 0xa0, 0x42,
-	0x25, 0xcc, 0x02, 0xaa, 0xa1, 0xa7, 0xc3, 0x39, 0x2b, 0x96, 0x19, 0x68, 0xb3, 0x41, 0x7f, 0x39,
-	0x3f, 0x39, 0xbc, 0x19, 0x43, 0xc4, 0xc5, 0x72, 0x8a, 0xc7, 0xd1, 0x93, 0x4d, 0xa4, 0x21, 0xb8,
-	0x24, 0x9b, 0x5c, 0xcc, 0x0d, 0xb7, 0x79, 0x0d, 0xdc, 0x0d, 0x2e, 0xe6, 0x88, 0xfd, 0x40, 0x3a,
-	0x85, 0x4c, 0x40, 0x95, 0x85, 0x41, 0xb7, 0xae, 0x01, 0x4d, 0x2c, 0x70, 0x04, 0xe0, 0x7f, 0x76,
-	0x48, 0xfb, 0x0d, 0x17, 0x73, 0x40, 0xcb, 0x7d, 0x42, 0x5a, 0x55, 0xdd, 0x9d, 0x6e, 0x67, 0x70,
-	0x9f, 0x5e, 0xd1, 0x30, 0x74, 0x04, 0x30, 0xbc, 0x81, 0x3a, 0x26, 0x18, 0xee, 0x3e, 0x22, 0xb7,
-	0x73, 0x98, 0x95, 0x69, 0x38, 0xe5, 0x61, 0x98, 0x83, 0xd6, 0xbb, 0xcd, 0x03, 0xa7, 0xdb, 0x9e,
-	0xdc, 0xaa, 0x56, 0x8f, 0xaa, 0x45, 0x77, 0x0f, 0x6f, 0x36, 0xe6, 0x4b, 0xc8, 0xb5, 0x39, 0x66,
-	0x7b, 0x52, 0xdb, 0x2f, 0xee, 0x7e, 0x3c, 0x3f, 0x39, 0xbc, 0x94, 0xc5, 0x7f, 0x4b, 0x48, 0x2d,
-	0x4d, 0xbb, 0x63, 0xd2, 0xc9, 0x8c, 0x85, 0x75, 0xd2, 0xb6, 0x37, 0xfc, 0x2b, 0x35, 0xd6, 0x3b,
-	0xad, 0x52, 0x92, 0xd5, 0xa9, 0xfc, 0xaf, 0x0e, 0xd9, 0x1e, 0x87, 0x90, 0x16, 0x72, 0x26, 0x21,
-	0xbc, 0xc0, 0x78, 0x49, 0xda, 0x96, 0x21, 0x43, 0x5b, 0x85, 0x07, 0x86, 0x80, 0x4d, 0x4d, 0xff,
-	0x76, 0x72, 0x9d, 0x7d, 0x1c, 0xda, 0xe4, 0x5b, 0x99, 0xb5, 0x2f, 0xab, 0x6c, 0xfe, 0xbf, 0xca,
-	0xe1, 0xeb, 0xd3, 0x95, 0xe7, 0x9c, 0xad, 0x3c, 0xe7, 0xd7, 0xca, 0x73, 0x3e, 0xad, 0xbd, 0xc6,
-	0xd9, 0xda, 0x6b, 0x7c, 0x5f, 0x7b, 0x8d, 0x77, 0x4f, 0xff, 0xbd, 0x7b, 0x19, 0x88, 0x5e, 0xa4,
-	0xd8, 0xe2, 0x19, 0x4b, 0x54, 0x58, 0xc6, 0xa0, 0xf1, 0x31, 0xd0, 0x6c, 0xf0, 0xbc, 0x87, 0xef,
-	0x80, 0x69, 0x87, 0x60, 0xc3, 0xfc, 0x69, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x53,
-	0x6e, 0x63, 0x2c, 0x04, 0x00, 0x00,
+var xxx_messageInfo_PayeeShare proto.InternalMessageInfo
+
+func (m *PayeeShare) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *Fee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PayeeShare) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PayeeShares contains a list of type PayeeShare
+type PayeeShares struct {
+	// list of payee shares
+	PayeeShares []PayeeShare `protobuf:"bytes,1,rep,name=payee_shares,json=payeeShares,proto3" json:"payee_shares"`
 }
 
-func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TimeoutFee) > 0 {
-		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.AckFee) > 0 {
-		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.RecvFee) > 0 {
-		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PayeeShares) Reset()         { *m = PayeeShares{} }
+func (m *PayeeShares) String() string { return proto.CompactTextString(m) }
+func (*PayeeShares) ProtoMessage()    {}
+func (*PayeeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *PayeeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayeeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayeeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PayeeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayeeShares.Merge(m, src)
+}
+func (m *PayeeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayeeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayeeShares.DiscardUnknown(m)
 }
 
-func (m *PacketFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PayeeShares proto.InternalMessageInfo
+
+func (m *PayeeShares) GetPayeeShares() []PayeeShare {
+	if m != nil {
+		return m.PayeeShares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClientUpdateFee defines the fees escrowed to incentivize the relaying of the updates of a light client
+type ClientUpdateFee struct {
+	// the fee paid to the relayer of each incentivized client update
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// the refund address for unspent fees
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+	// the number of client updates which remain to be paid
+	RemainingUpdates uint64 `protobuf:"varint,3,opt,name=remaining_updates,json=remainingUpdates,proto3" json:"remaining_updates,omitempty"`
+	// the minimum time in nanoseconds between two paid client updates
+	RefreshInterval uint64 `protobuf:"varint,4,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// the block timestamp in nanoseconds after which the unspent fees are refunded
+	ExpiryTimestamp uint64 `protobuf:"varint,5,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	// the block timestamp in nanoseconds of the last paid client update
+	LastPaidTimestamp uint64 `protobuf:"varint,6,opt,name=last_paid_timestamp,json=lastPaidTimestamp,proto3" json:"last_paid_timestamp,omitempty"`
+	// the latest height of the client after the last paid client update
+	LastPaidHeight types2.Height `protobuf:"bytes,7,opt,name=last_paid_height,json=lastPaidHeight,proto3" json:"last_paid_height"`
 }
 
-func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintFee(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.RefundAddress) > 0 {
-		i -= len(m.RefundAddress)
-		copy(dAtA[i:], m.RefundAddress)
-		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *ClientUpdateFee) Reset()         { *m = ClientUpdateFee{} }
+func (m *ClientUpdateFee) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateFee) ProtoMessage()    {}
+func (*ClientUpdateFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *ClientUpdateFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFee(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ClientUpdateFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateFee.Merge(m, src)
+}
+func (m *ClientUpdateFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateFee.DiscardUnknown(m)
 }
 
-func (m *PacketFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClientUpdateFee proto.InternalMessageInfo
+
+func (m *ClientUpdateFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClientUpdateFee) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
 }
 
-func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ClientUpdateFee) GetRemainingUpdates() uint64 {
+	if m != nil {
+		return m.RemainingUpdates
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IdentifiedPacketFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClientUpdateFee) GetRefreshInterval() uint64 {
+	if m != nil {
+		return m.RefreshInterval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IdentifiedPacketFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClientUpdateFee) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
 }
 
-func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFee(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *ClientUpdateFee) GetLastPaidTimestamp() uint64 {
+	if m != nil {
+		return m.LastPaidTimestamp
 	}
-	{
-		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *ClientUpdateFee) GetLastPaidHeight() types2.Height {
+	if m != nil {
+		return m.LastPaidHeight
+	}
+	return types2.Height{}
+}
+
+// ClientUpdateFees contains a list of type ClientUpdateFee
+type ClientUpdateFees struct {
+	// list of client update fees
+	ClientUpdateFees []ClientUpdateFee `protobuf:"bytes,1,rep,name=client_update_fees,json=clientUpdateFees,proto3" json:"client_update_fees"`
+}
+
+func (m *ClientUpdateFees) Reset()         { *m = ClientUpdateFees{} }
+func (m *ClientUpdateFees) String() string { return proto.CompactTextString(m) }
+func (*ClientUpdateFees) ProtoMessage()    {}
+func (*ClientUpdateFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{7}
+}
+func (m *ClientUpdateFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientUpdateFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientUpdateFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintFee(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ClientUpdateFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientUpdateFees.Merge(m, src)
+}
+func (m *ClientUpdateFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientUpdateFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientUpdateFees.DiscardUnknown(m)
 }
 
-func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFee(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ClientUpdateFees proto.InternalMessageInfo
+
+func (m *ClientUpdateFees) GetClientUpdateFees() []ClientUpdateFee {
+	if m != nil {
+		return m.ClientUpdateFees
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Fee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RecvFee) > 0 {
-		for _, e := range m.RecvFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+
+// IdentifiedClientUpdateFees contains a list of type ClientUpdateFee and the associated client identifier
+type IdentifiedClientUpdateFees struct {
+	// unique client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// list of client update fees
+	ClientUpdateFees []ClientUpdateFee `protobuf:"bytes,2,rep,name=client_update_fees,json=clientUpdateFees,proto3" json:"client_update_fees"`
+}
+
+func (m *IdentifiedClientUpdateFees) Reset()         { *m = IdentifiedClientUpdateFees{} }
+func (m *IdentifiedClientUpdateFees) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientUpdateFees) ProtoMessage()    {}
+func (*IdentifiedClientUpdateFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{8}
+}
+func (m *IdentifiedClientUpdateFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientUpdateFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientUpdateFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AckFee) > 0 {
-		for _, e := range m.AckFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+}
+func (m *IdentifiedClientUpdateFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientUpdateFees.Merge(m, src)
+}
+func (m *IdentifiedClientUpdateFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientUpdateFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientUpdateFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientUpdateFees proto.InternalMessageInfo
+
+func (m *IdentifiedClientUpdateFees) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientUpdateFees) GetClientUpdateFees() []ClientUpdateFee {
+	if m != nil {
+		return m.ClientUpdateFees
+	}
+	return nil
+}
+
+// UpgradeFee defines the fees paid to the relayers of the messages of a channel upgrade handshake
+type UpgradeFee struct {
+	// the channel upgrade try fee
+	TryFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=try_fee,json=tryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"try_fee"`
+	// the channel upgrade acknowledgement fee
+	AckFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=ack_fee,json=ackFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_fee"`
+	// the channel upgrade confirm fee
+	ConfirmFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=confirm_fee,json=confirmFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"confirm_fee"`
+	// the channel upgrade open fee
+	OpenFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=open_fee,json=openFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"open_fee"`
+}
+
+func (m *UpgradeFee) Reset()         { *m = UpgradeFee{} }
+func (m *UpgradeFee) String() string { return proto.CompactTextString(m) }
+func (*UpgradeFee) ProtoMessage()    {}
+func (*UpgradeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{9}
+}
+func (m *UpgradeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TimeoutFee) > 0 {
-		for _, e := range m.TimeoutFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+}
+func (m *UpgradeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeFee.Merge(m, src)
+}
+func (m *UpgradeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeFee proto.InternalMessageInfo
+
+func (m *UpgradeFee) GetTryFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TryFee
+	}
+	return nil
+}
+
+func (m *UpgradeFee) GetAckFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckFee
+	}
+	return nil
+}
+
+func (m *UpgradeFee) GetConfirmFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConfirmFee
+	}
+	return nil
+}
+
+func (m *UpgradeFee) GetOpenFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OpenFee
+	}
+	return nil
+}
+
+// ChannelUpgradeFee defines the fees escrowed to incentivize the relaying of a channel upgrade handshake
+type ChannelUpgradeFee struct {
+	// fee encapsulates the try, ack, confirm and open fees of the channel upgrade handshake
+	Fee UpgradeFee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// the refund address for unspent fees
+	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *ChannelUpgradeFee) Reset()         { *m = ChannelUpgradeFee{} }
+func (m *ChannelUpgradeFee) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeFee) ProtoMessage()    {}
+func (*ChannelUpgradeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{10}
+}
+func (m *ChannelUpgradeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ChannelUpgradeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeFee.Merge(m, src)
+}
+func (m *ChannelUpgradeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeFee.DiscardUnknown(m)
 }
 
-func (m *PacketFee) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ChannelUpgradeFee proto.InternalMessageInfo
+
+func (m *ChannelUpgradeFee) GetFee() UpgradeFee {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovFee(uint64(l))
-	l = len(m.RefundAddress)
-	if l > 0 {
-		n += 1 + l + sovFee(uint64(l))
+	return UpgradeFee{}
+}
+
+func (m *ChannelUpgradeFee) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovFee(uint64(l))
+	return ""
+}
+
+// ChannelUpgradeFees contains a list of type ChannelUpgradeFee
+type ChannelUpgradeFees struct {
+	// list of channel upgrade fees
+	ChannelUpgradeFees []ChannelUpgradeFee `protobuf:"bytes,1,rep,name=channel_upgrade_fees,json=channelUpgradeFees,proto3" json:"channel_upgrade_fees"`
+}
+
+func (m *ChannelUpgradeFees) Reset()         { *m = ChannelUpgradeFees{} }
+func (m *ChannelUpgradeFees) String() string { return proto.CompactTextString(m) }
+func (*ChannelUpgradeFees) ProtoMessage()    {}
+func (*ChannelUpgradeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{11}
+}
+func (m *ChannelUpgradeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelUpgradeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelUpgradeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ChannelUpgradeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelUpgradeFees.Merge(m, src)
+}
+func (m *ChannelUpgradeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelUpgradeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelUpgradeFees.DiscardUnknown(m)
 }
 
-func (m *PacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ChannelUpgradeFees proto.InternalMessageInfo
+
+func (m *ChannelUpgradeFees) GetChannelUpgradeFees() []ChannelUpgradeFee {
+	if m != nil {
+		return m.ChannelUpgradeFees
 	}
-	var l int
-	_ = l
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	return nil
+}
+
+// IdentifiedChannelUpgradeFees contains a list of type ChannelUpgradeFee and the associated channel upgrade
+type IdentifiedChannelUpgradeFees struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the incentivized channel upgrade
+	UpgradeSequence uint64 `protobuf:"varint,3,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// list of channel upgrade fees
+	ChannelUpgradeFees []ChannelUpgradeFee `protobuf:"bytes,4,rep,name=channel_upgrade_fees,json=channelUpgradeFees,proto3" json:"channel_upgrade_fees"`
+}
+
+func (m *IdentifiedChannelUpgradeFees) Reset()         { *m = IdentifiedChannelUpgradeFees{} }
+func (m *IdentifiedChannelUpgradeFees) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedChannelUpgradeFees) ProtoMessage()    {}
+func (*IdentifiedChannelUpgradeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{12}
+}
+func (m *IdentifiedChannelUpgradeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedChannelUpgradeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedChannelUpgradeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *IdentifiedChannelUpgradeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedChannelUpgradeFees.Merge(m, src)
+}
+func (m *IdentifiedChannelUpgradeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedChannelUpgradeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedChannelUpgradeFees.DiscardUnknown(m)
 }
 
-func (m *IdentifiedPacketFees) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_IdentifiedChannelUpgradeFees proto.InternalMessageInfo
+
+func (m *IdentifiedChannelUpgradeFees) GetPortId() string {
+	if m != nil {
+		return m.PortId
 	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovFee(uint64(l))
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	return ""
+}
+
+func (m *IdentifiedChannelUpgradeFees) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IdentifiedChannelUpgradeFees) GetUpgradeSequence() uint64 {
+	if m != nil {
+		return m.UpgradeSequence
+	}
+	return 0
+}
+
+func (m *IdentifiedChannelUpgradeFees) GetChannelUpgradeFees() []ChannelUpgradeFee {
+	if m != nil {
+		return m.ChannelUpgradeFees
+	}
+	return nil
+}
+
+// PayeeStatistics defines the statistics of the packets relayed over a channel for which a payee was paid fees, for the
+// fees of a single denomination, aggregated over all time or over a single time bucket. The relayers which earned the
+// fees are not recorded, the statistics of all the relayers sharing a payee are aggregated.
+type PayeeStatistics struct {
+	// the payee address
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the fee denomination
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the start of the time bucket in unix seconds, zero if the statistics are aggregated over all time
+	BucketStart uint64 `protobuf:"varint,4,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	// the number of packets for which the payee was paid the receive fee of the forward relayer
+	RecvPackets uint64 `protobuf:"varint,5,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty"`
+	// the number of packets for which the payee was paid a share of the acknowledgement fee of the reverse relayer
+	AckPackets uint64 `protobuf:"varint,6,opt,name=ack_packets,json=ackPackets,proto3" json:"ack_packets,omitempty"`
+	// the number of packets for which the payee was paid a share of the timeout fee of the timeout relayer
+	TimeoutPackets uint64 `protobuf:"varint,7,opt,name=timeout_packets,json=timeoutPackets,proto3" json:"timeout_packets,omitempty"`
+	// the total fees earned by the payee
+	FeesEarned cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=fees_earned,json=feesEarned,proto3,customtype=cosmossdk.io/math.Int" json:"fees_earned"`
+	// the share of the payee of the fees refunded to the payers of the packets acknowledged or timed out
+	FeesRefunded cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=fees_refunded,json=feesRefunded,proto3,customtype=cosmossdk.io/math.Int" json:"fees_refunded"`
+}
+
+func (m *PayeeStatistics) Reset()         { *m = PayeeStatistics{} }
+func (m *PayeeStatistics) String() string { return proto.CompactTextString(m) }
+func (*PayeeStatistics) ProtoMessage()    {}
+func (*PayeeStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{13}
+}
+func (m *PayeeStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayeeStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayeeStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
+func (m *PayeeStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayeeStatistics.Merge(m, src)
+}
+func (m *PayeeStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayeeStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayeeStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayeeStatistics proto.InternalMessageInfo
+
+func (m *PayeeStatistics) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *PayeeStatistics) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PayeeStatistics) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PayeeStatistics) GetBucketStart() uint64 {
+	if m != nil {
+		return m.BucketStart
+	}
+	return 0
+}
+
+func (m *PayeeStatistics) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *PayeeStatistics) GetAckPackets() uint64 {
+	if m != nil {
+		return m.AckPackets
+	}
+	return 0
+}
+
+func (m *PayeeStatistics) GetTimeoutPackets() uint64 {
+	if m != nil {
+		return m.TimeoutPackets
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
+	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
+	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
+	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*PayeeShare)(nil), "ibc.applications.fee.v1.PayeeShare")
+	proto.RegisterType((*PayeeShares)(nil), "ibc.applications.fee.v1.PayeeShares")
+	proto.RegisterType((*ClientUpdateFee)(nil), "ibc.applications.fee.v1.ClientUpdateFee")
+	proto.RegisterType((*ClientUpdateFees)(nil), "ibc.applications.fee.v1.ClientUpdateFees")
+	proto.RegisterType((*IdentifiedClientUpdateFees)(nil), "ibc.applications.fee.v1.IdentifiedClientUpdateFees")
+	proto.RegisterType((*UpgradeFee)(nil), "ibc.applications.fee.v1.UpgradeFee")
+	proto.RegisterType((*ChannelUpgradeFee)(nil), "ibc.applications.fee.v1.ChannelUpgradeFee")
+	proto.RegisterType((*ChannelUpgradeFees)(nil), "ibc.applications.fee.v1.ChannelUpgradeFees")
+	proto.RegisterType((*IdentifiedChannelUpgradeFees)(nil), "ibc.applications.fee.v1.IdentifiedChannelUpgradeFees")
+	proto.RegisterType((*PayeeStatistics)(nil), "ibc.applications.fee.v1.PayeeStatistics")
+}
+
+func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
+
+var fileDescriptor_cb3319f1af2a53e5 = []byte{
+	// 1105 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xcf, 0xda, 0xa9, 0x1d, 0x8f, 0xd3, 0x7c, 0xbc, 0x06, 0x62, 0x42, 0xe3, 0xb4, 0x8b, 0x10,
+	0x21, 0x28, 0xbb, 0x4a, 0x00, 0x89, 0x0f, 0xa9, 0xa2, 0x89, 0x88, 0x30, 0x42, 0xa2, 0x72, 0xa8,
+	0x90, 0x00, 0xc9, 0x7a, 0xde, 0x37, 0xb6, 0x9f, 0xec, 0xfd, 0x60, 0xdf, 0xb3, 0x53, 0x1f, 0xb8,
+	0xf0, 0x17, 0x70, 0x43, 0x70, 0xe5, 0x80, 0xc4, 0xa9, 0x7f, 0x46, 0x8f, 0x3d, 0x22, 0x0e, 0x05,
+	0x25, 0x42, 0xbd, 0x22, 0xc4, 0x1f, 0x80, 0xde, 0xc7, 0xda, 0x96, 0x23, 0x03, 0x15, 0xc5, 0x5c,
+	0x92, 0x9d, 0x79, 0xbf, 0x99, 0xdf, 0xcc, 0xec, 0xcc, 0x9b, 0x35, 0xdc, 0xe4, 0xcd, 0xc0, 0xa7,
+	0x49, 0xd2, 0xe3, 0x01, 0x95, 0x3c, 0x8e, 0x84, 0xdf, 0x42, 0xf4, 0x07, 0x07, 0xea, 0x9f, 0x97,
+	0xa4, 0xb1, 0x8c, 0xc9, 0x26, 0x6f, 0x06, 0xde, 0x24, 0xc4, 0x53, 0x67, 0x83, 0x83, 0xad, 0x75,
+	0x1a, 0xf2, 0x28, 0xf6, 0xf5, 0x5f, 0x83, 0xdd, 0xaa, 0x06, 0xb1, 0x08, 0x63, 0xe1, 0x37, 0xa9,
+	0x50, 0x5e, 0x9a, 0x28, 0xe9, 0x81, 0x1f, 0xc4, 0x3c, 0xb2, 0xe7, 0x1b, 0xed, 0xb8, 0x1d, 0xeb,
+	0x47, 0x5f, 0x3d, 0x59, 0xad, 0x0e, 0x22, 0x88, 0x53, 0xf4, 0x83, 0x0e, 0x8d, 0x22, 0xec, 0xa9,
+	0x00, 0xec, 0xa3, 0x85, 0xec, 0x8c, 0x21, 0x3d, 0x8e, 0x91, 0xd4, 0x08, 0xfd, 0x64, 0x01, 0x9b,
+	0x96, 0x39, 0x14, 0x6d, 0x75, 0x16, 0x8a, 0xb6, 0x39, 0x70, 0xff, 0xc8, 0x41, 0xfe, 0x04, 0x91,
+	0x9c, 0xc1, 0x52, 0x8a, 0xc1, 0xa0, 0xd1, 0x42, 0xac, 0x38, 0x37, 0xf2, 0xbb, 0xe5, 0xc3, 0xe7,
+	0x3c, 0x63, 0xe3, 0xa9, 0x68, 0x3d, 0x1b, 0xad, 0x77, 0x1c, 0xf3, 0xe8, 0xe8, 0xf6, 0x83, 0x47,
+	0x3b, 0x0b, 0x3f, 0xfc, 0xbc, 0xb3, 0xdb, 0xe6, 0xb2, 0xd3, 0x6f, 0x7a, 0x41, 0x1c, 0xfa, 0x96,
+	0xc0, 0xfc, 0xdb, 0x17, 0xac, 0xeb, 0xcb, 0x61, 0x82, 0x42, 0x1b, 0x88, 0x6f, 0x1f, 0xdf, 0xdf,
+	0x5b, 0xee, 0x61, 0x9b, 0x06, 0xc3, 0x86, 0xca, 0x57, 0xd4, 0x8b, 0x8a, 0x4d, 0x11, 0xf7, 0xa1,
+	0x48, 0x83, 0xae, 0xe6, 0xcd, 0xcd, 0x81, 0xb7, 0x40, 0x83, 0xae, 0xa2, 0xfd, 0x02, 0xca, 0x92,
+	0x87, 0x18, 0xf7, 0xa5, 0xa6, 0xce, 0xcf, 0x81, 0x1a, 0x2c, 0xe1, 0x09, 0xa2, 0xfb, 0x8d, 0x03,
+	0xa5, 0x3b, 0x34, 0xe8, 0xa2, 0x92, 0xc8, 0x6b, 0x90, 0x37, 0x75, 0x77, 0x76, 0xcb, 0x87, 0xd7,
+	0xbd, 0x19, 0x1d, 0xe5, 0x9d, 0x20, 0x1e, 0x2d, 0xaa, 0x38, 0xea, 0x0a, 0x4e, 0x5e, 0x84, 0x95,
+	0x14, 0x5b, 0xfd, 0x88, 0x35, 0x28, 0x63, 0x29, 0x0a, 0x51, 0xc9, 0xdd, 0x70, 0x76, 0x4b, 0xf5,
+	0xab, 0x46, 0x7b, 0xdb, 0x28, 0xc9, 0x96, 0x7a, 0xb3, 0x3d, 0x3a, 0xc4, 0x54, 0xe8, 0x34, 0x4b,
+	0xf5, 0x91, 0xfc, 0xd6, 0xb5, 0x2f, 0x1f, 0xdf, 0xdf, 0x9b, 0xf2, 0xe2, 0x7e, 0x0c, 0x30, 0x0a,
+	0x4d, 0x90, 0x1a, 0x94, 0x13, 0x2d, 0xa9, 0x3a, 0x09, 0xdb, 0x1b, 0xee, 0xcc, 0x18, 0x47, 0x96,
+	0x36, 0x52, 0x48, 0x46, 0xae, 0xdc, 0xef, 0x1c, 0xd8, 0xa8, 0x31, 0x8c, 0x24, 0x6f, 0x71, 0x64,
+	0x13, 0x1c, 0xef, 0x40, 0xc9, 0x72, 0x70, 0x66, 0xab, 0xb0, 0xad, 0x19, 0x54, 0x4b, 0x7b, 0x59,
+	0xab, 0x8f, 0xbc, 0xd7, 0x98, 0x75, 0xbe, 0x94, 0x58, 0x79, 0x3a, 0xca, 0xdc, 0xbf, 0x88, 0xf2,
+	0x96, 0x4a, 0x7f, 0x88, 0x78, 0xda, 0xa1, 0x29, 0x92, 0x0a, 0x14, 0xb3, 0xea, 0x3a, 0xba, 0xba,
+	0x99, 0x48, 0x9e, 0x85, 0xc2, 0x19, 0xf2, 0x76, 0x47, 0xea, 0xb2, 0x2f, 0xd6, 0xad, 0xe4, 0x7e,
+	0x0a, 0xe5, 0xb1, 0xbd, 0x20, 0x1f, 0xc0, 0x72, 0xa2, 0xc4, 0x86, 0xd0, 0xb2, 0x2d, 0xe0, 0x0b,
+	0x7f, 0x11, 0x5a, 0x66, 0x6b, 0x63, 0x2b, 0x27, 0x63, 0x6f, 0xee, 0xf7, 0x79, 0x58, 0x3d, 0xd6,
+	0x83, 0x7d, 0x37, 0x61, 0x54, 0xa2, 0xea, 0x9e, 0x28, 0xeb, 0x9e, 0xff, 0xbe, 0x85, 0x9f, 0xa4,
+	0xef, 0x5e, 0x81, 0xf5, 0x14, 0x43, 0xca, 0x23, 0x1e, 0xb5, 0x1b, 0x7d, 0x1d, 0xad, 0x6a, 0x40,
+	0x55, 0xaa, 0xb5, 0xd1, 0x81, 0xc9, 0x42, 0x90, 0x97, 0x61, 0x2d, 0xc5, 0x56, 0x8a, 0xa2, 0xd3,
+	0xe0, 0x91, 0xc4, 0x74, 0x40, 0x7b, 0x95, 0x45, 0x8d, 0x5d, 0xb5, 0xfa, 0x9a, 0x55, 0x2b, 0x28,
+	0xde, 0x4b, 0x78, 0x3a, 0x6c, 0xa8, 0x79, 0x12, 0x92, 0x86, 0x49, 0xe5, 0x8a, 0x81, 0x1a, 0xfd,
+	0x47, 0x99, 0x9a, 0x78, 0x70, 0xad, 0x47, 0x85, 0x6c, 0x24, 0x94, 0xb3, 0x09, 0x74, 0x41, 0xa3,
+	0xd7, 0xd5, 0xd1, 0x1d, 0xca, 0xd9, 0x18, 0xff, 0x3e, 0xac, 0x8d, 0xf1, 0x1d, 0xf3, 0x72, 0x8b,
+	0xba, 0x1d, 0xb7, 0x26, 0xda, 0xd1, 0xdc, 0xab, 0x83, 0x03, 0xef, 0x3d, 0x8d, 0xb0, 0xaf, 0x69,
+	0x25, 0x73, 0x67, 0xb4, 0x6e, 0x02, 0x6b, 0x53, 0x2f, 0x4a, 0x90, 0xcf, 0x80, 0x18, 0x6b, 0x5b,
+	0x8f, 0xc9, 0x91, 0xda, 0x9d, 0xd9, 0x11, 0x53, 0x6e, 0x2c, 0xdf, 0x5a, 0x30, 0xe5, 0xdd, 0xfd,
+	0xda, 0x81, 0xad, 0xf1, 0x78, 0x5d, 0x22, 0x7f, 0x1e, 0x4a, 0x96, 0xdc, 0x0e, 0x59, 0xa9, 0xbe,
+	0x64, 0x14, 0x35, 0x36, 0x23, 0xb2, 0xdc, 0x53, 0x8a, 0xec, 0xf7, 0x3c, 0xc0, 0xdd, 0xa4, 0x9d,
+	0x52, 0x86, 0xf6, 0xca, 0x97, 0xe9, 0x70, 0x6e, 0xab, 0xa6, 0x20, 0xd3, 0xe1, 0xff, 0xbb, 0x69,
+	0x82, 0x38, 0x6a, 0xf1, 0x34, 0x9c, 0xdf, 0xa6, 0xb1, 0x84, 0x76, 0xb1, 0xc7, 0x09, 0x46, 0x9a,
+	0x7b, 0x71, 0x1e, 0x8b, 0x5d, 0xb1, 0xa9, 0x15, 0x77, 0x06, 0xeb, 0xc7, 0xe6, 0xe6, 0x9e, 0x78,
+	0xf5, 0x6f, 0x4f, 0x6e, 0xba, 0xd9, 0x97, 0xe0, 0xd8, 0xe2, 0xc9, 0x17, 0x9e, 0x7b, 0x0f, 0xc8,
+	0x25, 0x62, 0x41, 0x9a, 0xb0, 0x61, 0x17, 0x49, 0xa3, 0x6f, 0xd4, 0x93, 0xd3, 0xb7, 0x37, 0xbb,
+	0xc7, 0xa7, 0x5d, 0xd9, 0x88, 0x48, 0x70, 0x89, 0xc3, 0xfd, 0xd5, 0x81, 0xeb, 0x13, 0x13, 0x78,
+	0x39, 0x88, 0x4d, 0x28, 0x26, 0x71, 0x3a, 0x31, 0x81, 0x05, 0x25, 0xd6, 0x18, 0xd9, 0x06, 0xc8,
+	0xa2, 0xe3, 0xcc, 0xa6, 0x55, 0xb2, 0x9a, 0x1a, 0x53, 0x77, 0x5e, 0x16, 0xb4, 0xc0, 0xcf, 0xfb,
+	0x18, 0x05, 0x68, 0xaf, 0xd2, 0x55, 0xab, 0x3f, 0xb5, 0xea, 0x99, 0x79, 0x2e, 0x3e, 0xc5, 0x3c,
+	0x7f, 0xcb, 0xc1, 0xaa, 0xd9, 0x53, 0x92, 0x4a, 0x2e, 0x24, 0x0f, 0x04, 0xd9, 0x80, 0x2b, 0x7a,
+	0x51, 0xd9, 0xc4, 0x8c, 0xf0, 0x77, 0x79, 0x6d, 0xc0, 0x15, 0x86, 0x51, 0x1c, 0xea, 0x64, 0x4a,
+	0x75, 0x23, 0x90, 0x9b, 0xb0, 0xdc, 0xec, 0xeb, 0x65, 0x2e, 0x24, 0x4d, 0xa5, 0x5d, 0x04, 0x65,
+	0xa3, 0x3b, 0x55, 0x2a, 0x05, 0xd1, 0x9f, 0xab, 0x66, 0x6f, 0x0b, 0xbb, 0x00, 0xca, 0x4a, 0x67,
+	0x76, 0xbb, 0x20, 0x3b, 0x50, 0x56, 0xe3, 0x9e, 0x21, 0xcc, 0xa5, 0x0f, 0x34, 0xe8, 0x66, 0x80,
+	0x97, 0x60, 0x35, 0xfb, 0x04, 0xcc, 0x40, 0x45, 0x0d, 0x5a, 0xb1, 0xea, 0x0c, 0x78, 0x0b, 0xca,
+	0xaa, 0x84, 0x0d, 0xa4, 0x69, 0x84, 0xac, 0xb2, 0xa4, 0x62, 0x3d, 0xda, 0x56, 0xd5, 0xf9, 0xe9,
+	0xd1, 0xce, 0x33, 0x66, 0x30, 0x04, 0xeb, 0x7a, 0x3c, 0xf6, 0x43, 0x2a, 0x3b, 0x5e, 0x2d, 0x92,
+	0x75, 0x50, 0x16, 0xef, 0x6a, 0x03, 0x72, 0x04, 0x57, 0xb5, 0xbd, 0x69, 0x53, 0x64, 0x95, 0xd2,
+	0x3f, 0xf1, 0xb0, 0xac, 0x6c, 0xea, 0xd6, 0xe4, 0xe8, 0xc3, 0x07, 0xe7, 0x55, 0xe7, 0xe1, 0x79,
+	0xd5, 0xf9, 0xe5, 0xbc, 0xea, 0x7c, 0x75, 0x51, 0x5d, 0x78, 0x78, 0x51, 0x5d, 0xf8, 0xf1, 0xa2,
+	0xba, 0xf0, 0xc9, 0xeb, 0x97, 0x67, 0x95, 0x37, 0x83, 0xfd, 0x76, 0xec, 0x0f, 0xde, 0xf0, 0xc3,
+	0x98, 0xf5, 0x7b, 0x28, 0xd4, 0x6f, 0x18, 0xe1, 0x1f, 0xbe, 0xb9, 0xaf, 0x7e, 0xbe, 0xe8, 0xf1,
+	0x6d, 0x16, 0xf4, 0xf7, 0xff, 0xab, 0x7f, 0x0e, 0x00, 0x37, 0xfa, 0x27, 0x39, 0xe3, 0x0c, 0x00,
+	0x00,
+}
+
+func (m *Fee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeoutFee) > 0 {
+		for iNdEx := len(m.TimeoutFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeoutFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecvFee) > 0 {
+		for iNdEx := len(m.RecvFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintFee(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedPacketFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedPacketFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for iNdEx := len(m.PacketFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PayeeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayeeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayeeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayeeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayeeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayeeShares) > 0 {
+		for iNdEx := len(m.PayeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastPaidHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.LastPaidTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.LastPaidTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RefreshInterval != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RefreshInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemainingUpdates != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RemainingUpdates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClientUpdateFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientUpdateFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientUpdateFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientUpdateFees) > 0 {
+		for iNdEx := len(m.ClientUpdateFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientUpdateFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedClientUpdateFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedClientUpdateFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedClientUpdateFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientUpdateFees) > 0 {
+		for iNdEx := len(m.ClientUpdateFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientUpdateFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OpenFee) > 0 {
+		for iNdEx := len(m.OpenFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpenFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConfirmFee) > 0 {
+		for iNdEx := len(m.ConfirmFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for iNdEx := len(m.AckFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TryFee) > 0 {
+		for iNdEx := len(m.TryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelUpgradeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelUpgradeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelUpgradeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelUpgradeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelUpgradeFees) > 0 {
+		for iNdEx := len(m.ChannelUpgradeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelUpgradeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentifiedChannelUpgradeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedChannelUpgradeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedChannelUpgradeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelUpgradeFees) > 0 {
+		for iNdEx := len(m.ChannelUpgradeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelUpgradeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayeeStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayeeStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayeeStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeesRefunded.Size()
+		i -= size
+		if _, err := m.FeesRefunded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FeesEarned.Size()
+		i -= size
+		if _, err := m.FeesEarned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.TimeoutPackets != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.TimeoutPackets))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AckPackets != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.AckPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RecvPackets != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BucketStart != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.BucketStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedPacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovFee(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *PayeeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PayeeShares) > 0 {
+		for _, e := range m.PayeeShares {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClientUpdateFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.RemainingUpdates != 0 {
+		n += 1 + sovFee(uint64(m.RemainingUpdates))
+	}
+	if m.RefreshInterval != 0 {
+		n += 1 + sovFee(uint64(m.RefreshInterval))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.ExpiryTimestamp))
+	}
+	if m.LastPaidTimestamp != 0 {
+		n += 1 + sovFee(uint64(m.LastPaidTimestamp))
+	}
+	l = m.LastPaidHeight.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func (m *ClientUpdateFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientUpdateFees) > 0 {
+		for _, e := range m.ClientUpdateFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedClientUpdateFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.ClientUpdateFees) > 0 {
+		for _, e := range m.ClientUpdateFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpgradeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TryFee) > 0 {
+		for _, e := range m.TryFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.ConfirmFee) > 0 {
+		for _, e := range m.ConfirmFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.OpenFee) > 0 {
+		for _, e := range m.OpenFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelUpgradeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelUpgradeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChannelUpgradeFees) > 0 {
+		for _, e := range m.ChannelUpgradeFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IdentifiedChannelUpgradeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovFee(uint64(m.UpgradeSequence))
+	}
+	if len(m.ChannelUpgradeFees) > 0 {
+		for _, e := range m.ChannelUpgradeFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayeeStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.BucketStart != 0 {
+		n += 1 + sovFee(uint64(m.BucketStart))
+	}
+	if m.RecvPackets != 0 {
+		n += 1 + sovFee(uint64(m.RecvPackets))
+	}
+	if m.AckPackets != 0 {
+		n += 1 + sovFee(uint64(m.AckPackets))
+	}
+	if m.TimeoutPackets != 0 {
+		n += 1 + sovFee(uint64(m.TimeoutPackets))
+	}
+	l = m.FeesEarned.Size()
+	n += 1 + l + sovFee(uint64(l))
+	l = m.FeesRefunded.Size()
+	n += 1 + l + sovFee(uint64(l))
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayeeShares) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayeeShares: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayeeShares: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayeeShares = append(m.PayeeShares, PayeeShare{})
+			if err := m.PayeeShares[len(m.PayeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientUpdateFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientUpdateFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientUpdateFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUpdates", wireType)
+			}
+			m.RemainingUpdates = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUpdates |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
+			}
+			m.RefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefreshInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPaidTimestamp", wireType)
+			}
+			m.LastPaidTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPaidTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPaidHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPaidHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientUpdateFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientUpdateFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientUpdateFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpdateFees = append(m.ClientUpdateFees, ClientUpdateFee{})
+			if err := m.ClientUpdateFees[len(m.ClientUpdateFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedClientUpdateFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientUpdateFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientUpdateFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpdateFees = append(m.ClientUpdateFees, ClientUpdateFee{})
+			if err := m.ClientUpdateFees[len(m.ClientUpdateFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TryFee = append(m.TryFee, types.Coin{})
+			if err := m.TryFee[len(m.TryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmFee = append(m.ConfirmFee, types.Coin{})
+			if err := m.ConfirmFee[len(m.ConfirmFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenFee = append(m.OpenFee, types.Coin{})
+			if err := m.OpenFee[len(m.OpenFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelUpgradeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelUpgradeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelUpgradeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelUpgradeFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelUpgradeFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelUpgradeFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelUpgradeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelUpgradeFees = append(m.ChannelUpgradeFees, ChannelUpgradeFee{})
+			if err := m.ChannelUpgradeFees[len(m.ChannelUpgradeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Fee) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedChannelUpgradeFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,17 +3567,17 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedChannelUpgradeFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedChannelUpgradeFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -641,31 +3587,29 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFee = append(m.RecvFee, types.Coin{})
-			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -675,29 +3619,46 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFee = append(m.AckFee, types.Coin{})
-			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelUpgradeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -724,8 +3685,8 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
-			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChannelUpgradeFees = append(m.ChannelUpgradeFees, ChannelUpgradeFee{})
+			if err := m.ChannelUpgradeFees[len(m.ChannelUpgradeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -750,7 +3711,7 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFee) Unmarshal(dAtA []byte) error {
+func (m *PayeeStatistics) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -773,17 +3734,17 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayeeStatistics: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayeeStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -793,28 +3754,27 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -842,11 +3802,11 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -874,63 +3834,13 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFee(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFee
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PacketFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFee
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketStart", wireType)
 			}
-			var msglen int
+			m.BucketStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -940,81 +3850,73 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BucketStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFee
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFee
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFee(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthFee
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPackets", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFee
+			m.AckPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPackets", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TimeoutPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1024,30 +3926,31 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeesEarned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesRefunded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1057,23 +3960,23 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeesRefunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12,6 +12,7 @@ import (
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 var (
@@ -226,3 +227,258 @@ func TestPacketFeeValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestFeeIsAllGTE(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	require.True(t, fee.IsAllGTE(fee))
+	require.True(t, fee.IsAllGTE(types.Fee{}))
+	require.True(t, fee.IsAllGTE(types.NewFee(defaultRecvFee, nil, nil)))
+	require.False(t, fee.IsAllGTE(types.NewFee(defaultRecvFee, defaultAckFee.Add(defaultAckFee...), defaultTimeoutFee)))
+	require.False(t, fee.IsAllGTE(types.NewFee(sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1))), nil, nil)))
+	require.False(t, types.Fee{}.IsAllGTE(fee))
+}
+
+func TestMinimumFeeValidation(t *testing.T) {
+	var minimumFee types.MinimumFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: port minimum fee",
+			func() {
+				minimumFee.ChannelId = ""
+			},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				minimumFee.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				minimumFee.ChannelId = "invalid/channel"
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				minimumFee.Fee.AckFee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty fee",
+			func() {
+				minimumFee.Fee = types.Fee{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		minimumFee = types.NewMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee))
+
+		tc.malleate()
+
+		err := minimumFee.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestClientUpdateFee(t *testing.T) {
+	clientUpdateFee := types.NewClientUpdateFee(defaultRecvFee, defaultAccAddress, 3, 100, 1000)
+
+	require.Equal(t, defaultRecvFee.MulInt(sdkmath.NewInt(3)), clientUpdateFee.Total())
+
+	require.False(t, clientUpdateFee.IsExpired(999))
+	require.True(t, clientUpdateFee.IsExpired(1000))
+
+	// a client update fee which has never been paid is refreshed
+	require.True(t, clientUpdateFee.IsRefreshed(0))
+
+	clientUpdateFee.LastPaidTimestamp = 500
+	require.False(t, clientUpdateFee.IsRefreshed(599))
+	require.True(t, clientUpdateFee.IsRefreshed(600))
+}
+
+func TestClientUpdateFeeValidation(t *testing.T) {
+	var clientUpdateFee types.ClientUpdateFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: zero refresh interval",
+			func() {
+				clientUpdateFee.RefreshInterval = 0
+			},
+			true,
+		},
+		{
+			"invalid refund address",
+			func() {
+				clientUpdateFee.RefundAddress = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				clientUpdateFee.Fee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty fee",
+			func() {
+				clientUpdateFee.Fee = sdk.Coins{}
+			},
+			false,
+		},
+		{
+			"zero remaining updates",
+			func() {
+				clientUpdateFee.RemainingUpdates = 0
+			},
+			false,
+		},
+		{
+			"zero expiry timestamp",
+			func() {
+				clientUpdateFee.ExpiryTimestamp = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		clientUpdateFee = types.NewClientUpdateFee(defaultRecvFee, defaultAccAddress, 10, 100, 1000)
+
+		tc.malleate()
+
+		err := clientUpdateFee.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpgradeFee(t *testing.T) {
+	upgradeFee := types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee)
+
+	// all the steps of the handshake may be relayed to the same chain
+	require.Equal(t, sdkmath.NewInt(700), upgradeFee.Total().AmountOf(sdk.DefaultBondDenom))
+
+	require.Equal(t, defaultRecvFee, upgradeFee.StepFee(types.UpgradeStepTry))
+	require.Equal(t, defaultAckFee, upgradeFee.StepFee(types.UpgradeStepAck))
+	require.Equal(t, defaultTimeoutFee, upgradeFee.StepFee(types.UpgradeStepConfirm))
+	require.Equal(t, defaultRecvFee, upgradeFee.StepFee(types.UpgradeStepOpen))
+
+	upgradeFee = upgradeFee.WithoutStepFee(types.UpgradeStepAck)
+	require.True(t, upgradeFee.StepFee(types.UpgradeStepAck).IsZero())
+	require.Equal(t, sdkmath.NewInt(500), upgradeFee.Total().AmountOf(sdk.DefaultBondDenom))
+	require.False(t, upgradeFee.IsZero())
+
+	upgradeFee = upgradeFee.WithoutStepFee(types.UpgradeStepTry).WithoutStepFee(types.UpgradeStepConfirm).WithoutStepFee(types.UpgradeStepOpen)
+	require.True(t, upgradeFee.IsZero())
+}
+
+func TestChannelUpgradeFeeValidation(t *testing.T) {
+	var channelUpgradeFee types.ChannelUpgradeFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: only the open fee is set",
+			func() {
+				channelUpgradeFee.Fee = types.NewUpgradeFee(nil, nil, nil, defaultRecvFee)
+			},
+			true,
+		},
+		{
+			"invalid refund address",
+			func() {
+				channelUpgradeFee.RefundAddress = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid try fee",
+			func() {
+				channelUpgradeFee.Fee.TryFee = invalidFee
+			},
+			false,
+		},
+		{
+			"invalid confirm fee",
+			func() {
+				channelUpgradeFee.Fee.ConfirmFee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty fee",
+			func() {
+				channelUpgradeFee.Fee = types.UpgradeFee{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		channelUpgradeFee = types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), defaultAccAddress)
+
+		tc.malleate()
+
+		err := channelUpgradeFee.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
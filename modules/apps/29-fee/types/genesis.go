@@ -18,6 +18,10 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	minimumFees []MinimumFee,
+	clientUpdateFees []IdentifiedClientUpdateFees,
+	channelUpgradeFees []IdentifiedChannelUpgradeFees,
+	payeeStatistics []PayeeStatistics,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +29,10 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		MinimumFees:                  minimumFees,
+		ClientUpdateFees:             clientUpdateFees,
+		ChannelUpgradeFees:           channelUpgradeFees,
+		PayeeStatistics:              payeeStatistics,
 	}
 }
 
@@ -36,6 +44,10 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		MinimumFees:                  []MinimumFee{},
+		ClientUpdateFees:             []IdentifiedClientUpdateFees{},
+		ChannelUpgradeFees:           []IdentifiedChannelUpgradeFees{},
+		PayeeStatistics:              []PayeeStatistics{},
 	}
 }
 
@@ -67,16 +79,26 @@ func (gs GenesisState) Validate() error {
 
 	// Validate RegisteredPayees
 	for _, registeredPayee := range gs.RegisteredPayees {
-		if registeredPayee.Relayer == registeredPayee.Payee {
-			return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address and payee address must not be equal")
-		}
-
 		if _, err := sdk.AccAddressFromBech32(registeredPayee.Relayer); err != nil {
 			return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
 		}
 
-		if _, err := sdk.AccAddressFromBech32(registeredPayee.Payee); err != nil {
-			return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+		if len(registeredPayee.PayeeShares) != 0 {
+			if registeredPayee.Payee != "" {
+				return errorsmod.Wrap(ErrInvalidPayeeShares, "payee must be empty if payee shares are set")
+			}
+
+			if err := ValidatePayeeShares(registeredPayee.PayeeShares); err != nil {
+				return err
+			}
+		} else {
+			if registeredPayee.Relayer == registeredPayee.Payee {
+				return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address and payee address must not be equal")
+			}
+
+			if _, err := sdk.AccAddressFromBech32(registeredPayee.Payee); err != nil {
+				return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+			}
 		}
 
 		if err := host.ChannelIdentifierValidator(registeredPayee.ChannelId); err != nil {
@@ -110,5 +132,64 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate MinimumFees
+	seenMinimumFees := make(map[string]bool)
+	for _, minimumFee := range gs.MinimumFees {
+		if err := minimumFee.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyMinimumFee(minimumFee.PortId, minimumFee.ChannelId))
+		if seenMinimumFees[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate minimum fee for port ID %s and channel ID %s", minimumFee.PortId, minimumFee.ChannelId)
+		}
+
+		seenMinimumFees[key] = true
+	}
+
+	// Validate ClientUpdateFees
+	for _, identifiedFees := range gs.ClientUpdateFees {
+		if err := host.ClientIdentifierValidator(identifiedFees.ClientId); err != nil {
+			return errorsmod.Wrapf(err, "invalid client identifier: %s", identifiedFees.ClientId)
+		}
+
+		for _, clientUpdateFee := range identifiedFees.ClientUpdateFees {
+			if err := clientUpdateFee.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Validate ChannelUpgradeFees
+	for _, identifiedFees := range gs.ChannelUpgradeFees {
+		if err := host.PortIdentifierValidator(identifiedFees.PortId); err != nil {
+			return errorsmod.Wrap(err, "invalid port ID")
+		}
+		if err := host.ChannelIdentifierValidator(identifiedFees.ChannelId); err != nil {
+			return errorsmod.Wrap(err, "invalid channel ID")
+		}
+
+		for _, channelUpgradeFee := range identifiedFees.ChannelUpgradeFees {
+			if err := channelUpgradeFee.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Validate PayeeStatistics
+	seenPayeeStatistics := make(map[string]bool)
+	for _, payeeStatistics := range gs.PayeeStatistics {
+		if err := payeeStatistics.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyPayeeStatisticsBucket(payeeStatistics.Payee, payeeStatistics.ChannelId, payeeStatistics.BucketStart, payeeStatistics.Denom))
+		if seenPayeeStatistics[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate payee statistics for payee %s, channel ID %s, denom %s and bucket start %d", payeeStatistics.Payee, payeeStatistics.ChannelId, payeeStatistics.Denom, payeeStatistics.BucketStart)
+		}
+
+		seenPayeeStatistics[key] = true
+	}
+
 	return nil
 }
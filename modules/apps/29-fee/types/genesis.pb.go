@@ -36,6 +36,14 @@ type GenesisState struct {
 	RegisteredCounterpartyPayees []RegisteredCounterpartyPayee `protobuf:"bytes,4,rep,name=registered_counterparty_payees,json=registeredCounterpartyPayees,proto3" json:"registered_counterparty_payees"`
 	// list of forward relayer addresses
 	ForwardRelayers []ForwardRelayerAddress `protobuf:"bytes,5,rep,name=forward_relayers,json=forwardRelayers,proto3" json:"forward_relayers"`
+	// list of minimum fees required to send packets
+	MinimumFees []MinimumFee `protobuf:"bytes,6,rep,name=minimum_fees,json=minimumFees,proto3" json:"minimum_fees"`
+	// list of fees escrowed for client updates
+	ClientUpdateFees []IdentifiedClientUpdateFees `protobuf:"bytes,7,rep,name=client_update_fees,json=clientUpdateFees,proto3" json:"client_update_fees"`
+	// list of fees escrowed for channel upgrade handshakes
+	ChannelUpgradeFees []IdentifiedChannelUpgradeFees `protobuf:"bytes,8,rep,name=channel_upgrade_fees,json=channelUpgradeFees,proto3" json:"channel_upgrade_fees"`
+	// list of payee statistics, aggregated over all time and per time bucket
+	PayeeStatistics []PayeeStatistics `protobuf:"bytes,9,rep,name=payee_statistics,json=payeeStatistics,proto3" json:"payee_statistics"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +114,34 @@ func (m *GenesisState) GetForwardRelayers() []ForwardRelayerAddress {
 	return nil
 }
 
+func (m *GenesisState) GetMinimumFees() []MinimumFee {
+	if m != nil {
+		return m.MinimumFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientUpdateFees() []IdentifiedClientUpdateFees {
+	if m != nil {
+		return m.ClientUpdateFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelUpgradeFees() []IdentifiedChannelUpgradeFees {
+	if m != nil {
+		return m.ChannelUpgradeFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPayeeStatistics() []PayeeStatistics {
+	if m != nil {
+		return m.PayeeStatistics
+	}
+	return nil
+}
+
 // FeeEnabledChannel contains the PortID & ChannelID for a fee enabled channel
 type FeeEnabledChannel struct {
 	// unique port identifier
@@ -161,14 +197,16 @@ func (m *FeeEnabledChannel) GetChannelId() string {
 	return ""
 }
 
-// RegisteredPayee contains the relayer address and payee address for a specific channel
+// RegisteredPayee contains the relayer address and payee address, or weighted payee addresses, for a specific channel
 type RegisteredPayee struct {
 	// unique channel identifier
 	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// the relayer address
 	Relayer string `protobuf:"bytes,2,opt,name=relayer,proto3" json:"relayer,omitempty"`
-	// the payee address
+	// the payee address, empty if the fees are split between multiple payees
 	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+	// list of payees between which the fees are split, set if the fees are split between multiple payees
+	PayeeShares []PayeeShare `protobuf:"bytes,4,rep,name=payee_shares,json=payeeShares,proto3" json:"payee_shares"`
 }
 
 func (m *RegisteredPayee) Reset()         { *m = RegisteredPayee{} }
@@ -225,6 +263,13 @@ func (m *RegisteredPayee) GetPayee() string {
 	return ""
 }
 
+func (m *RegisteredPayee) GetPayeeShares() []PayeeShare {
+	if m != nil {
+		return m.PayeeShares
+	}
+	return nil
+}
+
 // RegisteredCounterpartyPayee contains the relayer address and counterparty payee address for a specific channel (used
 // for recv fee distribution)
 type RegisteredCounterpartyPayee struct {
@@ -345,12 +390,77 @@ func (m *ForwardRelayerAddress) GetPacketId() types.PacketId {
 	return types.PacketId{}
 }
 
+// MinimumFee contains the minimum fees required to send a packet over a channel, or over all channels of a port
+type MinimumFee struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier, empty if the minimum fee applies to all channels of the port
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the minimum receive, acknowledgement and timeout fees
+	Fee Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MinimumFee) Reset()         { *m = MinimumFee{} }
+func (m *MinimumFee) String() string { return proto.CompactTextString(m) }
+func (*MinimumFee) ProtoMessage()    {}
+func (*MinimumFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7191992e856dff95, []int{5}
+}
+func (m *MinimumFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinimumFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinimumFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinimumFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinimumFee.Merge(m, src)
+}
+func (m *MinimumFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinimumFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinimumFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinimumFee proto.InternalMessageInfo
+
+func (m *MinimumFee) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MinimumFee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MinimumFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.fee.v1.GenesisState")
 	proto.RegisterType((*FeeEnabledChannel)(nil), "ibc.applications.fee.v1.FeeEnabledChannel")
 	proto.RegisterType((*RegisteredPayee)(nil), "ibc.applications.fee.v1.RegisteredPayee")
 	proto.RegisterType((*RegisteredCounterpartyPayee)(nil), "ibc.applications.fee.v1.RegisteredCounterpartyPayee")
 	proto.RegisterType((*ForwardRelayerAddress)(nil), "ibc.applications.fee.v1.ForwardRelayerAddress")
+	proto.RegisterType((*MinimumFee)(nil), "ibc.applications.fee.v1.MinimumFee")
 }
 
 func init() {
@@ -358,42 +468,52 @@ func init() {
 }
 
 var fileDescriptor_7191992e856dff95 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xf6, 0x93, 0x7a, 0x88, 0xae, 0x56, 0xd1, 0xa2, 0xc1, 0xc2, 0xa8, 0x84, 0x54, 0x21,
-	0x35, 0x51, 0x0b, 0x48, 0x70, 0x03, 0x26, 0x86, 0x2a, 0x0e, 0x4c, 0xe5, 0x06, 0x48, 0x21, 0xb1,
-	0x5f, 0x32, 0x8b, 0x36, 0x8e, 0x6c, 0xb7, 0xa8, 0x37, 0x2e, 0xdc, 0xe1, 0xbf, 0xda, 0x71, 0x47,
-	0x4e, 0x08, 0xb5, 0xff, 0x08, 0xb2, 0xe3, 0x8c, 0xae, 0x23, 0x08, 0x71, 0xf3, 0x7b, 0xef, 0xfb,
-	0xde, 0x67, 0x7f, 0x4f, 0xcf, 0xe8, 0x1e, 0x8b, 0x49, 0x10, 0xe5, 0xf9, 0x88, 0x91, 0x48, 0x31,
-	0x9e, 0xc9, 0x20, 0x01, 0x08, 0xa6, 0xbd, 0x20, 0x85, 0x0c, 0x24, 0x93, 0x7e, 0x2e, 0xb8, 0xe2,
-	0x78, 0x8f, 0xc5, 0xc4, 0x5f, 0x86, 0xf9, 0x09, 0x80, 0x3f, 0xed, 0xed, 0xb7, 0x52, 0x9e, 0x72,
-	0x83, 0x09, 0xf4, 0xa9, 0x80, 0xef, 0xdf, 0xad, 0xea, 0xaa, 0x59, 0x4b, 0x10, 0xc2, 0x05, 0x04,
-	0xe4, 0x34, 0xca, 0x32, 0x18, 0xe9, 0xb2, 0x3d, 0x16, 0x90, 0xf6, 0xb7, 0x0d, 0x74, 0xfd, 0x65,
-	0x71, 0x8d, 0x37, 0x2a, 0x52, 0x80, 0xdf, 0xa3, 0x06, 0xa3, 0x90, 0x29, 0x96, 0x30, 0xa0, 0x61,
-	0x02, 0x20, 0x5d, 0xe7, 0x70, 0xbd, 0xb3, 0xd3, 0xef, 0xfa, 0x15, 0xf7, 0xf3, 0x07, 0x17, 0xf8,
-	0x93, 0x88, 0x7c, 0x04, 0x75, 0x0c, 0x20, 0x9f, 0x6f, 0x9c, 0xfd, 0xb8, 0x53, 0x1b, 0xde, 0xf8,
-	0xdd, 0x4b, 0x67, 0x71, 0x8c, 0x5a, 0x09, 0x40, 0x08, 0x59, 0x14, 0x8f, 0x80, 0x86, 0xf6, 0x2e,
-	0xd2, 0x5d, 0x33, 0x12, 0xf7, 0x2b, 0x25, 0x8e, 0x01, 0x5e, 0x14, 0x9c, 0xa3, 0x82, 0x62, 0xfb,
-	0xe3, 0x64, 0xb5, 0x20, 0xf1, 0x3b, 0xd4, 0x14, 0x90, 0x32, 0xa9, 0x40, 0x00, 0x0d, 0xf3, 0x68,
-	0xa6, 0xdf, 0xb0, 0x6e, 0x04, 0x3a, 0x95, 0x02, 0xc3, 0x0b, 0xc6, 0x89, 0x26, 0xd8, 0xf6, 0xbb,
-	0xe2, 0x72, 0x5a, 0xe2, 0xcf, 0x0e, 0xf2, 0x96, 0xba, 0x13, 0x3e, 0xc9, 0x14, 0x88, 0x3c, 0x12,
-	0x6a, 0x56, 0x4a, 0x6d, 0x18, 0xa9, 0x87, 0xff, 0x20, 0x75, 0xb4, 0xc4, 0x5e, 0x96, 0xbd, 0x2d,
-	0xaa, 0x21, 0x12, 0x87, 0x68, 0x37, 0xe1, 0xe2, 0x53, 0x24, 0x68, 0x28, 0x60, 0x14, 0xcd, 0x40,
-	0x48, 0x77, 0xd3, 0x68, 0xfa, 0xd5, 0xfe, 0x15, 0x84, 0x61, 0x81, 0x7f, 0x46, 0xa9, 0x00, 0x59,
-	0xce, 0xa8, 0x91, 0x5c, 0x2a, 0xca, 0xf6, 0x2b, 0xd4, 0xbc, 0xe2, 0x37, 0xde, 0x43, 0xdb, 0x39,
-	0x17, 0x2a, 0x64, 0xd4, 0x75, 0x0e, 0x9d, 0x4e, 0x7d, 0xb8, 0xa5, 0xc3, 0x01, 0xc5, 0x07, 0x08,
-	0xd9, 0x31, 0xea, 0xda, 0x9a, 0xa9, 0xd5, 0x6d, 0x66, 0x40, 0xdb, 0x1f, 0x50, 0x63, 0xc5, 0xdb,
-	0x15, 0x86, 0xb3, 0xc2, 0xc0, 0x2e, 0xda, 0xb6, 0xef, 0xb2, 0xdd, 0xca, 0x10, 0xb7, 0xd0, 0xa6,
-	0xf1, 0xd8, 0x5d, 0x37, 0xf9, 0x22, 0x68, 0x7f, 0x71, 0xd0, 0xad, 0xbf, 0x78, 0xfa, 0xff, 0x72,
-	0x5d, 0x84, 0xaf, 0xce, 0xd7, 0x6a, 0x37, 0xc9, 0xaa, 0x4e, 0x5b, 0xa2, 0x9b, 0x7f, 0xb4, 0x59,
-	0x2b, 0x44, 0xc5, 0xd1, 0xaa, 0x97, 0x21, 0x7e, 0x8a, 0xea, 0xb9, 0x59, 0x99, 0xd2, 0xba, 0x9d,
-	0xfe, 0x81, 0x99, 0xa1, 0x5e, 0x5a, 0xbf, 0xdc, 0xd4, 0x69, 0xcf, 0x2f, 0x16, 0x6b, 0x40, 0xed,
-	0xc8, 0xae, 0xe5, 0x65, 0xfc, 0xfa, 0x6c, 0xee, 0x39, 0xe7, 0x73, 0xcf, 0xf9, 0x39, 0xf7, 0x9c,
-	0xaf, 0x0b, 0xaf, 0x76, 0xbe, 0xf0, 0x6a, 0xdf, 0x17, 0x5e, 0xed, 0xed, 0xa3, 0x94, 0xa9, 0xd3,
-	0x49, 0xec, 0x13, 0x3e, 0x0e, 0x08, 0x97, 0x63, 0x2e, 0x03, 0x16, 0x93, 0x6e, 0xca, 0x83, 0xe9,
-	0xe3, 0x60, 0xcc, 0xe9, 0x64, 0x04, 0x52, 0xff, 0x1f, 0x32, 0xe8, 0x3f, 0xe9, 0xea, 0xaf, 0x43,
-	0xcd, 0x72, 0x90, 0xf1, 0x96, 0xf9, 0x17, 0x1e, 0xfc, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x6c,
-	0xb0, 0x1e, 0xb5, 0x04, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xce, 0x10, 0x20, 0xc4, 0x41, 0x97, 0x60, 0xe5, 0x8a, 0x11, 0x17, 0x72, 0x69, 0x50, 0x25,
+	0x54, 0x29, 0x33, 0x22, 0x80, 0xd4, 0xee, 0x5a, 0x50, 0xa9, 0xa2, 0xb6, 0x2a, 0x0a, 0x62, 0xd1,
+	0x1f, 0x69, 0x34, 0xb1, 0xcf, 0x0c, 0x56, 0x33, 0x3f, 0xb2, 0x1d, 0xaa, 0x74, 0xd5, 0x4d, 0xf7,
+	0x55, 0x1f, 0xa4, 0xcf, 0xc1, 0x92, 0x65, 0x57, 0x55, 0x05, 0x2f, 0x52, 0xd9, 0xe3, 0x09, 0x21,
+	0x34, 0x80, 0xd8, 0xd9, 0xc7, 0xdf, 0xcf, 0xb1, 0xcf, 0xb1, 0x8d, 0x1e, 0xb2, 0x2e, 0x71, 0xfd,
+	0x34, 0xed, 0x31, 0xe2, 0x4b, 0x96, 0xc4, 0xc2, 0x0d, 0x00, 0xdc, 0x93, 0x4d, 0x37, 0x84, 0x18,
+	0x04, 0x13, 0x4e, 0xca, 0x13, 0x99, 0xe0, 0x25, 0xd6, 0x25, 0xce, 0x28, 0xcc, 0x09, 0x00, 0x9c,
+	0x93, 0xcd, 0xe5, 0x5a, 0x98, 0x84, 0x89, 0xc6, 0xb8, 0x6a, 0x94, 0xc1, 0x97, 0x1f, 0x4c, 0x52,
+	0x55, 0xac, 0x11, 0x08, 0x49, 0x38, 0xb8, 0xe4, 0xd8, 0x8f, 0x63, 0xe8, 0xa9, 0x65, 0x33, 0xcc,
+	0x20, 0x8d, 0xef, 0x25, 0x34, 0xff, 0x22, 0x4b, 0xe3, 0x50, 0xfa, 0x12, 0xf0, 0x07, 0xb4, 0xc0,
+	0x28, 0xc4, 0x92, 0x05, 0x0c, 0xa8, 0x17, 0x00, 0x08, 0xdb, 0x5a, 0x2b, 0x6e, 0x54, 0x5a, 0x4d,
+	0x67, 0x42, 0x7e, 0x4e, 0x7b, 0x88, 0x3f, 0xf0, 0xc9, 0x47, 0x90, 0xfb, 0x00, 0x62, 0x77, 0xfa,
+	0xf4, 0xd7, 0xff, 0x85, 0xce, 0x3f, 0x97, 0x5a, 0x2a, 0x8a, 0xbb, 0xa8, 0x16, 0x00, 0x78, 0x10,
+	0xfb, 0xdd, 0x1e, 0x50, 0xcf, 0xe4, 0x22, 0xec, 0x29, 0x6d, 0xf1, 0x68, 0xa2, 0xc5, 0x3e, 0xc0,
+	0xf3, 0x8c, 0xb3, 0x97, 0x51, 0x8c, 0x3e, 0x0e, 0xc6, 0x17, 0x04, 0x7e, 0x8f, 0x16, 0x39, 0x84,
+	0x4c, 0x48, 0xe0, 0x40, 0xbd, 0xd4, 0x1f, 0xa8, 0x3d, 0x14, 0xb5, 0xc1, 0xc6, 0x44, 0x83, 0xce,
+	0x90, 0x71, 0xa0, 0x08, 0x46, 0xbe, 0xca, 0xaf, 0x86, 0x05, 0xfe, 0x62, 0xa1, 0xfa, 0x88, 0x3a,
+	0x49, 0xfa, 0xb1, 0x04, 0x9e, 0xfa, 0x5c, 0x0e, 0x72, 0xab, 0x69, 0x6d, 0xb5, 0x7d, 0x07, 0xab,
+	0xbd, 0x11, 0xf6, 0xa8, 0xed, 0x0a, 0x9f, 0x0c, 0x11, 0xd8, 0x43, 0xd5, 0x20, 0xe1, 0x9f, 0x7c,
+	0x4e, 0x3d, 0x0e, 0x3d, 0x7f, 0x00, 0x5c, 0xd8, 0x33, 0xda, 0xd3, 0x99, 0x7c, 0x7e, 0x19, 0xa1,
+	0x93, 0xe1, 0x9f, 0x51, 0xca, 0x41, 0xe4, 0x35, 0x5a, 0x08, 0xae, 0x2c, 0x0a, 0xfc, 0x0a, 0xcd,
+	0x47, 0x2c, 0x66, 0x51, 0x3f, 0xca, 0xea, 0x3f, 0xab, 0xc5, 0xd7, 0x27, 0x8a, 0xbf, 0xce, 0xc0,
+	0xfb, 0xc3, 0xfc, 0x2b, 0xd1, 0x30, 0x22, 0x70, 0x88, 0x30, 0xe9, 0x31, 0x88, 0xa5, 0xd7, 0x4f,
+	0xa9, 0x2f, 0x21, 0xd3, 0x2c, 0x69, 0xcd, 0xad, 0x3b, 0xf4, 0xd4, 0x9e, 0x26, 0x1f, 0x69, 0xee,
+	0x48, 0x67, 0x55, 0xc9, 0x58, 0x1c, 0x47, 0xa8, 0x66, 0xfa, 0xc9, 0xeb, 0xa7, 0x21, 0xf7, 0xa9,
+	0xb1, 0x9a, 0xd3, 0x56, 0x3b, 0x77, 0xb1, 0xca, 0xe8, 0x47, 0x19, 0x7b, 0xc4, 0x0c, 0x93, 0x6b,
+	0x2b, 0xf8, 0x2d, 0xaa, 0xea, 0x82, 0x7b, 0x42, 0xfa, 0x92, 0x09, 0xc9, 0x88, 0xb0, 0xcb, 0xb7,
+	0x74, 0x99, 0xae, 0xe0, 0xe1, 0x10, 0x9f, 0x17, 0x20, 0xbd, 0x1a, 0x6e, 0xbc, 0x44, 0x8b, 0xd7,
+	0x1a, 0x1e, 0x2f, 0xa1, 0x52, 0x9a, 0x70, 0xe9, 0x31, 0x6a, 0x5b, 0x6b, 0xd6, 0x46, 0xb9, 0x33,
+	0xab, 0xa6, 0x6d, 0x8a, 0x57, 0x11, 0xca, 0xf7, 0xcd, 0xa8, 0x3d, 0xa5, 0xd7, 0xca, 0x26, 0xd2,
+	0xa6, 0x8d, 0x1f, 0x16, 0x5a, 0x18, 0xeb, 0xee, 0x31, 0x8a, 0x35, 0x46, 0xc1, 0x36, 0x2a, 0x99,
+	0xce, 0x32, 0x72, 0xf9, 0x14, 0xd7, 0xd0, 0x8c, 0x4e, 0xd6, 0x2e, 0xea, 0x78, 0x36, 0x51, 0x0d,
+	0x63, 0x8e, 0xe2, 0xd8, 0xe7, 0xc3, 0x1b, 0xb0, 0x7e, 0xcb, 0x31, 0x28, 0x6c, 0xde, 0x30, 0xe9,
+	0x30, 0x22, 0x1a, 0x5f, 0x2d, 0xf4, 0xdf, 0x0d, 0x77, 0xe4, 0xfe, 0xc9, 0x37, 0x11, 0xbe, 0x7e,
+	0x5f, 0xcd, 0x4e, 0x16, 0xc9, 0xb8, 0x4f, 0x43, 0xa0, 0x7f, 0xff, 0x7a, 0x6d, 0x94, 0x83, 0x9f,
+	0x0d, 0x8d, 0x7b, 0x3e, 0xc5, 0x4f, 0x51, 0x39, 0xd5, 0x4f, 0x60, 0x5e, 0x89, 0x4a, 0x6b, 0x55,
+	0x9f, 0x82, 0x7a, 0x84, 0x9d, 0xfc, 0xe5, 0xd5, 0x27, 0xa0, 0x50, 0x6d, 0x6a, 0xf6, 0x3f, 0x97,
+	0x9a, 0x79, 0xe3, 0x33, 0x42, 0x97, 0xd7, 0xe9, 0xbe, 0x35, 0xc7, 0xdb, 0xa8, 0x18, 0x98, 0xad,
+	0x55, 0x5a, 0x2b, 0x37, 0xbd, 0xaa, 0x26, 0x01, 0x05, 0xdf, 0x7d, 0x73, 0x7a, 0x5e, 0xb7, 0xce,
+	0xce, 0xeb, 0xd6, 0xef, 0xf3, 0xba, 0xf5, 0xed, 0xa2, 0x5e, 0x38, 0xbb, 0xa8, 0x17, 0x7e, 0x5e,
+	0xd4, 0x0b, 0xef, 0x76, 0x42, 0x26, 0x8f, 0xfb, 0x5d, 0x87, 0x24, 0x91, 0x4b, 0x12, 0x11, 0x25,
+	0xc2, 0x65, 0x5d, 0xd2, 0x0c, 0x13, 0xf7, 0xe4, 0xb1, 0x1b, 0x25, 0xb4, 0xdf, 0x03, 0xa1, 0xfe,
+	0x22, 0xe1, 0xb6, 0x9e, 0x34, 0xd5, 0x37, 0x24, 0x07, 0x29, 0x88, 0xee, 0xac, 0xfe, 0x63, 0xb6,
+	0xfe, 0x0c, 0x00, 0x48, 0xfc, 0xe0, 0x59, 0x01, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -416,6 +536,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayeeStatistics) > 0 {
+		for iNdEx := len(m.PayeeStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayeeStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChannelUpgradeFees) > 0 {
+		for iNdEx := len(m.ChannelUpgradeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelUpgradeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientUpdateFees) > 0 {
+		for iNdEx := len(m.ClientUpdateFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientUpdateFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MinimumFees) > 0 {
+		for iNdEx := len(m.MinimumFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardRelayers) > 0 {
 		for iNdEx := len(m.ForwardRelayers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -546,6 +722,20 @@ func (m *RegisteredPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayeeShares) > 0 {
+		for iNdEx := len(m.PayeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Payee) > 0 {
 		i -= len(m.Payee)
 		copy(dAtA[i:], m.Payee)
@@ -654,6 +844,53 @@ func (m *ForwardRelayerAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MinimumFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinimumFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinimumFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -701,6 +938,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MinimumFees) > 0 {
+		for _, e := range m.MinimumFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientUpdateFees) > 0 {
+		for _, e := range m.ClientUpdateFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelUpgradeFees) > 0 {
+		for _, e := range m.ChannelUpgradeFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PayeeStatistics) > 0 {
+		for _, e := range m.PayeeStatistics {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -739,6 +1000,12 @@ func (m *RegisteredPayee) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PayeeShares) > 0 {
+		for _, e := range m.PayeeShares {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -778,6 +1045,25 @@ func (m *ForwardRelayerAddress) Size() (n int) {
 	return n
 }
 
+func (m *MinimumFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -983,6 +1269,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumFees = append(m.MinimumFees, MinimumFee{})
+			if err := m.MinimumFees[len(m.MinimumFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUpdateFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientUpdateFees = append(m.ClientUpdateFees, IdentifiedClientUpdateFees{})
+			if err := m.ClientUpdateFees[len(m.ClientUpdateFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelUpgradeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelUpgradeFees = append(m.ChannelUpgradeFees, IdentifiedChannelUpgradeFees{})
+			if err := m.ChannelUpgradeFees[len(m.ChannelUpgradeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayeeStatistics = append(m.PayeeStatistics, PayeeStatistics{})
+			if err := m.PayeeStatistics[len(m.PayeeStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1243,6 +1665,40 @@ func (m *RegisteredPayee) Unmarshal(dAtA []byte) error {
 			}
 			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayeeShares = append(m.PayeeShares, PayeeShare{})
+			if err := m.PayeeShares[len(m.PayeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1525,6 +1981,153 @@ func (m *ForwardRelayerAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MinimumFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinimumFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinimumFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -95,6 +97,32 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"success - registered payee with payee shares",
+			func() {
+				genState.RegisteredPayees[0].Payee = ""
+				genState.RegisteredPayees[0].PayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(defaultAccAddress, 1),
+					types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1),
+				}
+			},
+			true,
+		},
+		{
+			"invalid registered payee: payee and payee shares are both set",
+			func() {
+				genState.RegisteredPayees[0].PayeeShares = []types.PayeeShare{types.NewPayeeShare(defaultAccAddress, 1)}
+			},
+			false,
+		},
+		{
+			"invalid registered payee: invalid payee shares",
+			func() {
+				genState.RegisteredPayees[0].Payee = ""
+				genState.RegisteredPayees[0].PayeeShares = []types.PayeeShare{types.NewPayeeShare(defaultAccAddress, 0)}
+			},
+			false,
+		},
 		{
 			"invalid registered payee: invalid channel ID",
 			func() {
@@ -130,6 +158,76 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid minimum fee: invalid port ID",
+			func() {
+				genState.MinimumFees[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid minimum fee: empty fee",
+			func() {
+				genState.MinimumFees[0].Fee = types.Fee{}
+			},
+			false,
+		},
+		{
+			"invalid minimum fee: duplicate minimum fee",
+			func() {
+				genState.MinimumFees = append(genState.MinimumFees, genState.MinimumFees[0])
+			},
+			false,
+		},
+		{
+			"invalid client update fees: invalid client ID",
+			func() {
+				genState.ClientUpdateFees[0].ClientId = "invalid/client"
+			},
+			false,
+		},
+		{
+			"invalid client update fees: zero remaining updates",
+			func() {
+				genState.ClientUpdateFees[0].ClientUpdateFees[0].RemainingUpdates = 0
+			},
+			false,
+		},
+		{
+			"invalid channel upgrade fees: invalid channel ID",
+			func() {
+				genState.ChannelUpgradeFees[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel upgrade fees: invalid refund address",
+			func() {
+				genState.ChannelUpgradeFees[0].ChannelUpgradeFees[0].RefundAddress = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid payee statistics: invalid payee address",
+			func() {
+				genState.PayeeStatistics[0].Payee = ""
+			},
+			false,
+		},
+		{
+			"invalid payee statistics: negative fees earned",
+			func() {
+				genState.PayeeStatistics[1].FeesEarned = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+		{
+			"invalid payee statistics: duplicate payee statistics",
+			func() {
+				genState.PayeeStatistics = append(genState.PayeeStatistics, genState.PayeeStatistics[1])
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -168,6 +266,22 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			MinimumFees: []types.MinimumFee{
+				types.NewMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)),
+				types.NewMinimumFee(ibctesting.MockFeePort, "", types.NewFee(defaultRecvFee, nil, nil)),
+			},
+			ClientUpdateFees: []types.IdentifiedClientUpdateFees{
+				types.NewIdentifiedClientUpdateFees(ibctesting.FirstClientID, []types.ClientUpdateFee{types.NewClientUpdateFee(defaultRecvFee, defaultAccAddress, 10, 100, 1000)}),
+			},
+			ChannelUpgradeFees: []types.IdentifiedChannelUpgradeFees{
+				types.NewIdentifiedChannelUpgradeFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, []types.ChannelUpgradeFee{
+					types.NewChannelUpgradeFee(types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee), defaultAccAddress),
+				}),
+			},
+			PayeeStatistics: []types.PayeeStatistics{
+				types.NewPayeeStatistics(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 0).Add(types.PacketStepRecv, sdkmath.NewInt(100), sdkmath.ZeroInt()),
+				types.NewPayeeStatistics(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 86400).Add(types.PacketStepRecv, sdkmath.NewInt(100), sdkmath.ZeroInt()),
+			},
 		}
 
 		tc.malleate()
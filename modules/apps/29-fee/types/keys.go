@@ -40,6 +40,24 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// MinimumFeeKeyPrefix is the key prefix for the minimum fees required to send packets
+	MinimumFeeKeyPrefix = "minimumFee"
+
+	// ClientUpdateFeesPrefix is the key prefix for the fees escrowed for client updates
+	ClientUpdateFeesPrefix = "clientUpdateFees"
+
+	// ClientUpdateFeesExpiryPrefix is the key prefix for the index of client update fees ordered by expiry timestamp
+	ClientUpdateFeesExpiryPrefix = "clientUpdateExpiry"
+
+	// ChannelUpgradeFeesPrefix is the key prefix for the fees escrowed for channel upgrade handshakes
+	ChannelUpgradeFeesPrefix = "channelUpgradeFees"
+
+	// PayeeStatisticsPrefix is the key prefix for the payee statistics aggregated over all time
+	PayeeStatisticsPrefix = "statistics"
+
+	// PayeeStatisticsBucketsPrefix is the key prefix for the payee statistics aggregated per time bucket
+	PayeeStatisticsBucketsPrefix = "statisticsBuckets"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -48,6 +66,11 @@ func KeyLocked() []byte {
 	return []byte("locked")
 }
 
+// KeyLockReason returns the key used to store the reason for which the fee module was locked.
+func KeyLockReason() []byte {
+	return []byte("lockReason")
+}
+
 // KeyFeeEnabled returns the key that stores a flag to determine if fee logic should
 // be enabled for the given port and channel identifiers.
 func KeyFeeEnabled(portID, channelID string) []byte {
@@ -91,6 +114,28 @@ func ParseKeyPayeeAddress(key string) (relayerAddr, channelID string, err error)
 	return keySplit[1], keySplit[2], nil
 }
 
+// KeyMinimumFee returns the key for the minimum fee required to send packets over the given channel.
+// The channel identifier is empty for the minimum fee of all channels of the port.
+func KeyMinimumFee(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", MinimumFeeKeyPrefix, portID, channelID))
+}
+
+// ParseKeyMinimumFee returns the port and channel identifiers used to store the minimum fee
+func ParseKeyMinimumFee(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != MinimumFeeKeyPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", MinimumFeeKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
 // KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
 func KeyCounterpartyPayee(address, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyPayeeKeyPrefix, address, channelID))
@@ -158,3 +203,118 @@ func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
 func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", FeesInEscrowPrefix, portID, channelID))
 }
+
+// KeyClientUpdateFees returns the key for the fees escrowed for the updates of the given client
+func KeyClientUpdateFees(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ClientUpdateFeesPrefix, clientID))
+}
+
+// ParseKeyClientUpdateFees parses the key used to store client update fees and returns the client identifier
+func ParseKeyClientUpdateFees(key string) (string, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 2 {
+		return "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 2, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != ClientUpdateFeesPrefix {
+		return "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", ClientUpdateFeesPrefix, keySplit[0])
+	}
+
+	return keySplit[1], nil
+}
+
+// KeyClientUpdateFeesExpiry returns the key indexing the client update fees of the given client which expire at the
+// given timestamp. The expiry timestamp is zero padded so that the keys are ordered by expiry timestamp.
+func KeyClientUpdateFeesExpiry(expiryTimestamp uint64, clientID string) []byte {
+	return []byte(fmt.Sprintf("%s%s", KeyClientUpdateFeesExpiryPrefix(expiryTimestamp), clientID))
+}
+
+// KeyClientUpdateFeesExpiryPrefix returns the key prefix of the client update fees expiring at the given timestamp
+func KeyClientUpdateFeesExpiryPrefix(expiryTimestamp uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/", ClientUpdateFeesExpiryPrefix, expiryTimestamp))
+}
+
+// ParseKeyClientUpdateFeesExpiry parses the key used to index client update fees by expiry timestamp and returns
+// the expiry timestamp and the client identifier
+func ParseKeyClientUpdateFeesExpiry(key string) (uint64, string, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return 0, "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != ClientUpdateFeesExpiryPrefix {
+		return 0, "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", ClientUpdateFeesExpiryPrefix, keySplit[0])
+	}
+
+	expiryTimestamp, err := strconv.ParseUint(keySplit[1], 10, 64)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return expiryTimestamp, keySplit[2], nil
+}
+
+// KeyChannelUpgradeFees returns the key for the fees escrowed for the channel upgrade handshake at the given upgrade sequence
+func KeyChannelUpgradeFees(portID, channelID string, upgradeSequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyChannelUpgradeFeesChannelPrefix(portID, channelID), upgradeSequence))
+}
+
+// ParseKeyChannelUpgradeFees parses the key used to store channel upgrade fees and returns the port and channel
+// identifiers and the upgrade sequence
+func ParseKeyChannelUpgradeFees(key string) (portID, channelID string, upgradeSequence uint64, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 {
+		return "", "", 0, errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	upgradeSequence, err = strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return keySplit[1], keySplit[2], upgradeSequence, nil
+}
+
+// KeyChannelUpgradeFeesChannelPrefix returns the key prefix for the channel upgrade fees escrowed on the given channel.
+// The prefix is terminated by a separator so that it does not match channel identifiers which extend the given one.
+func KeyChannelUpgradeFeesChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ChannelUpgradeFeesPrefix, portID, channelID))
+}
+
+// KeyPayeeStatistics returns the key for the statistics of the given payee, channel and denomination aggregated over all time
+func KeyPayeeStatistics(payee, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s", KeyPayeeStatisticsPrefix(payee, channelID, false), denom))
+}
+
+// KeyPayeeStatisticsBucket returns the key for the statistics of the given payee, channel and denomination aggregated
+// over the time bucket starting at the given time. The denomination is the last component of the key as it may contain separators.
+func KeyPayeeStatisticsBucket(payee, channelID string, bucketStart uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%d/%s", KeyPayeeStatisticsPrefix(payee, channelID, true), bucketStart, denom))
+}
+
+// KeyPayeeStatisticsPrefix returns the key prefix for the statistics of the given payee, aggregated over all time or
+// per time bucket, restricted to the given channel if it is not empty. The statistics of all payees are matched if the
+// payee is empty. The prefix is terminated by a separator so that it does not match payee or channel identifiers which
+// extend the given ones.
+func KeyPayeeStatisticsPrefix(payee, channelID string, timeBuckets bool) []byte {
+	keyPrefix := PayeeStatisticsPrefix
+	if timeBuckets {
+		keyPrefix = PayeeStatisticsBucketsPrefix
+	}
+
+	if payee == "" {
+		return []byte(fmt.Sprintf("%s/", keyPrefix))
+	}
+
+	if channelID == "" {
+		return []byte(fmt.Sprintf("%s/%s/", keyPrefix, payee))
+	}
+
+	return []byte(fmt.Sprintf("%s/%s/%s/", keyPrefix, payee, channelID))
+}
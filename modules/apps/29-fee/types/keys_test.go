@@ -61,6 +61,18 @@ func TestKeyCounterpartyPayee(t *testing.T) {
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s", types.CounterpartyPayeeKeyPrefix, relayerAddress, channelID))
 }
 
+func TestKeyPayeeStatistics(t *testing.T) {
+	key := types.KeyPayeeStatistics("payee-address", ibctesting.FirstChannelID, "ibc/denom")
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%s", types.PayeeStatisticsPrefix, "payee-address", ibctesting.FirstChannelID, "ibc/denom"))
+
+	key = types.KeyPayeeStatisticsBucket("payee-address", ibctesting.FirstChannelID, 86400, "ibc/denom")
+	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%d/%s", types.PayeeStatisticsBucketsPrefix, "payee-address", ibctesting.FirstChannelID, 86400, "ibc/denom"))
+
+	require.Equal(t, fmt.Sprintf("%s/", types.PayeeStatisticsPrefix), string(types.KeyPayeeStatisticsPrefix("", ibctesting.FirstChannelID, false)))
+	require.Equal(t, fmt.Sprintf("%s/%s/", types.PayeeStatisticsBucketsPrefix, "payee-address"), string(types.KeyPayeeStatisticsPrefix("payee-address", "", true)))
+	require.Equal(t, fmt.Sprintf("%s/%s/%s/", types.PayeeStatisticsPrefix, "payee-address", ibctesting.FirstChannelID), string(types.KeyPayeeStatisticsPrefix("payee-address", ibctesting.FirstChannelID, false)))
+}
+
 func TestKeyFeesInEscrow(t *testing.T) {
 	key := types.KeyFeesInEscrow(validPacketID)
 	require.Equal(t, string(key), fmt.Sprintf("%s/%s/%s/%d", types.FeesInEscrowPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, 1))
@@ -214,3 +226,178 @@ func TestParseKeyCounterpartyPayee(t *testing.T) {
 		}
 	}
 }
+
+func TestParseKeyMinimumFee(t *testing.T) {
+	testCases := []struct {
+		name         string
+		key          string
+		expChannelID string
+		expPass      bool
+	}{
+		{
+			"success",
+			string(types.KeyMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			ibctesting.FirstChannelID,
+			true,
+		},
+		{
+			"success: port minimum fee",
+			string(types.KeyMinimumFee(ibctesting.MockFeePort, "")),
+			"",
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyFeesInEscrow(validPacketID)),
+			"",
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s/%s", "fee", ibctesting.MockFeePort, ibctesting.FirstChannelID),
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		portID, channelID, err := types.ParseKeyMinimumFee(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockFeePort, portID)
+			require.Equal(t, tc.expChannelID, channelID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+		}
+	}
+}
+
+func TestParseKeyClientUpdateFees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyClientUpdateFees(ibctesting.FirstClientID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyMinimumFee(ibctesting.MockFeePort, ibctesting.FirstChannelID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%s", "fee", ibctesting.FirstClientID),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		clientID, err := types.ParseKeyClientUpdateFees(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.FirstClientID, clientID)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, clientID)
+		}
+	}
+}
+
+func TestParseKeyClientUpdateFeesExpiry(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyClientUpdateFeesExpiry(1000, ibctesting.FirstClientID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyClientUpdateFees(ibctesting.FirstClientID)),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			fmt.Sprintf("%s/%d/%s", "fee", 1000, ibctesting.FirstClientID),
+			false,
+		},
+		{
+			"incorrect key - invalid expiry timestamp",
+			fmt.Sprintf("%s/%s/%s", types.ClientUpdateFeesExpiryPrefix, "expiry", ibctesting.FirstClientID),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		expiryTimestamp, clientID, err := types.ParseKeyClientUpdateFeesExpiry(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, uint64(1000), expiryTimestamp)
+			require.Equal(t, ibctesting.FirstClientID, clientID)
+		} else {
+			require.Error(t, err)
+			require.Zero(t, expiryTimestamp)
+			require.Empty(t, clientID)
+		}
+	}
+}
+
+func TestParseKeyChannelUpgradeFees(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyChannelUpgradeFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			string(types.KeyClientUpdateFees(ibctesting.FirstClientID)),
+			false,
+		},
+		{
+			"incorrect key - invalid upgrade sequence",
+			fmt.Sprintf("%s/%s/%s/%s", types.ChannelUpgradeFeesPrefix, ibctesting.MockFeePort, ibctesting.FirstChannelID, "sequence"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		portID, channelID, upgradeSequence, err := types.ParseKeyChannelUpgradeFees(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err)
+			require.Equal(t, ibctesting.MockFeePort, portID)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+			require.Equal(t, uint64(1), upgradeSequence)
+		} else {
+			require.Error(t, err)
+			require.Empty(t, portID)
+			require.Empty(t, channelID)
+			require.Zero(t, upgradeSequence)
+		}
+	}
+}
@@ -19,11 +19,19 @@ var (
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgRecoverLockedFeeModule)(nil)
+	_ sdk.Msg = (*MsgUpdateMinimumFee)(nil)
+	_ sdk.Msg = (*MsgPayClientUpdateFee)(nil)
+	_ sdk.Msg = (*MsgPayChannelUpgradeFee)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecoverLockedFeeModule)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateMinimumFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgPayClientUpdateFee)(nil)
+	_ sdk.HasValidateBasic = (*MsgPayChannelUpgradeFee)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -36,6 +44,17 @@ func NewMsgRegisterPayee(portID, channelID, relayerAddr, payeeAddr string) *MsgR
 	}
 }
 
+// NewMsgRegisterPayeeShares creates a new instance of MsgRegisterPayee splitting the fees paid out to the relayer
+// between the provided payee shares
+func NewMsgRegisterPayeeShares(portID, channelID, relayerAddr string, payeeShares []PayeeShare) *MsgRegisterPayee {
+	return &MsgRegisterPayee{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Relayer:     relayerAddr,
+		PayeeShares: payeeShares,
+	}
+}
+
 // ValidateBasic implements sdk.Msg and performs basic stateless validation
 func (msg MsgRegisterPayee) ValidateBasic() error {
 	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
@@ -51,6 +70,14 @@ func (msg MsgRegisterPayee) ValidateBasic() error {
 		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
 	}
 
+	if len(msg.PayeeShares) != 0 {
+		if msg.Payee != "" {
+			return errorsmod.Wrap(ErrInvalidPayeeShares, "payee must be empty if payee shares are set")
+		}
+
+		return ValidatePayeeShares(msg.PayeeShares)
+	}
+
 	_, err = sdk.AccAddressFromBech32(msg.Payee)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from payee address")
@@ -59,6 +86,16 @@ func (msg MsgRegisterPayee) ValidateBasic() error {
 	return nil
 }
 
+// Payees returns the payee shares between which the fees paid out to the relayer are split. If a single payee is
+// registered, it is returned as the only payee share.
+func (msg MsgRegisterPayee) Payees() []PayeeShare {
+	if len(msg.PayeeShares) != 0 {
+		return msg.PayeeShares
+	}
+
+	return []PayeeShare{NewPayeeShare(msg.Payee, 1)}
+}
+
 // NewMsgRegisterCounterpartyPayee creates a new instance of MsgRegisterCounterpartyPayee
 func NewMsgRegisterCounterpartyPayee(portID, channelID, relayerAddr, counterpartyPayeeAddr string) *MsgRegisterCounterpartyPayee {
 	return &MsgRegisterCounterpartyPayee{
@@ -147,3 +184,109 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgRecoverLockedFeeModule creates a new instance of MsgRecoverLockedFeeModule
+func NewMsgRecoverLockedFeeModule(signer, funder string) *MsgRecoverLockedFeeModule {
+	return &MsgRecoverLockedFeeModule{
+		Signer: signer,
+		Funder: funder,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRecoverLockedFeeModule fields
+func (msg MsgRecoverLockedFeeModule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if msg.Funder != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to create sdk.AccAddress from funder address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgUpdateMinimumFee creates a new instance of MsgUpdateMinimumFee
+func NewMsgUpdateMinimumFee(signer, portID, channelID string, fee Fee) *MsgUpdateMinimumFee {
+	return &MsgUpdateMinimumFee{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Fee:       fee,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateMinimumFee fields
+func (msg MsgUpdateMinimumFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if msg.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+			return err
+		}
+	}
+
+	// an empty fee removes the minimum fee
+	if msg.Fee.IsZero() {
+		return nil
+	}
+
+	return msg.Fee.Validate()
+}
+
+// NewMsgPayClientUpdateFee creates a new instance of MsgPayClientUpdateFee
+func NewMsgPayClientUpdateFee(clientID string, fee sdk.Coins, maxUpdates, refreshInterval, expiryTimestamp uint64, signer string) *MsgPayClientUpdateFee {
+	return &MsgPayClientUpdateFee{
+		ClientId:        clientID,
+		Fee:             fee,
+		MaxUpdates:      maxUpdates,
+		RefreshInterval: refreshInterval,
+		ExpiryTimestamp: expiryTimestamp,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayClientUpdateFee fields
+func (msg MsgPayClientUpdateFee) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	return NewClientUpdateFee(msg.Fee, msg.Signer, msg.MaxUpdates, msg.RefreshInterval, msg.ExpiryTimestamp).Validate()
+}
+
+// NewMsgPayChannelUpgradeFee creates a new instance of MsgPayChannelUpgradeFee
+func NewMsgPayChannelUpgradeFee(portID, channelID string, upgradeSequence uint64, fee UpgradeFee, signer string) *MsgPayChannelUpgradeFee {
+	return &MsgPayChannelUpgradeFee{
+		PortId:          portID,
+		ChannelId:       channelID,
+		UpgradeSequence: upgradeSequence,
+		Fee:             fee,
+		Signer:          signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgPayChannelUpgradeFee fields
+func (msg MsgPayChannelUpgradeFee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if msg.UpgradeSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidUpgradeSequence, "upgrade sequence must be greater than zero")
+	}
+
+	return NewChannelUpgradeFee(msg.Fee, msg.Signer).Validate()
+}
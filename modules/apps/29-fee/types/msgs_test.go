@@ -65,6 +65,32 @@ func TestMsgRegisterPayeeValidation(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"success: payee shares",
+			func() {
+				msg.Payee = ""
+				msg.PayeeShares = []types.PayeeShare{
+					types.NewPayeeShare(defaultAccAddress, 1),
+					types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 2),
+				}
+			},
+			true,
+		},
+		{
+			"payee and payee shares are both set",
+			func() {
+				msg.PayeeShares = []types.PayeeShare{types.NewPayeeShare(defaultAccAddress, 1)}
+			},
+			false,
+		},
+		{
+			"invalid payee shares",
+			func() {
+				msg.Payee = ""
+				msg.PayeeShares = []types.PayeeShare{types.NewPayeeShare(defaultAccAddress, 0)}
+			},
+			false,
+		},
 	}
 
 	for i, tc := range testCases {
@@ -398,3 +424,283 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgRecoverLockedFeeModuleValidation(t *testing.T) {
+	var msg *types.MsgRecoverLockedFeeModule
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty funder",
+			func() {
+				msg.Funder = ""
+			},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid funder address",
+			func() {
+				msg.Funder = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgRecoverLockedFeeModule(defaultAccAddress, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRecoverLockedFeeModuleGetSigners(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRecoverLockedFeeModule(signer.String(), "")
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, signer.Bytes(), signers[0])
+}
+
+func TestMsgUpdateMinimumFeeValidation(t *testing.T) {
+	var msg *types.MsgUpdateMinimumFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: port minimum fee",
+			func() {
+				msg.ChannelId = ""
+			},
+			true,
+		},
+		{
+			"success: empty fee removes the minimum fee",
+			func() {
+				msg.Fee = types.Fee{}
+			},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = "invalid/channel"
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				msg.Fee.RecvFee = invalidFee
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgUpdateMinimumFee(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID, fee)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayClientUpdateFeeValidation(t *testing.T) {
+	var msg *types.MsgPayClientUpdateFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func() {
+				msg.ClientId = "invalid/client"
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				msg.Fee = invalidFee
+			},
+			false,
+		},
+		{
+			"zero max updates",
+			func() {
+				msg.MaxUpdates = 0
+			},
+			false,
+		},
+		{
+			"zero expiry timestamp",
+			func() {
+				msg.ExpiryTimestamp = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgPayClientUpdateFee(ibctesting.FirstClientID, defaultRecvFee, 10, 100, 1000, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayChannelUpgradeFeeValidation(t *testing.T) {
+	var msg *types.MsgPayChannelUpgradeFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port ID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				msg.ChannelId = "invalid/channel"
+			},
+			false,
+		},
+		{
+			"zero upgrade sequence",
+			func() {
+				msg.UpgradeSequence = 0
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid fee",
+			func() {
+				msg.Fee.OpenFee = invalidFee
+			},
+			false,
+		},
+		{
+			"empty fee",
+			func() {
+				msg.Fee = types.UpgradeFee{}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		fee := types.NewUpgradeFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee, defaultRecvFee)
+		msg = types.NewMsgPayChannelUpgradeFee(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1, fee, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
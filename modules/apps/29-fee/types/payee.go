@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaximumPayeeShares is the maximum number of payees between which the fees paid out to a relayer can be split
+const MaximumPayeeShares = 10
+
+// NewPayeeShare creates and returns a new PayeeShare struct including the payee address and its weight
+func NewPayeeShare(address string, weight uint64) PayeeShare {
+	return PayeeShare{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// NewPayeeShares creates and returns a new PayeeShares struct including a list of type PayeeShare
+func NewPayeeShares(payeeShares []PayeeShare) PayeeShares {
+	return PayeeShares{
+		PayeeShares: payeeShares,
+	}
+}
+
+// ValidatePayeeShares performs basic stateless validation of a list of payee shares. The list must not be empty or
+// exceed MaximumPayeeShares, each payee must be a valid address with a non-zero weight and payees must be unique.
+func ValidatePayeeShares(payeeShares []PayeeShare) error {
+	if len(payeeShares) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayeeShares, "payee shares cannot be empty")
+	}
+
+	if len(payeeShares) > MaximumPayeeShares {
+		return errorsmod.Wrapf(ErrInvalidPayeeShares, "number of payee shares must not exceed %d", MaximumPayeeShares)
+	}
+
+	seen := make(map[string]bool, len(payeeShares))
+	for _, payeeShare := range payeeShares {
+		if _, err := sdk.AccAddressFromBech32(payeeShare.Address); err != nil {
+			return errorsmod.Wrap(err, "failed to create sdk.AccAddress from payee address")
+		}
+
+		if payeeShare.Weight == 0 {
+			return errorsmod.Wrapf(ErrInvalidPayeeShares, "weight of payee %s must be greater than zero", payeeShare.Address)
+		}
+
+		if seen[payeeShare.Address] {
+			return errorsmod.Wrapf(ErrInvalidPayeeShares, "duplicate payee %s", payeeShare.Address)
+		}
+
+		seen[payeeShare.Address] = true
+	}
+
+	return nil
+}
+
+// SplitFee splits the provided fee between the payee shares in proportion to their weights, returning the share of
+// the fee of each payee in the same order as the payee shares. The amount of each denomination is rounded down for
+// every payee and the remainder is assigned to the payee with the largest weight, or the first of them if several
+// payees share the largest weight, so that the whole fee is always split.
+func SplitFee(payeeShares []PayeeShare, fee sdk.Coins) []sdk.Coins {
+	totalWeight := sdkmath.ZeroInt()
+	largest := 0
+	for i, payeeShare := range payeeShares {
+		totalWeight = totalWeight.Add(sdkmath.NewIntFromUint64(payeeShare.Weight))
+		if payeeShare.Weight > payeeShares[largest].Weight {
+			largest = i
+		}
+	}
+
+	shares := make([]sdk.Coins, len(payeeShares))
+	if len(payeeShares) == 0 || totalWeight.IsZero() {
+		return shares
+	}
+
+	for _, coin := range fee {
+		remainder := coin.Amount
+		for i, payeeShare := range payeeShares {
+			if i == largest {
+				continue
+			}
+
+			amount := coin.Amount.Mul(sdkmath.NewIntFromUint64(payeeShare.Weight)).Quo(totalWeight)
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, amount))
+			remainder = remainder.Sub(amount)
+		}
+
+		shares[largest] = shares[largest].Add(sdk.NewCoin(coin.Denom, remainder))
+	}
+
+	return shares
+}
+
+// FormatPayeeShares returns the string representation of a list of payee shares. A single payee is represented by
+// its address, while multiple payees are represented as a comma separated list of address:weight pairs.
+func FormatPayeeShares(payeeShares []PayeeShare) string {
+	if len(payeeShares) == 1 {
+		return payeeShares[0].Address
+	}
+
+	formatted := make([]string, len(payeeShares))
+	for i, payeeShare := range payeeShares {
+		formatted[i] = fmt.Sprintf("%s:%d", payeeShare.Address, payeeShare.Weight)
+	}
+
+	return strings.Join(formatted, ",")
+}
+
+// ParsePayeeShares parses a comma separated list of address:weight pairs into a list of payee shares
+func ParsePayeeShares(payeeShares string) ([]PayeeShare, error) {
+	var parsed []PayeeShare
+	for _, payeeShare := range strings.Split(payeeShares, ",") {
+		address, weight, found := strings.Cut(strings.TrimSpace(payeeShare), ":")
+		if !found {
+			return nil, errorsmod.Wrapf(ErrInvalidPayeeShares, "payee share %s must be of the form address:weight", payeeShare)
+		}
+
+		parsedWeight, err := strconv.ParseUint(weight, 10, 64)
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidPayeeShares, "invalid weight for payee %s: %s", address, err)
+		}
+
+		parsed = append(parsed, NewPayeeShare(address, parsedWeight))
+	}
+
+	return parsed, nil
+}
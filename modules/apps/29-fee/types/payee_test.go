@@ -0,0 +1,154 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+func TestValidatePayeeShares(t *testing.T) {
+	var payeeShares []types.PayeeShare
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty payee shares",
+			func() {
+				payeeShares = nil
+			},
+			false,
+		},
+		{
+			"too many payee shares",
+			func() {
+				payeeShares = nil
+				for i := 0; i <= types.MaximumPayeeShares; i++ {
+					payeeShares = append(payeeShares, types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1))
+				}
+			},
+			false,
+		},
+		{
+			"invalid payee address",
+			func() {
+				payeeShares[0].Address = invalidAddress
+			},
+			false,
+		},
+		{
+			"zero weight",
+			func() {
+				payeeShares[1].Weight = 0
+			},
+			false,
+		},
+		{
+			"duplicate payee",
+			func() {
+				payeeShares[1].Address = payeeShares[0].Address
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		payeeShares = []types.PayeeShare{
+			types.NewPayeeShare(defaultAccAddress, 1),
+			types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 2),
+		}
+
+		tc.malleate()
+
+		err := types.ValidatePayeeShares(payeeShares)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSplitFee(t *testing.T) {
+	fee := sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+		sdk.NewCoin("atom", sdkmath.NewInt(7)),
+	)
+
+	payeeShares := []types.PayeeShare{
+		types.NewPayeeShare(defaultAccAddress, 1),
+		types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1),
+		types.NewPayeeShare(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), 1),
+	}
+
+	shares := types.SplitFee(payeeShares, fee)
+	require.Len(t, shares, 3)
+
+	// the remainder of each denomination is assigned to the first of the payees with the largest weight
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(34)), sdk.NewCoin("atom", sdkmath.NewInt(3))), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(33)), sdk.NewCoin("atom", sdkmath.NewInt(2))), shares[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(33)), sdk.NewCoin("atom", sdkmath.NewInt(2))), shares[2])
+	requireFeeSplit(t, fee, shares)
+
+	// the remainder of each denomination is assigned to the payee with the largest weight
+	payeeShares[1].Weight = 2
+	shares = types.SplitFee(payeeShares, fee)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(25)), sdk.NewCoin("atom", sdkmath.NewInt(1))), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50)), sdk.NewCoin("atom", sdkmath.NewInt(5))), shares[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(25)), sdk.NewCoin("atom", sdkmath.NewInt(1))), shares[2])
+	requireFeeSplit(t, fee, shares)
+}
+
+// requireFeeSplit asserts that the whole fee is split between the shares
+func requireFeeSplit(t *testing.T, fee sdk.Coins, shares []sdk.Coins) {
+	t.Helper()
+
+	total := sdk.NewCoins()
+	for _, share := range shares {
+		total = total.Add(share...)
+	}
+	require.Equal(t, fee, total)
+}
+
+func TestFormatAndParsePayeeShares(t *testing.T) {
+	payeeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	// a single payee is formatted as its address
+	require.Equal(t, defaultAccAddress, types.FormatPayeeShares([]types.PayeeShare{types.NewPayeeShare(defaultAccAddress, 1)}))
+
+	payeeShares := []types.PayeeShare{
+		types.NewPayeeShare(defaultAccAddress, 3),
+		types.NewPayeeShare(payeeAddr, 1),
+	}
+
+	formatted := types.FormatPayeeShares(payeeShares)
+	require.Equal(t, defaultAccAddress+":3,"+payeeAddr+":1", formatted)
+
+	parsed, err := types.ParsePayeeShares(formatted)
+	require.NoError(t, err)
+	require.Equal(t, payeeShares, parsed)
+
+	_, err = types.ParsePayeeShares(defaultAccAddress)
+	require.Error(t, err)
+
+	_, err = types.ParsePayeeShares(defaultAccAddress + ":weight")
+	require.Error(t, err)
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+const (
+	// PayeeStatisticsBucketDuration is the duration of the time buckets over which payee statistics are aggregated
+	PayeeStatisticsBucketDuration = 24 * time.Hour
+	// MaxPayeeStatisticsBuckets is the number of time buckets for which payee statistics are retained, the statistics
+	// of older time buckets are pruned when statistics are recorded in a new time bucket
+	MaxPayeeStatisticsBuckets = 90
+)
+
+// PacketStep defines a step of the packet lifecycle for which payees are paid fees
+type PacketStep int
+
+const (
+	// PacketStepRecv is the receipt of a packet on the counterparty chain, paid with the receive fee
+	PacketStepRecv PacketStep = iota + 1
+	// PacketStepAck is the acknowledgement of a packet, paid with the acknowledgement fee
+	PacketStepAck
+	// PacketStepTimeout is the timeout of a packet, paid with the timeout fee
+	PacketStepTimeout
+)
+
+// NewPayeeStatistics creates and returns a new PayeeStatistics struct for the given payee, channel, denomination
+// and time bucket, with all counters set to zero
+func NewPayeeStatistics(payee, channelID, denom string, bucketStart uint64) PayeeStatistics {
+	return PayeeStatistics{
+		Payee:        payee,
+		ChannelId:    channelID,
+		Denom:        denom,
+		BucketStart:  bucketStart,
+		FeesEarned:   sdkmath.ZeroInt(),
+		FeesRefunded: sdkmath.ZeroInt(),
+	}
+}
+
+// PayeeStatisticsBucketStart returns the start in unix seconds of the time bucket containing the given time.
+// Zero is returned for times which are not after the unix epoch.
+func PayeeStatisticsBucketStart(t time.Time) uint64 {
+	seconds := t.Unix()
+	if seconds <= 0 {
+		return 0
+	}
+
+	return uint64(seconds - seconds%int64(PayeeStatisticsBucketDuration/time.Second))
+}
+
+// OldestRetainedBucketStart returns the start in unix seconds of the oldest time bucket for which payee statistics are
+// retained while statistics are recorded in the time bucket starting at the given time
+func OldestRetainedBucketStart(bucketStart uint64) uint64 {
+	retention := uint64((MaxPayeeStatisticsBuckets - 1) * PayeeStatisticsBucketDuration / time.Second)
+	if bucketStart <= retention {
+		return 0
+	}
+
+	return bucketStart - retention
+}
+
+// IsTimeBucket returns true if the statistics are aggregated over a single time bucket
+func (ps PayeeStatistics) IsTimeBucket() bool {
+	return ps.BucketStart != 0
+}
+
+// InTimeRange returns true if the time bucket of the statistics starts at or after the start time and before the end
+// time. A zero start or end time leaves the range unbounded on that side.
+func (ps PayeeStatistics) InTimeRange(startTime, endTime uint64) bool {
+	if ps.BucketStart < startTime {
+		return false
+	}
+
+	return endTime == 0 || ps.BucketStart < endTime
+}
+
+// Add returns the statistics incremented by a packet relayed at the given step, for which the given fees were earned
+// by the payee and refunded to the payers
+func (ps PayeeStatistics) Add(step PacketStep, earned, refunded sdkmath.Int) PayeeStatistics {
+	switch step {
+	case PacketStepRecv:
+		ps.RecvPackets++
+	case PacketStepAck:
+		ps.AckPackets++
+	case PacketStepTimeout:
+		ps.TimeoutPackets++
+	}
+
+	ps.FeesEarned = ps.FeesEarned.Add(earned)
+	ps.FeesRefunded = ps.FeesRefunded.Add(refunded)
+
+	return ps
+}
+
+// Validate performs basic stateless validation of the payee statistics
+func (ps PayeeStatistics) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ps.Payee); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "failed to convert payee address")
+	}
+
+	if err := host.ChannelIdentifierValidator(ps.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(ps.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidPayeeStatistics, err.Error())
+	}
+
+	if ps.BucketStart%uint64(PayeeStatisticsBucketDuration/time.Second) != 0 {
+		return errorsmod.Wrapf(ErrInvalidPayeeStatistics, "bucket start %d is not aligned to the bucket duration %s", ps.BucketStart, PayeeStatisticsBucketDuration)
+	}
+
+	if ps.FeesEarned.IsNil() || ps.FeesEarned.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPayeeStatistics, "fees earned must not be negative: %s", ps.FeesEarned)
+	}
+
+	if ps.FeesRefunded.IsNil() || ps.FeesRefunded.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidPayeeStatistics, "fees refunded must not be negative: %s", ps.FeesRefunded)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestPayeeStatisticsBucketStart(t *testing.T) {
+	require.Equal(t, uint64(0), types.PayeeStatisticsBucketStart(time.Unix(0, 0)))
+	require.Equal(t, uint64(0), types.PayeeStatisticsBucketStart(time.Unix(86399, 0)))
+	require.Equal(t, uint64(86400), types.PayeeStatisticsBucketStart(time.Unix(86400, 0)))
+	require.Equal(t, uint64(86400), types.PayeeStatisticsBucketStart(time.Unix(2*86400-1, 999)))
+}
+
+func TestOldestRetainedBucketStart(t *testing.T) {
+	retention := uint64((types.MaxPayeeStatisticsBuckets - 1) * 86400)
+
+	require.Equal(t, uint64(0), types.OldestRetainedBucketStart(86400))
+	require.Equal(t, uint64(0), types.OldestRetainedBucketStart(retention))
+	require.Equal(t, uint64(86400), types.OldestRetainedBucketStart(retention+86400))
+}
+
+func TestPayeeStatisticsAdd(t *testing.T) {
+	stats := types.NewPayeeStatistics(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 0)
+
+	stats = stats.Add(types.PacketStepRecv, sdkmath.NewInt(100), sdkmath.ZeroInt())
+	stats = stats.Add(types.PacketStepAck, sdkmath.NewInt(200), sdkmath.NewInt(50))
+	stats = stats.Add(types.PacketStepTimeout, sdkmath.NewInt(300), sdkmath.NewInt(10))
+	stats = stats.Add(types.PacketStepAck, sdkmath.NewInt(200), sdkmath.ZeroInt())
+
+	require.Equal(t, uint64(1), stats.RecvPackets)
+	require.Equal(t, uint64(2), stats.AckPackets)
+	require.Equal(t, uint64(1), stats.TimeoutPackets)
+	require.Equal(t, sdkmath.NewInt(800), stats.FeesEarned)
+	require.Equal(t, sdkmath.NewInt(60), stats.FeesRefunded)
+}
+
+func TestPayeeStatisticsInTimeRange(t *testing.T) {
+	stats := types.NewPayeeStatistics(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 2*86400)
+
+	require.True(t, stats.IsTimeBucket())
+	require.True(t, stats.InTimeRange(0, 0))
+	require.True(t, stats.InTimeRange(2*86400, 3*86400))
+	require.False(t, stats.InTimeRange(3*86400, 0))
+	require.False(t, stats.InTimeRange(0, 2*86400))
+
+	stats.BucketStart = 0
+	require.False(t, stats.IsTimeBucket())
+}
+
+func TestValidatePayeeStatistics(t *testing.T) {
+	var stats types.PayeeStatistics
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: time bucket",
+			func() {
+				stats.BucketStart = 86400
+			},
+			true,
+		},
+		{
+			"invalid payee address",
+			func() {
+				stats.Payee = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				stats.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid denomination",
+			func() {
+				stats.Denom = "1"
+			},
+			false,
+		},
+		{
+			"time bucket not aligned to the bucket duration",
+			func() {
+				stats.BucketStart = 86401
+			},
+			false,
+		},
+		{
+			"nil fees earned",
+			func() {
+				stats.FeesEarned = sdkmath.Int{}
+			},
+			false,
+		},
+		{
+			"negative fees refunded",
+			func() {
+				stats.FeesRefunded = sdkmath.NewInt(-1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		stats = types.NewPayeeStatistics(defaultAccAddress, ibctesting.FirstChannelID, sdk.DefaultBondDenom, 0).Add(types.PacketStepAck, sdkmath.NewInt(200), sdkmath.NewInt(100))
+
+		tc.malleate()
+
+		err := stats.Validate()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
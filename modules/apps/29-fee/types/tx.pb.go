@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -39,8 +41,10 @@ type MsgRegisterPayee struct {
 	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// the relayer address
 	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
-	// the payee address
+	// the payee address, must be empty if payee_shares is set
 	Payee string `protobuf:"bytes,4,opt,name=payee,proto3" json:"payee,omitempty"`
+	// optional list of payees between which the fees paid out to the relayer are split in proportion to their weights
+	PayeeShares []PayeeShare `protobuf:"bytes,5,rep,name=payee_shares,json=payeeShares,proto3" json:"payee_shares"`
 }
 
 func (m *MsgRegisterPayee) Reset()         { *m = MsgRegisterPayee{} }
@@ -360,6 +364,346 @@ func (m *MsgPayPacketFeeAsyncResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPayPacketFeeAsyncResponse proto.InternalMessageInfo
 
+// MsgRecoverLockedFeeModule defines the request type for the RecoverLockedFeeModule rpc
+type MsgRecoverLockedFeeModule struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// optional address of the account from which the escrow deficit is topped up, which must be the signer. If empty,
+	// the fee module is only unlocked if the fee module account holds all the fees in escrow.
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+}
+
+func (m *MsgRecoverLockedFeeModule) Reset()         { *m = MsgRecoverLockedFeeModule{} }
+func (m *MsgRecoverLockedFeeModule) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverLockedFeeModule) ProtoMessage()    {}
+func (*MsgRecoverLockedFeeModule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{8}
+}
+func (m *MsgRecoverLockedFeeModule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverLockedFeeModule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverLockedFeeModule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverLockedFeeModule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverLockedFeeModule.Merge(m, src)
+}
+func (m *MsgRecoverLockedFeeModule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverLockedFeeModule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverLockedFeeModule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverLockedFeeModule proto.InternalMessageInfo
+
+// MsgRecoverLockedFeeModuleResponse defines the response type for the RecoverLockedFeeModule rpc
+type MsgRecoverLockedFeeModuleResponse struct {
+	// the escrow deficit topped up from the funder account
+	Deficit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deficit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deficit"`
+}
+
+func (m *MsgRecoverLockedFeeModuleResponse) Reset()         { *m = MsgRecoverLockedFeeModuleResponse{} }
+func (m *MsgRecoverLockedFeeModuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverLockedFeeModuleResponse) ProtoMessage()    {}
+func (*MsgRecoverLockedFeeModuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{9}
+}
+func (m *MsgRecoverLockedFeeModuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverLockedFeeModuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverLockedFeeModuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverLockedFeeModuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverLockedFeeModuleResponse.Merge(m, src)
+}
+func (m *MsgRecoverLockedFeeModuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverLockedFeeModuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverLockedFeeModuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverLockedFeeModuleResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverLockedFeeModuleResponse) GetDeficit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deficit
+	}
+	return nil
+}
+
+// MsgUpdateMinimumFee defines the request type for the UpdateMinimumFee rpc
+type MsgUpdateMinimumFee struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// unique port identifier
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier, empty to set the minimum fee for all channels of the port
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the minimum receive, acknowledgement and timeout fees. An empty fee removes the minimum fee.
+	Fee Fee `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgUpdateMinimumFee) Reset()         { *m = MsgUpdateMinimumFee{} }
+func (m *MsgUpdateMinimumFee) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinimumFee) ProtoMessage()    {}
+func (*MsgUpdateMinimumFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{10}
+}
+func (m *MsgUpdateMinimumFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinimumFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinimumFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinimumFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinimumFee.Merge(m, src)
+}
+func (m *MsgUpdateMinimumFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinimumFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinimumFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMinimumFee proto.InternalMessageInfo
+
+// MsgUpdateMinimumFeeResponse defines the response type for the UpdateMinimumFee rpc
+type MsgUpdateMinimumFeeResponse struct {
+}
+
+func (m *MsgUpdateMinimumFeeResponse) Reset()         { *m = MsgUpdateMinimumFeeResponse{} }
+func (m *MsgUpdateMinimumFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinimumFeeResponse) ProtoMessage()    {}
+func (*MsgUpdateMinimumFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{11}
+}
+func (m *MsgUpdateMinimumFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinimumFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinimumFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinimumFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinimumFeeResponse.Merge(m, src)
+}
+func (m *MsgUpdateMinimumFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinimumFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinimumFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMinimumFeeResponse proto.InternalMessageInfo
+
+// MsgPayClientUpdateFee defines the request type for the PayClientUpdateFee rpc
+type MsgPayClientUpdateFee struct {
+	// unique client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the fee paid to the relayer of each incentivized client update
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// the maximum number of incentivized client updates
+	MaxUpdates uint64 `protobuf:"varint,3,opt,name=max_updates,json=maxUpdates,proto3" json:"max_updates,omitempty"`
+	// the minimum time in nanoseconds between two incentivized client updates
+	RefreshInterval uint64 `protobuf:"varint,4,opt,name=refresh_interval,json=refreshInterval,proto3" json:"refresh_interval,omitempty"`
+	// the block timestamp in nanoseconds after which the unspent fees are refunded
+	ExpiryTimestamp uint64 `protobuf:"varint,5,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	// account address to refund fee if necessary
+	Signer string `protobuf:"bytes,6,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPayClientUpdateFee) Reset()         { *m = MsgPayClientUpdateFee{} }
+func (m *MsgPayClientUpdateFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPayClientUpdateFee) ProtoMessage()    {}
+func (*MsgPayClientUpdateFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{12}
+}
+func (m *MsgPayClientUpdateFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayClientUpdateFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayClientUpdateFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayClientUpdateFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayClientUpdateFee.Merge(m, src)
+}
+func (m *MsgPayClientUpdateFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayClientUpdateFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayClientUpdateFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayClientUpdateFee proto.InternalMessageInfo
+
+// MsgPayClientUpdateFeeResponse defines the response type for the PayClientUpdateFee rpc
+type MsgPayClientUpdateFeeResponse struct {
+}
+
+func (m *MsgPayClientUpdateFeeResponse) Reset()         { *m = MsgPayClientUpdateFeeResponse{} }
+func (m *MsgPayClientUpdateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayClientUpdateFeeResponse) ProtoMessage()    {}
+func (*MsgPayClientUpdateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{13}
+}
+func (m *MsgPayClientUpdateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayClientUpdateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayClientUpdateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayClientUpdateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayClientUpdateFeeResponse.Merge(m, src)
+}
+func (m *MsgPayClientUpdateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayClientUpdateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayClientUpdateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayClientUpdateFeeResponse proto.InternalMessageInfo
+
+// MsgPayChannelUpgradeFee defines the request type for the PayChannelUpgradeFee rpc
+type MsgPayChannelUpgradeFee struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the incentivized channel upgrade
+	UpgradeSequence uint64 `protobuf:"varint,3,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// fee encapsulates the try, ack, confirm and open fees of the channel upgrade handshake
+	Fee UpgradeFee `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+	// account address to refund fee if necessary
+	Signer string `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgPayChannelUpgradeFee) Reset()         { *m = MsgPayChannelUpgradeFee{} }
+func (m *MsgPayChannelUpgradeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgPayChannelUpgradeFee) ProtoMessage()    {}
+func (*MsgPayChannelUpgradeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{14}
+}
+func (m *MsgPayChannelUpgradeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayChannelUpgradeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayChannelUpgradeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayChannelUpgradeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayChannelUpgradeFee.Merge(m, src)
+}
+func (m *MsgPayChannelUpgradeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayChannelUpgradeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayChannelUpgradeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayChannelUpgradeFee proto.InternalMessageInfo
+
+// MsgPayChannelUpgradeFeeResponse defines the response type for the PayChannelUpgradeFee rpc
+type MsgPayChannelUpgradeFeeResponse struct {
+}
+
+func (m *MsgPayChannelUpgradeFeeResponse) Reset()         { *m = MsgPayChannelUpgradeFeeResponse{} }
+func (m *MsgPayChannelUpgradeFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayChannelUpgradeFeeResponse) ProtoMessage()    {}
+func (*MsgPayChannelUpgradeFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05c93128649f1b96, []int{15}
+}
+func (m *MsgPayChannelUpgradeFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayChannelUpgradeFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayChannelUpgradeFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayChannelUpgradeFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayChannelUpgradeFeeResponse.Merge(m, src)
+}
+func (m *MsgPayChannelUpgradeFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayChannelUpgradeFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayChannelUpgradeFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayChannelUpgradeFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterPayee)(nil), "ibc.applications.fee.v1.MsgRegisterPayee")
 	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "ibc.applications.fee.v1.MsgRegisterPayeeResponse")
@@ -369,56 +713,94 @@ func init() {
 	proto.RegisterType((*MsgPayPacketFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeResponse")
 	proto.RegisterType((*MsgPayPacketFeeAsync)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsync")
 	proto.RegisterType((*MsgPayPacketFeeAsyncResponse)(nil), "ibc.applications.fee.v1.MsgPayPacketFeeAsyncResponse")
+	proto.RegisterType((*MsgRecoverLockedFeeModule)(nil), "ibc.applications.fee.v1.MsgRecoverLockedFeeModule")
+	proto.RegisterType((*MsgRecoverLockedFeeModuleResponse)(nil), "ibc.applications.fee.v1.MsgRecoverLockedFeeModuleResponse")
+	proto.RegisterType((*MsgUpdateMinimumFee)(nil), "ibc.applications.fee.v1.MsgUpdateMinimumFee")
+	proto.RegisterType((*MsgUpdateMinimumFeeResponse)(nil), "ibc.applications.fee.v1.MsgUpdateMinimumFeeResponse")
+	proto.RegisterType((*MsgPayClientUpdateFee)(nil), "ibc.applications.fee.v1.MsgPayClientUpdateFee")
+	proto.RegisterType((*MsgPayClientUpdateFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayClientUpdateFeeResponse")
+	proto.RegisterType((*MsgPayChannelUpgradeFee)(nil), "ibc.applications.fee.v1.MsgPayChannelUpgradeFee")
+	proto.RegisterType((*MsgPayChannelUpgradeFeeResponse)(nil), "ibc.applications.fee.v1.MsgPayChannelUpgradeFeeResponse")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/tx.proto", fileDescriptor_05c93128649f1b96) }
 
 var fileDescriptor_05c93128649f1b96 = []byte{
-	// 699 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x1b, 0xfa, 0x23, 0xaf, 0x85, 0x12, 0xab, 0x22, 0xa9, 0x69, 0xd3, 0x62, 0x55, 0x50,
-	0x22, 0xc5, 0x6e, 0x82, 0x2a, 0x68, 0x04, 0x03, 0xad, 0xa8, 0x54, 0x89, 0x8a, 0x28, 0x23, 0x4b,
-	0xe5, 0x38, 0xaf, 0xae, 0x69, 0xec, 0xb3, 0x7c, 0x4e, 0x84, 0x37, 0xd4, 0x09, 0x31, 0xc1, 0x7f,
-	0xc0, 0xc8, 0xc0, 0xd0, 0x3f, 0xa3, 0x63, 0x47, 0x16, 0x10, 0x6a, 0x91, 0xfa, 0x1f, 0x30, 0xa3,
-	0x3b, 0x9f, 0x2d, 0x37, 0x69, 0xaa, 0x80, 0xc4, 0x62, 0xf9, 0xde, 0xf7, 0xdd, 0x77, 0xef, 0xfb,
-	0x74, 0x4f, 0x07, 0xcb, 0x76, 0xcb, 0xd4, 0x0d, 0xcf, 0xeb, 0xd8, 0xa6, 0x11, 0xd8, 0xc4, 0xa5,
-	0xfa, 0x3e, 0xa2, 0xde, 0xab, 0xea, 0xc1, 0x5b, 0xcd, 0xf3, 0x49, 0x40, 0xe4, 0x82, 0xdd, 0x32,
-	0xb5, 0x34, 0x43, 0xdb, 0x47, 0xd4, 0x7a, 0x55, 0x25, 0x6f, 0x38, 0xb6, 0x4b, 0x74, 0xfe, 0x8d,
-	0xb8, 0xca, 0x9c, 0x45, 0x2c, 0xc2, 0x7f, 0x75, 0xf6, 0x27, 0xaa, 0xf7, 0x86, 0x9d, 0xc1, 0x84,
-	0x52, 0x14, 0x93, 0xf8, 0xa8, 0x9b, 0x07, 0x86, 0xeb, 0x62, 0x87, 0xc1, 0xe2, 0x57, 0x50, 0x0a,
-	0x26, 0xa1, 0x0e, 0xa1, 0xba, 0x43, 0x2d, 0x06, 0x3a, 0xd4, 0x8a, 0x00, 0xf5, 0xab, 0x04, 0xb7,
-	0x77, 0xa9, 0xd5, 0x44, 0xcb, 0xa6, 0x01, 0xfa, 0x0d, 0x23, 0x44, 0x94, 0x0b, 0x30, 0xe9, 0x11,
-	0x3f, 0xd8, 0xb3, 0xdb, 0x45, 0x69, 0x59, 0x5a, 0xcd, 0x35, 0x27, 0xd8, 0x72, 0xa7, 0x2d, 0x2f,
-	0x02, 0x08, 0x5d, 0x86, 0x8d, 0x71, 0x2c, 0x27, 0x2a, 0x3b, 0x6d, 0xb9, 0x08, 0x93, 0x3e, 0x76,
-	0x8c, 0x10, 0xfd, 0x62, 0x96, 0x63, 0xf1, 0x52, 0x9e, 0x83, 0x71, 0x8f, 0x49, 0x17, 0x6f, 0xf0,
-	0x7a, 0xb4, 0xa8, 0xaf, 0xbd, 0xff, 0xbc, 0x94, 0x39, 0xba, 0x38, 0x2e, 0xc7, 0xbc, 0x0f, 0x17,
-	0xc7, 0xe5, 0xbb, 0x51, 0xab, 0x15, 0xda, 0x3e, 0xd4, 0xfb, 0x3b, 0x53, 0x15, 0x28, 0xf6, 0xd7,
-	0x9a, 0x48, 0x3d, 0xe2, 0x52, 0x54, 0xbf, 0x4b, 0xb0, 0x90, 0x02, 0xb7, 0x48, 0xd7, 0x0d, 0xd0,
-	0xf7, 0x0c, 0x3f, 0x08, 0xff, 0x97, 0xad, 0x0a, 0xc8, 0x66, 0xea, 0x98, 0xbd, 0xb4, 0xc7, 0xbc,
-	0xd9, 0xdf, 0x40, 0xfd, 0xe9, 0x55, 0x7e, 0x1f, 0x5c, 0xed, 0x77, 0xa0, 0x7d, 0xf5, 0x3e, 0xac,
-	0x5c, 0x87, 0x27, 0x39, 0x1c, 0x8d, 0xc1, 0xec, 0x2e, 0xb5, 0x1a, 0x46, 0xd8, 0x30, 0xcc, 0x43,
-	0x0c, 0xb6, 0x11, 0xe5, 0x0d, 0xc8, 0xee, 0x23, 0x72, 0xdb, 0xd3, 0xb5, 0x05, 0x6d, 0xc8, 0xad,
-	0xd4, 0xb6, 0x11, 0x37, 0x73, 0x27, 0x3f, 0x96, 0x32, 0x5f, 0x2e, 0x8e, 0xcb, 0x52, 0x93, 0xed,
-	0x91, 0x57, 0xe0, 0x16, 0x25, 0x5d, 0xdf, 0xc4, 0xbd, 0x38, 0xbc, 0x28, 0xa0, 0x99, 0xa8, 0xda,
-	0x88, 0x22, 0x2c, 0x43, 0x5e, 0xb0, 0x52, 0x49, 0x46, 0x69, 0xcd, 0x46, 0xc0, 0x56, 0x92, 0xe7,
-	0x1d, 0x98, 0xa0, 0xb6, 0xe5, 0xa2, 0x2f, 0x92, 0x12, 0x2b, 0x59, 0x81, 0x29, 0x91, 0x0b, 0x2d,
-	0x8e, 0x2f, 0x67, 0x57, 0x73, 0xcd, 0x64, 0x5d, 0xd7, 0xe2, 0xe8, 0x04, 0x99, 0x25, 0xa7, 0x5c,
-	0x4e, 0x2e, 0x6d, 0x58, 0x9d, 0x87, 0x42, 0x5f, 0x29, 0xc9, 0xe7, 0x97, 0x04, 0x73, 0x7d, 0xd8,
-	0x73, 0x1a, 0xba, 0xa6, 0xfc, 0x02, 0x72, 0x1e, 0xaf, 0xc4, 0x37, 0x64, 0xba, 0xb6, 0xc8, 0xa3,
-	0x62, 0xb3, 0xa5, 0xc5, 0x03, 0xd5, 0xab, 0x6a, 0xd1, 0xbe, 0x9d, 0x76, 0x3a, 0xab, 0x29, 0x4f,
-	0x14, 0xe5, 0x97, 0x00, 0x42, 0x86, 0x45, 0x3e, 0xc6, 0x75, 0xd4, 0xa1, 0x91, 0x27, 0x3d, 0xa4,
-	0xc5, 0x44, 0x1f, 0xdb, 0x88, 0xf5, 0xc7, 0xb1, 0xf1, 0x94, 0x28, 0x33, 0xbf, 0x34, 0xdc, 0x3c,
-	0x77, 0xa3, 0x96, 0xf8, 0x34, 0x0c, 0xd4, 0xe3, 0x18, 0x6a, 0xbf, 0xb3, 0x90, 0xdd, 0xa5, 0x96,
-	0xec, 0xc0, 0xcd, 0xcb, 0xd3, 0xff, 0x70, 0x68, 0xaf, 0xfd, 0xa3, 0xa7, 0x54, 0x47, 0xa6, 0xc6,
-	0xc7, 0xca, 0x9f, 0x24, 0x98, 0x1f, 0x3e, 0xa2, 0xeb, 0xa3, 0x08, 0x0e, 0x6c, 0x53, 0x9e, 0xfd,
-	0xd3, 0xb6, 0xa4, 0xa7, 0x37, 0x30, 0x73, 0x69, 0x5a, 0x56, 0xaf, 0x93, 0x4b, 0x33, 0x95, 0xb5,
-	0x51, 0x99, 0xc9, 0x59, 0x21, 0xe4, 0x07, 0x6f, 0x5e, 0x65, 0x54, 0x19, 0x4e, 0x57, 0xd6, 0xff,
-	0x8a, 0x1e, 0x1f, 0xad, 0x8c, 0xbf, 0x63, 0x97, 0x6b, 0xf3, 0xd5, 0xc9, 0x59, 0x49, 0x3a, 0x3d,
-	0x2b, 0x49, 0x3f, 0xcf, 0x4a, 0xd2, 0xc7, 0xf3, 0x52, 0xe6, 0xf4, 0xbc, 0x94, 0xf9, 0x76, 0x5e,
-	0xca, 0xbc, 0x5e, 0xb7, 0xec, 0xe0, 0xa0, 0xdb, 0xd2, 0x4c, 0xe2, 0xe8, 0xe2, 0xc1, 0xb0, 0x5b,
-	0x66, 0xc5, 0x22, 0x7a, 0xef, 0x89, 0xee, 0x90, 0x76, 0xb7, 0x83, 0x94, 0xbd, 0x45, 0x54, 0xaf,
-	0x6d, 0x54, 0xd8, 0x33, 0x14, 0x84, 0x1e, 0xd2, 0xd6, 0x04, 0x7f, 0x4a, 0x1e, 0xfd, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x92, 0x13, 0xd2, 0x0f, 0x07, 0x00, 0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0xe1, 0x6b, 0xf3, 0xa0, 0x05, 0x5c, 0xba, 0x04, 0xef, 0x92, 0x80, 0xbb, 0x6a, 0x01,
+	0x15, 0x1b, 0x52, 0xd8, 0xee, 0x46, 0xad, 0xd4, 0x82, 0x1a, 0x09, 0x89, 0xa8, 0x28, 0xdb, 0xbd,
+	0x54, 0x95, 0x22, 0xc7, 0x7e, 0x31, 0x2e, 0xf1, 0x47, 0x3d, 0x4e, 0x44, 0xa4, 0x1e, 0x2a, 0x4e,
+	0xab, 0x3d, 0x54, 0xed, 0xb5, 0xa7, 0x1e, 0xab, 0xaa, 0x07, 0xfe, 0x8c, 0x3d, 0xee, 0xb1, 0x97,
+	0x7e, 0x08, 0x2a, 0x71, 0xeb, 0x1f, 0x50, 0xf5, 0x50, 0xcd, 0x78, 0x6c, 0x8c, 0xf3, 0x01, 0x59,
+	0xa9, 0x17, 0xe4, 0x79, 0xef, 0x37, 0x6f, 0xde, 0xfb, 0xbd, 0x99, 0xdf, 0x23, 0xb0, 0x6c, 0xd5,
+	0x75, 0x55, 0xf3, 0xbc, 0xa6, 0xa5, 0x6b, 0x81, 0xe5, 0x3a, 0x44, 0x6d, 0x20, 0xaa, 0xed, 0x2d,
+	0x35, 0x38, 0x51, 0x3c, 0xdf, 0x0d, 0x5c, 0x71, 0xc1, 0xaa, 0xeb, 0x4a, 0x12, 0xa1, 0x34, 0x10,
+	0x95, 0xf6, 0x96, 0x34, 0xa7, 0xd9, 0x96, 0xe3, 0xaa, 0xec, 0x6f, 0x88, 0x95, 0xe6, 0x4d, 0xd7,
+	0x74, 0xd9, 0xa7, 0x4a, 0xbf, 0xb8, 0x75, 0xa5, 0xdf, 0x19, 0x34, 0x50, 0x02, 0xa2, 0xbb, 0x3e,
+	0xaa, 0xfa, 0x91, 0xe6, 0x38, 0xd8, 0xa4, 0x6e, 0xfe, 0xc9, 0x21, 0x0b, 0xba, 0x4b, 0x6c, 0x97,
+	0xa8, 0x36, 0x31, 0xa9, 0xd3, 0x26, 0x26, 0x77, 0xe4, 0xb9, 0xa3, 0xae, 0x11, 0x1a, 0xb5, 0x8e,
+	0x81, 0xb6, 0xa5, 0xea, 0xae, 0xe5, 0x84, 0x7e, 0xf9, 0x1f, 0x01, 0x66, 0x2b, 0xc4, 0xac, 0xa2,
+	0x69, 0x91, 0x00, 0xfd, 0x43, 0xad, 0x83, 0x28, 0x2e, 0xc0, 0xa4, 0xe7, 0xfa, 0x41, 0xcd, 0x32,
+	0x72, 0xc2, 0xb2, 0xb0, 0x9a, 0xad, 0x4e, 0xd0, 0xe5, 0xbe, 0x21, 0x2e, 0x01, 0xf0, 0x73, 0xa9,
+	0x2f, 0xc3, 0x7c, 0x59, 0x6e, 0xd9, 0x37, 0xc4, 0x1c, 0x4c, 0xfa, 0xd8, 0xd4, 0x3a, 0xe8, 0xe7,
+	0x46, 0x99, 0x2f, 0x5a, 0x8a, 0xf3, 0x30, 0xee, 0xd1, 0xd0, 0xb9, 0x31, 0x66, 0x0f, 0x17, 0xe2,
+	0x01, 0x4c, 0xb3, 0x8f, 0x1a, 0x39, 0xd2, 0x7c, 0x24, 0xb9, 0xf1, 0xe5, 0xd1, 0xd5, 0xa9, 0xe2,
+	0x5b, 0x4a, 0x1f, 0x52, 0x15, 0x96, 0xdd, 0x13, 0x8a, 0xdd, 0x1d, 0x7b, 0xf1, 0x7b, 0x61, 0xa4,
+	0x3a, 0xe5, 0xc5, 0x16, 0x52, 0xda, 0x7c, 0xf6, 0x63, 0x61, 0xe4, 0xf4, 0xf2, 0x6c, 0x3d, 0x3a,
+	0xf5, 0xf9, 0xe5, 0xd9, 0xfa, 0xbd, 0xb0, 0xfe, 0x0d, 0x62, 0x1c, 0xab, 0xe9, 0x3a, 0x65, 0x09,
+	0x72, 0x69, 0x5b, 0x15, 0x89, 0xe7, 0x3a, 0x04, 0xe5, 0xdf, 0x04, 0xb8, 0x9f, 0x70, 0xee, 0xb9,
+	0x2d, 0x27, 0x40, 0xdf, 0xd3, 0xfc, 0xa0, 0xf3, 0x7f, 0x91, 0xb4, 0x01, 0xa2, 0x9e, 0x38, 0xa6,
+	0x96, 0x64, 0x6c, 0x4e, 0x4f, 0x27, 0x50, 0xfa, 0xa0, 0x57, 0xbd, 0xef, 0xf4, 0xae, 0xb7, 0x2b,
+	0x7d, 0xf9, 0x6d, 0x78, 0x30, 0xc8, 0x1f, 0xf3, 0x70, 0x9a, 0x81, 0x99, 0x0a, 0x31, 0x0f, 0xb5,
+	0xce, 0xa1, 0xa6, 0x1f, 0x63, 0x50, 0x46, 0x14, 0x1f, 0xc3, 0x68, 0x03, 0x91, 0x95, 0x3d, 0x55,
+	0xbc, 0xdf, 0xb7, 0x5d, 0x65, 0xc4, 0xdd, 0x2c, 0xed, 0xd3, 0x4f, 0x97, 0x67, 0xeb, 0x42, 0x95,
+	0xee, 0x11, 0x1f, 0xc0, 0xeb, 0xc4, 0x6d, 0xf9, 0x3a, 0xd6, 0x22, 0xf2, 0x42, 0x82, 0xa6, 0x43,
+	0xeb, 0x61, 0x48, 0xe1, 0x3a, 0xcc, 0x71, 0x54, 0x82, 0xc9, 0x90, 0xad, 0x99, 0xd0, 0xb1, 0x17,
+	0xf3, 0x79, 0x17, 0x26, 0x88, 0x65, 0x3a, 0xe8, 0x73, 0xa6, 0xf8, 0x4a, 0x94, 0xe0, 0x0e, 0xe7,
+	0x25, 0xbc, 0x58, 0xd9, 0x6a, 0xbc, 0x2e, 0x29, 0x11, 0x75, 0x1c, 0x4c, 0x99, 0x93, 0xae, 0x33,
+	0x97, 0x2c, 0x58, 0x5e, 0x84, 0x85, 0x94, 0x29, 0xe6, 0xe7, 0x2f, 0x01, 0xe6, 0x53, 0xbe, 0x8f,
+	0x49, 0xc7, 0xd1, 0xc5, 0x4f, 0x20, 0xeb, 0x31, 0x4b, 0x74, 0x43, 0xa6, 0x8a, 0x4b, 0x8c, 0x2a,
+	0xfa, 0x92, 0x95, 0xe8, 0xf9, 0xb2, 0x5b, 0x4d, 0x51, 0xfb, 0x46, 0x92, 0xab, 0x3b, 0x1e, 0x37,
+	0x8a, 0x07, 0x00, 0x3c, 0x0c, 0xa5, 0x3c, 0xc3, 0xe2, 0xc8, 0x03, 0x5e, 0x08, 0xcf, 0x21, 0x19,
+	0x8c, 0xe7, 0x51, 0x46, 0x2c, 0xbd, 0x1f, 0x15, 0x9e, 0x08, 0x4a, 0x8b, 0x2f, 0xf4, 0x2f, 0x9e,
+	0x55, 0x23, 0xe7, 0xe1, 0x7e, 0x2f, 0x7b, 0x4c, 0xc3, 0x17, 0xb0, 0xc8, 0xae, 0x93, 0xee, 0xb6,
+	0xd1, 0x3f, 0x70, 0xf5, 0x63, 0x34, 0xca, 0x88, 0x15, 0xd7, 0x68, 0x35, 0x31, 0xd1, 0x22, 0xe1,
+	0x5a, 0x8b, 0xee, 0xc2, 0x44, 0xa3, 0xe5, 0x18, 0xe8, 0xf3, 0x4b, 0xc0, 0x57, 0xa5, 0x99, 0x54,
+	0x7b, 0xe4, 0xe7, 0x02, 0xac, 0xf4, 0x0d, 0x1f, 0xe5, 0x20, 0x22, 0x4c, 0x1a, 0xd8, 0xb0, 0x74,
+	0x2b, 0xc8, 0x09, 0x4c, 0x49, 0x16, 0x95, 0xb0, 0x2c, 0x85, 0xaa, 0x9f, 0xc2, 0xd5, 0x4f, 0xd9,
+	0x73, 0x2d, 0x67, 0x77, 0x93, 0xd2, 0xf3, 0xf3, 0x1f, 0x85, 0x55, 0xd3, 0x0a, 0x8e, 0x5a, 0x75,
+	0x45, 0x77, 0x6d, 0x95, 0x4b, 0x65, 0x82, 0x8a, 0xa0, 0xe3, 0x21, 0x61, 0x1b, 0x48, 0x35, 0x8a,
+	0x2d, 0xff, 0x22, 0xc0, 0x1b, 0x15, 0x62, 0x3e, 0xf5, 0x0c, 0x2d, 0xc0, 0x8a, 0xe5, 0x58, 0x76,
+	0xcb, 0x2e, 0x63, 0xff, 0x2a, 0x13, 0x42, 0x91, 0x19, 0x20, 0x14, 0xa3, 0x69, 0xa1, 0xd8, 0x0e,
+	0x5f, 0xd9, 0xd8, 0x2d, 0x5e, 0x59, 0xa8, 0x86, 0x14, 0xde, 0xcd, 0xdd, 0x12, 0xdc, 0xeb, 0x91,
+	0x6d, 0xdc, 0xb8, 0xbf, 0x33, 0xf0, 0x66, 0xd8, 0xd9, 0xbd, 0xa6, 0x85, 0x4e, 0x10, 0x02, 0x69,
+	0x3d, 0xf7, 0x20, 0xab, 0x33, 0xd3, 0x95, 0xc4, 0xdd, 0x09, 0x0d, 0xfb, 0x86, 0x48, 0xc2, 0xe4,
+	0x32, 0x37, 0xf1, 0x5c, 0x1e, 0x96, 0xe7, 0x1f, 0x2e, 0xcf, 0xd6, 0xa7, 0x9b, 0x68, 0x6a, 0x7a,
+	0xa7, 0x46, 0xe7, 0x14, 0x49, 0x88, 0x47, 0x01, 0xa6, 0x6c, 0xed, 0xa4, 0xd6, 0x62, 0x29, 0x12,
+	0xc6, 0xd8, 0x58, 0x15, 0x6c, 0xed, 0x24, 0x4c, 0x9a, 0x88, 0x6b, 0x30, 0xeb, 0x63, 0xc3, 0x47,
+	0x72, 0x54, 0xb3, 0xa8, 0x9e, 0xb5, 0xb5, 0x26, 0xe3, 0x6f, 0xac, 0x3a, 0xc3, 0xed, 0xfb, 0xdc,
+	0x4c, 0xa1, 0x78, 0xe2, 0x59, 0x7e, 0xa7, 0x16, 0x58, 0x36, 0x92, 0x40, 0xb3, 0xbd, 0xdc, 0x78,
+	0x08, 0x0d, 0xed, 0x9f, 0x45, 0xe6, 0x44, 0x63, 0x27, 0x92, 0x8d, 0x2d, 0x6d, 0xf7, 0x50, 0x91,
+	0xe5, 0xae, 0x87, 0x94, 0xa2, 0x55, 0x2e, 0xc0, 0x52, 0x4f, 0x47, 0xdc, 0x91, 0x6f, 0x33, 0x91,
+	0xda, 0x70, 0x91, 0x7b, 0xea, 0x99, 0xbe, 0x66, 0xb0, 0x9e, 0xbc, 0xea, 0xd0, 0x59, 0x83, 0xd9,
+	0x56, 0x18, 0xa5, 0x46, 0xf0, 0xab, 0x16, 0x3a, 0x3a, 0x72, 0xfa, 0x66, 0xb8, 0xfd, 0x09, 0x37,
+	0x8b, 0x1f, 0x25, 0xaf, 0x5d, 0xff, 0x59, 0x7c, 0x95, 0x54, 0x97, 0xc6, 0x5f, 0xf1, 0x35, 0x7e,
+	0x8d, 0xaf, 0x87, 0x3d, 0xf8, 0x92, 0xbb, 0xf9, 0x4a, 0x17, 0x2d, 0xaf, 0x40, 0xa1, 0x8f, 0x2b,
+	0xe2, 0xac, 0xf8, 0xef, 0x24, 0x8c, 0x56, 0x88, 0x29, 0xda, 0xf0, 0xda, 0xf5, 0x7f, 0x65, 0xd6,
+	0xfa, 0x16, 0x90, 0x9e, 0xfc, 0xd2, 0xd6, 0xad, 0xa1, 0xb1, 0xe2, 0x7c, 0x2f, 0xc0, 0x62, 0xff,
+	0xff, 0x10, 0x76, 0x6e, 0x13, 0xb0, 0x6b, 0x9b, 0xf4, 0xe1, 0x2b, 0x6d, 0x8b, 0x73, 0xfa, 0x12,
+	0xa6, 0xaf, 0x0d, 0xeb, 0xd5, 0x41, 0xe1, 0x92, 0x48, 0x69, 0xf3, 0xb6, 0xc8, 0xf8, 0xac, 0x0e,
+	0xcc, 0x75, 0x0f, 0xbe, 0x8d, 0xdb, 0x86, 0x61, 0x70, 0x69, 0x67, 0x28, 0x78, 0x7c, 0xf4, 0x33,
+	0x01, 0xee, 0xf6, 0x19, 0x37, 0xc5, 0xc1, 0x04, 0xf6, 0xda, 0x23, 0x95, 0x86, 0xdf, 0x13, 0xa7,
+	0xd2, 0x86, 0xd9, 0xae, 0x61, 0xf0, 0xee, 0xa0, 0x78, 0x69, 0xb4, 0xb4, 0x3d, 0x0c, 0x3a, 0x3e,
+	0xf7, 0x6b, 0x10, 0x7b, 0xc8, 0xb6, 0x72, 0x03, 0x9f, 0x29, 0xbc, 0xf4, 0x70, 0x38, 0x7c, 0x7c,
+	0xfa, 0xa9, 0x00, 0xf3, 0x3d, 0x35, 0xea, 0xa6, 0x6b, 0xd4, 0xb5, 0x43, 0x7a, 0x34, 0xec, 0x8e,
+	0x28, 0x09, 0x69, 0xfc, 0x1b, 0x2a, 0x3b, 0xbb, 0x9f, 0xbe, 0x38, 0xcf, 0x0b, 0x2f, 0xcf, 0xf3,
+	0xc2, 0x9f, 0xe7, 0x79, 0xe1, 0xbb, 0x8b, 0xfc, 0xc8, 0xcb, 0x8b, 0xfc, 0xc8, 0xaf, 0x17, 0xf9,
+	0x91, 0xcf, 0x77, 0xba, 0xe7, 0x8e, 0x55, 0xd7, 0x37, 0x4c, 0x57, 0x6d, 0x3f, 0x52, 0x6d, 0xd6,
+	0x47, 0x42, 0x7f, 0x7e, 0x11, 0xb5, 0xf8, 0x78, 0x83, 0xfe, 0xf2, 0x62, 0xa3, 0xa8, 0x3e, 0xc1,
+	0x7e, 0x1d, 0xbd, 0xf7, 0xdf, 0x00, 0xc5, 0x6f, 0x79, 0xb1, 0x02, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +837,22 @@ type MsgClient interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAsync, opts ...grpc.CallOption) (*MsgPayPacketFeeAsyncResponse, error)
+	// RecoverLockedFeeModule defines a rpc handler method for MsgRecoverLockedFeeModule
+	// RecoverLockedFeeModule reconciles the fees in escrow against the balance of the fee module account, optionally
+	// tops up the escrow deficit from a funder account and unlocks the fee module. It may only be called by the authority.
+	RecoverLockedFeeModule(ctx context.Context, in *MsgRecoverLockedFeeModule, opts ...grpc.CallOption) (*MsgRecoverLockedFeeModuleResponse, error)
+	// UpdateMinimumFee defines a rpc handler method for MsgUpdateMinimumFee
+	// UpdateMinimumFee sets the minimum fees which must be escrowed for a packet when it is sent over a fee enabled
+	// channel, either for a single channel or for all channels of a port. It may only be called by the authority.
+	UpdateMinimumFee(ctx context.Context, in *MsgUpdateMinimumFee, opts ...grpc.CallOption) (*MsgUpdateMinimumFeeResponse, error)
+	// PayClientUpdateFee defines a rpc handler method for MsgPayClientUpdateFee
+	// PayClientUpdateFee is an open callback that may be called by any module/user that wishes to escrow funds in order
+	// to incentivize the relaying of a bounded number of updates of a light client, paid at most once per refresh interval
+	PayClientUpdateFee(ctx context.Context, in *MsgPayClientUpdateFee, opts ...grpc.CallOption) (*MsgPayClientUpdateFeeResponse, error)
+	// PayChannelUpgradeFee defines a rpc handler method for MsgPayChannelUpgradeFee
+	// PayChannelUpgradeFee is an open callback that may be called by any module/user that wishes to escrow funds in
+	// order to incentivize the relaying of the try, ack, confirm and open messages of a channel upgrade handshake
+	PayChannelUpgradeFee(ctx context.Context, in *MsgPayChannelUpgradeFee, opts ...grpc.CallOption) (*MsgPayChannelUpgradeFeeResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +899,42 @@ func (c *msgClient) PayPacketFeeAsync(ctx context.Context, in *MsgPayPacketFeeAs
 	return out, nil
 }
 
+func (c *msgClient) RecoverLockedFeeModule(ctx context.Context, in *MsgRecoverLockedFeeModule, opts ...grpc.CallOption) (*MsgRecoverLockedFeeModuleResponse, error) {
+	out := new(MsgRecoverLockedFeeModuleResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/RecoverLockedFeeModule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMinimumFee(ctx context.Context, in *MsgUpdateMinimumFee, opts ...grpc.CallOption) (*MsgUpdateMinimumFeeResponse, error) {
+	out := new(MsgUpdateMinimumFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/UpdateMinimumFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PayClientUpdateFee(ctx context.Context, in *MsgPayClientUpdateFee, opts ...grpc.CallOption) (*MsgPayClientUpdateFeeResponse, error) {
+	out := new(MsgPayClientUpdateFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayClientUpdateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PayChannelUpgradeFee(ctx context.Context, in *MsgPayChannelUpgradeFee, opts ...grpc.CallOption) (*MsgPayChannelUpgradeFeeResponse, error) {
+	out := new(MsgPayChannelUpgradeFeeResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.fee.v1.Msg/PayChannelUpgradeFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterPayee defines a rpc handler method for MsgRegisterPayee
@@ -525,6 +959,22 @@ type MsgServer interface {
 	// PayPacketFeeAsync is an open callback that may be called by any module/user that wishes to escrow funds in order to
 	// incentivize the relaying of a known packet (i.e. at a particular sequence)
 	PayPacketFeeAsync(context.Context, *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error)
+	// RecoverLockedFeeModule defines a rpc handler method for MsgRecoverLockedFeeModule
+	// RecoverLockedFeeModule reconciles the fees in escrow against the balance of the fee module account, optionally
+	// tops up the escrow deficit from a funder account and unlocks the fee module. It may only be called by the authority.
+	RecoverLockedFeeModule(context.Context, *MsgRecoverLockedFeeModule) (*MsgRecoverLockedFeeModuleResponse, error)
+	// UpdateMinimumFee defines a rpc handler method for MsgUpdateMinimumFee
+	// UpdateMinimumFee sets the minimum fees which must be escrowed for a packet when it is sent over a fee enabled
+	// channel, either for a single channel or for all channels of a port. It may only be called by the authority.
+	UpdateMinimumFee(context.Context, *MsgUpdateMinimumFee) (*MsgUpdateMinimumFeeResponse, error)
+	// PayClientUpdateFee defines a rpc handler method for MsgPayClientUpdateFee
+	// PayClientUpdateFee is an open callback that may be called by any module/user that wishes to escrow funds in order
+	// to incentivize the relaying of a bounded number of updates of a light client, paid at most once per refresh interval
+	PayClientUpdateFee(context.Context, *MsgPayClientUpdateFee) (*MsgPayClientUpdateFeeResponse, error)
+	// PayChannelUpgradeFee defines a rpc handler method for MsgPayChannelUpgradeFee
+	// PayChannelUpgradeFee is an open callback that may be called by any module/user that wishes to escrow funds in
+	// order to incentivize the relaying of the try, ack, confirm and open messages of a channel upgrade handshake
+	PayChannelUpgradeFee(context.Context, *MsgPayChannelUpgradeFee) (*MsgPayChannelUpgradeFeeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -543,6 +993,18 @@ func (*UnimplementedMsgServer) PayPacketFee(ctx context.Context, req *MsgPayPack
 func (*UnimplementedMsgServer) PayPacketFeeAsync(ctx context.Context, req *MsgPayPacketFeeAsync) (*MsgPayPacketFeeAsyncResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayPacketFeeAsync not implemented")
 }
+func (*UnimplementedMsgServer) RecoverLockedFeeModule(ctx context.Context, req *MsgRecoverLockedFeeModule) (*MsgRecoverLockedFeeModuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverLockedFeeModule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinimumFee(ctx context.Context, req *MsgUpdateMinimumFee) (*MsgUpdateMinimumFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinimumFee not implemented")
+}
+func (*UnimplementedMsgServer) PayClientUpdateFee(ctx context.Context, req *MsgPayClientUpdateFee) (*MsgPayClientUpdateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayClientUpdateFee not implemented")
+}
+func (*UnimplementedMsgServer) PayChannelUpgradeFee(ctx context.Context, req *MsgPayChannelUpgradeFee) (*MsgPayChannelUpgradeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayChannelUpgradeFee not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -620,39 +1082,127 @@ func _Msg_PayPacketFeeAsync_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.fee.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterPayee",
-			Handler:    _Msg_RegisterPayee_Handler,
-		},
-		{
-			MethodName: "RegisterCounterpartyPayee",
-			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
-		},
-		{
-			MethodName: "PayPacketFee",
-			Handler:    _Msg_PayPacketFee_Handler,
-		},
-		{
-			MethodName: "PayPacketFeeAsync",
-			Handler:    _Msg_PayPacketFeeAsync_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/fee/v1/tx.proto",
+func _Msg_RecoverLockedFeeModule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverLockedFeeModule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverLockedFeeModule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/RecoverLockedFeeModule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverLockedFeeModule(ctx, req.(*MsgRecoverLockedFeeModule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateMinimumFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinimumFee)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinimumFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/UpdateMinimumFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinimumFee(ctx, req.(*MsgUpdateMinimumFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayClientUpdateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayClientUpdateFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayClientUpdateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayClientUpdateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayClientUpdateFee(ctx, req.(*MsgPayClientUpdateFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayChannelUpgradeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayChannelUpgradeFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayChannelUpgradeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.fee.v1.Msg/PayChannelUpgradeFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayChannelUpgradeFee(ctx, req.(*MsgPayChannelUpgradeFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.fee.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterPayee",
+			Handler:    _Msg_RegisterPayee_Handler,
+		},
+		{
+			MethodName: "RegisterCounterpartyPayee",
+			Handler:    _Msg_RegisterCounterpartyPayee_Handler,
+		},
+		{
+			MethodName: "PayPacketFee",
+			Handler:    _Msg_PayPacketFee_Handler,
+		},
+		{
+			MethodName: "PayPacketFeeAsync",
+			Handler:    _Msg_PayPacketFeeAsync_Handler,
+		},
+		{
+			MethodName: "RecoverLockedFeeModule",
+			Handler:    _Msg_RecoverLockedFeeModule_Handler,
+		},
+		{
+			MethodName: "UpdateMinimumFee",
+			Handler:    _Msg_UpdateMinimumFee_Handler,
+		},
+		{
+			MethodName: "PayClientUpdateFee",
+			Handler:    _Msg_PayClientUpdateFee_Handler,
+		},
+		{
+			MethodName: "PayChannelUpgradeFee",
+			Handler:    _Msg_PayChannelUpgradeFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/fee/v1/tx.proto",
+}
+
+func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
@@ -665,6 +1215,20 @@ func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PayeeShares) > 0 {
+		for iNdEx := len(m.PayeeShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayeeShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Payee) > 0 {
 		i -= len(m.Payee)
 		copy(dAtA[i:], m.Payee)
@@ -945,152 +1509,1371 @@ func (m *MsgPayPacketFeeAsyncResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRecoverLockedFeeModule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRecoverLockedFeeModule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverLockedFeeModule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Payee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRecoverLockedFeeModuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRecoverLockedFeeModuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverLockedFeeModuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Relayer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CounterpartyPayee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Deficit) > 0 {
+		for iNdEx := len(m.Deficit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deficit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateMinimumFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPayPacketFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateMinimumFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinimumFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.SourcePortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.SourceChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPayPacketFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateMinimumFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPayPacketFeeAsync) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateMinimumFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinimumFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PacketFee.Size()
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayClientUpdateFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayClientUpdateFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayClientUpdateFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RefreshInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RefreshInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxUpdates != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxUpdates))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayClientUpdateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayClientUpdateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayClientUpdateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayChannelUpgradeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayChannelUpgradeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayChannelUpgradeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UpgradeSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayChannelUpgradeFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayChannelUpgradeFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayChannelUpgradeFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PayeeShares) > 0 {
+		for _, e := range m.PayeeShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCounterpartyPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayPacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SourcePortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPayPacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPayPacketFeeAsync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PacketFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPayPacketFeeAsyncResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverLockedFeeModule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverLockedFeeModuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deficit) > 0 {
+		for _, e := range m.Deficit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateMinimumFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateMinimumFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayClientUpdateFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxUpdates != 0 {
+		n += 1 + sovTx(uint64(m.MaxUpdates))
+	}
+	if m.RefreshInterval != 0 {
+		n += 1 + sovTx(uint64(m.RefreshInterval))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTimestamp))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayClientUpdateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayChannelUpgradeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpgradeSequence != 0 {
+		n += 1 + sovTx(uint64(m.UpgradeSequence))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayChannelUpgradeFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayeeShares = append(m.PayeeShares, PayeeShare{})
+			if err := m.PayeeShares[len(m.PayeeShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1113,17 +2896,17 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1133,29 +2916,30 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1165,27 +2949,128 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverLockedFeeModule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverLockedFeeModule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverLockedFeeModule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1213,11 +3098,11 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1245,7 +3130,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payee = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1268,7 +3153,7 @@ func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecoverLockedFeeModuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1291,12 +3176,46 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecoverLockedFeeModuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecoverLockedFeeModuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deficit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deficit = append(m.Deficit, types1.Coin{})
+			if err := m.Deficit[len(m.Deficit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1318,7 +3237,7 @@ func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinimumFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,15 +3260,15 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinimumFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinimumFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1377,11 +3296,11 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1409,11 +3328,11 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1441,13 +3360,13 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayer = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1457,23 +3376,24 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1496,7 +3416,7 @@ func (m *MsgRegisterCounterpartyPayee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMinimumFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1519,10 +3439,10 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMinimumFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterCounterpartyPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMinimumFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1546,7 +3466,7 @@ func (m *MsgRegisterCounterpartyPayeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
+func (m *MsgPayClientUpdateFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +3489,17 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayClientUpdateFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayClientUpdateFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1589,30 +3509,29 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1622,29 +3541,31 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourcePortId = string(dAtA[iNdEx:postIndex])
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdates", wireType)
 			}
-			var stringLen uint64
+			m.MaxUpdates = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1654,29 +3575,16 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxUpdates |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshInterval", wireType)
 			}
-			var stringLen uint64
+			m.RefreshInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1686,27 +3594,33 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RefreshInterval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1734,7 +3648,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1757,7 +3671,7 @@ func (m *MsgPayPacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayClientUpdateFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +3694,10 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayClientUpdateFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayClientUpdateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1807,7 +3721,7 @@ func (m *MsgPayPacketFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
+func (m *MsgPayChannelUpgradeFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,17 +3744,17 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayChannelUpgradeFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayChannelUpgradeFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1850,28 +3764,78 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeSequence", wireType)
+			}
+			m.UpgradeSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1898,10 +3862,42 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1923,7 +3919,7 @@ func (m *MsgPayPacketFeeAsync) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayChannelUpgradeFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1946,10 +3942,10 @@ func (m *MsgPayPacketFeeAsyncResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayChannelUpgradeFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPayPacketFeeAsyncResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayChannelUpgradeFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
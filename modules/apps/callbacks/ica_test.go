@@ -198,7 +198,7 @@ func (s *CallbacksTestSuite) buildICAMsgDelegatePacketData(icaAddress string, me
 	}
 
 	// ensure chainB is allowed to execute stakingtypes.MsgDelegate
-	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)})
+	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)}, nil)
 	GetSimApp(s.chainB).ICAHostKeeper.SetParams(s.chainB.GetContext(), params)
 
 	data, err := icatypes.SerializeCosmosTx(GetSimApp(s.chainA).AppCodec(), []proto.Message{msgDelegate}, icatypes.EncodingProtobuf)
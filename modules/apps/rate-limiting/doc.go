@@ -0,0 +1,11 @@
+/*
+Package ratelimiting implements an ICS-20 middleware which limits the net amount
+of a denom that may be sent or received over a channel within a rolling window
+of time. Quotas are set by governance per channel and denom, and are measured either as a
+percentage of the total supply of the denom or as an absolute amount. Transfers
+which would exceed a quota are rejected: outgoing packets fail to be sent and
+incoming packets are acknowledged with an error. The middleware follows the
+pattern specified in the ICS 30 specification
+(https://github.com/cosmos/ibc/tree/main/spec/app/ics-030-middleware).
+*/
+package ratelimiting
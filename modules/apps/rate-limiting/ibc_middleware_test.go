@@ -0,0 +1,205 @@
+package ratelimiting_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *RateLimitingTestSuite) TestOnRecvPacket() {
+	var (
+		denom string
+		coin  sdk.Coin
+	)
+
+	// sendToChainA sends the provided coin from chainB to chainA and returns the acknowledgement written on chainA
+	sendToChainA := func(coin sdk.Coin) []byte {
+		msg := transfertypes.NewMsgTransfer(
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			coin, suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "",
+		)
+
+		res, err := suite.chainB.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		suite.Require().NoError(err)
+
+		_, ack, err := suite.path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err)
+
+		return ack
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: vouchers received within quota",
+			func() {},
+			true,
+		},
+		{
+			"success: native tokens received within quota",
+			func() {
+				// send native tokens from chainA to chainB and receive them back
+				msg := transfertypes.NewMsgTransfer(
+					suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+					sdk.NewCoin(sdk.DefaultBondDenom, coin.Amount), suite.chainA.SenderAccount.GetAddress().String(),
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+				)
+
+				res, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+				suite.Require().NoError(err)
+
+				err = suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				denom = sdk.DefaultBondDenom
+				coin.Denom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			},
+			true,
+		},
+		{
+			"failure: absolute quota exceeded",
+			func() {
+				coin.Amount = coin.Amount.AddRaw(1)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// by default chainB sends its native tokens, which are received as vouchers on chainA
+			denom = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+			coin = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			quota := types.NewQuota(types.ABSOLUTE, sdkmath.ZeroInt(), sdkmath.NewInt(100), time.Hour)
+			rateLimit := types.NewRateLimit(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, denom, quota, types.NewFlow(sdkmath.ZeroInt(), ctx.BlockTime()))
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+
+			ack := sendToChainA(coin)
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, denom)
+			suite.Require().True(found)
+
+			if tc.expPass {
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+				suite.Require().Equal(coin.Amount, rateLimit.Flow.Inflow)
+			} else {
+				suite.Require().Contains(string(ack), "error")
+				suite.Require().True(rateLimit.Flow.Inflow.IsZero())
+
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), denom)
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
+
+func (suite *RateLimitingTestSuite) TestOnAcknowledgementAndTimeoutPacket() {
+	amount := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		name string
+		// errorAck makes the receiving chain write an error acknowledgement
+		errorAck bool
+		// timeout times the packet out instead of relaying it
+		timeout bool
+		// laterAmount is sent once the bucket of the packet has left the rolling window, before the packet is relayed
+		laterAmount sdkmath.Int
+		expOutflow  sdkmath.Int
+	}{
+		{"success acknowledgement: outflow is kept", false, false, sdkmath.ZeroInt(), amount},
+		{"error acknowledgement: outflow is reverted", true, false, sdkmath.ZeroInt(), sdkmath.ZeroInt()},
+		{"timeout: outflow is reverted", false, true, sdkmath.ZeroInt(), sdkmath.ZeroInt()},
+		{"error acknowledgement once the bucket of the packet has left the rolling window: later outflow is kept", true, false, sdkmath.NewInt(30), sdkmath.NewInt(30)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			quota := types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(1000), sdkmath.ZeroInt(), time.Hour)
+			rateLimit := types.NewRateLimit(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota, types.NewFlow(sdkmath.ZeroInt(), ctx.BlockTime()))
+			suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+
+			receiver := suite.chainB.SenderAccount.GetAddress().String()
+			if tc.errorAck {
+				receiver = "invalid-address"
+			}
+
+			timeoutHeight := suite.chainB.GetTimeoutHeight()
+			if tc.timeout {
+				timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			}
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(),
+				receiver, timeoutHeight, 0, "",
+			)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().True(found)
+
+			if tc.laterAmount.IsPositive() {
+				suite.coordinator.IncrementTimeBy(time.Hour + time.Hour/types.BucketsPerPeriod)
+
+				msg := transfertypes.NewMsgTransfer(
+					suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+					sdk.NewCoin(sdk.DefaultBondDenom, tc.laterAmount), suite.chainA.SenderAccount.GetAddress().String(),
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+				)
+
+				_, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+			}
+
+			if tc.timeout {
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+			} else {
+				suite.Require().NoError(suite.path.RelayPacket(packet))
+			}
+
+			_, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().False(found)
+
+			rateLimit, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expOutflow, rateLimit.Flow.Outflow)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	ctx := suite.chainA.GetContext()
+
+	quota := types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(10), sdkmath.NewInt(20), defaultPeriod)
+	flow := types.NewFlow(sdkmath.NewInt(1000), ctx.BlockTime())
+	flow.Inflow = sdkmath.NewInt(5)
+	flow.Outflow = sdkmath.NewInt(50)
+
+	genesisState := types.NewGenesisState([]types.RateLimit{
+		types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, quota, flow),
+		types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", quota, flow),
+	}, []types.PendingSendPacket{
+		types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, ctx.BlockTime()),
+	})
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.InitGenesis(ctx, *genesisState)
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom")
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RateLimits[1], rateLimit)
+
+	pendingSendPacket, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetPendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PendingSendPackets[0], pendingSendPacket)
+
+	exported := suite.chainA.GetSimApp().RateLimitingKeeper.ExportGenesis(ctx)
+	suite.Require().ElementsMatch(genesisState.RateLimits, exported.RateLimits)
+	suite.Require().Equal(genesisState.PendingSendPackets, exported.PendingSendPackets)
+}
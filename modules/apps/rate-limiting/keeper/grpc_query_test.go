@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req        *types.QueryRateLimitRequest
+		expOutflow sdkmath.Int
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: flow leaves the rolling window once its bucket ends a period ago",
+			func() {
+				suite.coordinator.IncrementTimeBy(defaultPeriod + defaultPeriod/types.BucketsPerPeriod)
+				expOutflow = sdkmath.ZeroInt()
+			},
+			true,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				req.Denom = "uatom"
+			},
+			false,
+		},
+		{
+			"failure: rate limit not found for port",
+			func() {
+				req.PortId = ibctesting.MockPort
+			},
+			false,
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+
+			suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(50), sdkmath.ZeroInt())
+
+			expOutflow = sdkmath.NewInt(50)
+			req = &types.QueryRateLimitRequest{
+				PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimit(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expOutflow, res.RateLimit.Flow.Outflow)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	quota := types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod)
+	suite.setRateLimit(sdk.DefaultBondDenom, quota)
+	suite.setRateLimit("uatom", quota)
+
+	expRateLimits := suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext())
+
+	req := &types.QueryRateLimitsRequest{
+		Pagination: &query.PageRequest{
+			Limit:      1,
+			CountTotal: true,
+		},
+	}
+
+	res, err := suite.chainA.GetSimApp().RateLimitingKeeper.RateLimits(suite.chainA.GetContext(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRateLimits[:1], res.RateLimits)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	res, err = suite.chainA.GetSimApp().RateLimitingKeeper.RateLimits(suite.chainA.GetContext(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRateLimits[1:], res.RateLimits)
+}
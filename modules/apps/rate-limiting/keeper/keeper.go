@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// Middleware must implement the ICS4Wrapper interface so that it can wrap
+// the IBC channel logic for the underlying application.
+var _ porttypes.ICS4Wrapper = (*Keeper)(nil)
+
+// Keeper defines the IBC rate limiting keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	ics4Wrapper porttypes.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+
+	// the address capable of adding, resetting and removing rate limits. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new rate limiting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper, bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:         cdc,
+		storeKey:    key,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bankKeeper,
+		authority:   authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetAuthority returns the rate limiting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetRateLimit returns the rate limit of the provided denom over the provided port and channel, as stored in state.
+func (k Keeper) GetRateLimit(ctx sdk.Context, portID, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyRateLimit(portID, channelID, denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// SetRateLimit stores the provided rate limit in state.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.KeyRateLimit(rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom), bz)
+}
+
+// DeleteRateLimit removes the rate limit of the provided denom over the provided port and channel from state.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, portID, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRateLimit(portID, channelID, denom))
+}
+
+// GetAllRateLimits returns all rate limits stored in state.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all rate limits stored in state and performs
+// the provided callback function. Iteration stops if the callback returns true.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RateLimitKeyPrefix))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetPendingSendPacket returns the pending send packet with the provided source port, source channel
+// and sequence, as stored in state.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingSendPacket)
+
+	return pendingSendPacket, true
+}
+
+// SetPendingSendPacket stores the provided pending send packet in state.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingSendPacket)
+	store.Set(types.KeyPendingSendPacket(pendingSendPacket.PortId, pendingSendPacket.ChannelId, pendingSendPacket.Sequence), bz)
+}
+
+// DeletePendingSendPacket removes the pending send packet with the provided source port, source channel
+// and sequence from state.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(portID, channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all pending send packets stored in state.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix))
+
+	var pendingSendPackets []types.PendingSendPacket
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+	}
+
+	return pendingSendPackets
+}
+
+// UpdateRateLimitWindows moves the rolling window of every rate limit forward to end at the current
+// block time. It is called at the beginning of every block, so that the supply of a denom is
+// snapshotted before any transfer of the block escrows, burns or mints tokens.
+func (k Keeper) UpdateRateLimitWindows(ctx sdk.Context) {
+	for _, rateLimit := range k.GetAllRateLimits(ctx) {
+		if k.updateWindow(ctx, &rateLimit) {
+			k.SetRateLimit(ctx, rateLimit)
+		}
+	}
+}
+
+// currentRateLimit returns the provided rate limit with its rolling window updated to end at the
+// current block time.
+func (k Keeper) currentRateLimit(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	k.updateWindow(ctx, &rateLimit)
+	return rateLimit
+}
+
+// updateWindow updates the rolling window of the provided rate limit to end at the current block
+// time. The supply of the denom is snapshotted at the start of each bucket. It returns whether a
+// new bucket was started.
+func (k Keeper) updateWindow(ctx sdk.Context, rateLimit *types.RateLimit) bool {
+	if !rateLimit.UpdateWindow(ctx.BlockTime()) {
+		return false
+	}
+
+	rateLimit.Flow.Supply = k.bankKeeper.GetSupply(ctx, rateLimit.Denom).Amount
+	return true
+}
+
+// newFlow returns an empty flow tracked from the current block time, whose first bucket is
+// opened with the current supply of the denom.
+func (k Keeper) newFlow(ctx sdk.Context, quota types.Quota, denom string) types.Flow {
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+
+	rateLimit := types.RateLimit{Quota: quota, Flow: types.NewFlow(supply.Amount, ctx.BlockTime())}
+	rateLimit.UpdateWindow(ctx.BlockTime())
+
+	return rateLimit.Flow
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var defaultPeriod = time.Hour
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.path.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// setRateLimit stores a rate limit with an empty flow for the provided denom over the channel of chainA.
+func (suite *KeeperTestSuite) setRateLimit(denom string, quota types.Quota) {
+	ctx := suite.chainA.GetContext()
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, denom)
+
+	rateLimit := types.NewRateLimit(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, denom, quota, types.NewFlow(supply.Amount, ctx.BlockTime()))
+	rateLimit.UpdateWindow(ctx.BlockTime())
+	suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+}
+
+// addFlow adds the provided outflow and inflow to the current bucket of the rate limit of the provided denom
+// over the channel of chainA.
+func (suite *KeeperTestSuite) addFlow(denom string, outflow, inflow sdkmath.Int) {
+	ctx := suite.chainA.GetContext()
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, denom)
+	suite.Require().True(found)
+
+	rateLimit.UpdateWindow(ctx.BlockTime())
+	bucket := &rateLimit.Flow.Buckets[len(rateLimit.Flow.Buckets)-1]
+	bucket.Outflow = bucket.Outflow.Add(outflow)
+	bucket.Inflow = bucket.Inflow.Add(inflow)
+	rateLimit.Flow.Outflow = rateLimit.Flow.Outflow.Add(outflow)
+	rateLimit.Flow.Inflow = rateLimit.Flow.Inflow.Add(inflow)
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	suite.Require().Panics(func() {
+		keeper.NewKeeper(
+			suite.chainA.GetSimApp().AppCodec(),
+			suite.chainA.GetSimApp().GetKey(types.StoreKey),
+			suite.chainA.GetSimApp().IBCFeeKeeper,
+			suite.chainA.GetSimApp().BankKeeper,
+			"",
+		)
+	})
+}
+
+func (suite *KeeperTestSuite) TestGetAllRateLimits() {
+	quota := types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.NewInt(100), defaultPeriod)
+
+	denoms := []string{sdk.DefaultBondDenom, "uatom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+	for _, denom := range denoms {
+		suite.setRateLimit(denom, quota)
+	}
+
+	rateLimits := suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext())
+	suite.Require().Len(rateLimits, len(denoms))
+
+	suite.chainA.GetSimApp().RateLimitingKeeper.DeleteRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, "uatom")
+
+	_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, "uatom")
+	suite.Require().False(found)
+
+	rateLimits = suite.chainA.GetSimApp().RateLimitingKeeper.GetAllRateLimits(suite.chainA.GetContext())
+	suite.Require().Len(rateLimits, len(denoms)-1)
+}
+
+func (suite *KeeperTestSuite) TestUpdateRateLimitWindows() {
+	suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(10), sdkmath.ZeroInt(), defaultPeriod))
+	suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(100), sdkmath.ZeroInt())
+
+	ctx := suite.chainA.GetContext()
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins))
+
+	// the supply is not snapshotted again within the current bucket
+	suite.chainA.GetSimApp().RateLimitingKeeper.UpdateRateLimitWindows(ctx)
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Len(rateLimit.Flow.Buckets, 1)
+	suite.Require().Equal(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount.SubRaw(1000), rateLimit.Flow.Supply)
+
+	// a new bucket is started with the current supply, while the outflow remains within the window
+	ctx = ctx.WithBlockTime(rateLimit.BucketStart(ctx.BlockTime()).Add(rateLimit.Quota.BucketDuration()))
+	suite.chainA.GetSimApp().RateLimitingKeeper.UpdateRateLimitWindows(ctx)
+	rateLimit, found = suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Len(rateLimit.Flow.Buckets, 2)
+	suite.Require().Equal(suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount, rateLimit.Flow.Supply)
+	suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+}
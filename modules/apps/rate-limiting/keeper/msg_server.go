@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// AddRateLimit defines an rpc handler method for MsgAddRateLimit. A rate limit with an
+// empty flow is added for the provided denom over the provided channel.
+func (k Keeper) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom); found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s over port %s and channel %s", msg.Denom, msg.PortId, msg.ChannelId)
+	}
+
+	flow := k.newFlow(ctx, msg.Quota, msg.Denom)
+	if msg.Quota.Type == types.PERCENTAGE && flow.Supply.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrZeroSupply, "cannot add percentage quota for denom %s", msg.Denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(msg.PortId, msg.ChannelId, msg.Denom, msg.Quota, flow))
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines an rpc handler method for MsgResetRateLimit. The flow of the rate
+// limit is cleared and its rolling window restarts from the current block time.
+func (k Keeper) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s over port %s and channel %s", msg.Denom, msg.PortId, msg.ChannelId)
+	}
+
+	rateLimit.Flow = k.newFlow(ctx, rateLimit.Quota, msg.Denom)
+	k.SetRateLimit(ctx, rateLimit)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit. Transfers of the
+// denom over the channel are no longer rate limited.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s over port %s and channel %s", msg.Denom, msg.PortId, msg.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestAddRateLimit tests AddRateLimit rpc handler
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	var msg *types.MsgAddRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: absolute quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: percentage quota",
+			func() {
+				msg.Quota.Type = types.PERCENTAGE
+				msg.Quota.MaxSend = sdkmath.NewInt(10)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit already exists",
+			func() {
+				suite.setRateLimit(msg.Denom, msg.Quota)
+			},
+			types.ErrRateLimitAlreadyExists,
+		},
+		{
+			"failure: percentage quota for denom without supply",
+			func() {
+				msg.Denom = "nosupply"
+				msg.Quota.Type = types.PERCENTAGE
+				msg.Quota.MaxSend = sdkmath.NewInt(10)
+			},
+			types.ErrZeroSupply,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			signer := suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority()
+			quota := types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod)
+			msg = types.NewMsgAddRateLimit(signer, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.AddRateLimit(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Quota, rateLimit.Quota)
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, msg.Denom)
+				expFlow := types.NewFlow(supply.Amount, ctx.BlockTime())
+				expFlow.Buckets = []types.FlowBucket{types.NewFlowBucket(rateLimit.BucketStart(ctx.BlockTime()))}
+				suite.Require().Equal(expFlow, rateLimit.Flow)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestResetRateLimit tests ResetRateLimit rpc handler
+func (suite *KeeperTestSuite) TestResetRateLimit() {
+	var msg *types.MsgResetRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.Denom = "uatom"
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+
+			suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(100), sdkmath.ZeroInt())
+
+			signer := suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority()
+			msg = types.NewMsgResetRateLimit(signer, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.ResetRateLimit(ctx, msg)
+
+			rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Equal(sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+			}
+		})
+	}
+}
+
+// TestRemoveRateLimit tests RemoveRateLimit rpc handler
+func (suite *KeeperTestSuite) TestRemoveRateLimit() {
+	var msg *types.MsgRemoveRateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+
+			signer := suite.chainA.GetSimApp().RateLimitingKeeper.GetAuthority()
+			msg = types.NewMsgRemoveRateLimit(signer, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().RateLimitingKeeper.RemoveRateLimit(ctx, msg)
+
+			_, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().True(found)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// SendPacket implements the ICS4 Wrapper interface. The tokens of the packet are added to the
+// outflows of the rate limited denoms of the port and channel, and the packet is only sent if
+// none of the quotas is exceeded. The send time of packets which are rate limited is recorded,
+// so that their outflow can be reverted if the packet fails or times out.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	packetData, err := k.unmarshalPacketData(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
+
+	var rateLimited bool
+	for _, token := range packetData.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return 0, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		found, err := k.addOutflow(ctx, sourcePort, sourceChannel, sentDenom(token.Denom), amount)
+		if err != nil {
+			return 0, err
+		}
+		rateLimited = rateLimited || found
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if rateLimited {
+		k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(sourcePort, sourceChannel, sequence, ctx.BlockTime()))
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetAppVersion returns the underlying application version.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ReceivePacket adds the tokens of the provided packet to the inflows of the rate limited
+// denoms of the destination port and channel. An error is returned if any of the quotas is exceeded.
+func (k Keeper) ReceivePacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetData, err := k.unmarshalPacketData(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range packetData.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		denom := receivedDenom(packet, token.Denom)
+		if err := k.addInflow(ctx, packet.GetDestPort(), packet.GetDestChannel(), denom, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CompleteSentPacket removes the pending send packet record of the provided packet. It is called
+// once the packet has been successfully acknowledged, after which its outflow is final.
+func (k Keeper) CompleteSentPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.DeletePendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
+
+// RevertSentPacket subtracts the tokens of the provided packet from the outflows of the rate
+// limited denoms of the source port and channel. It is called when the packet has been
+// acknowledged with an error or has timed out, in which case the tokens are refunded to the
+// sender. The outflow is only reverted if the bucket in which the packet was sent is still within
+// the rolling window of the rate limit, and the rate limit was not reset after the packet was sent.
+func (k Keeper) RevertSentPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+	k.DeletePendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	packetData, err := k.unmarshalPacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	for _, token := range packetData.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		rateLimit, found := k.GetRateLimit(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), sentDenom(token.Denom))
+		if !found {
+			continue
+		}
+
+		if pendingSendPacket.SendTime.Before(rateLimit.Flow.StartTime) {
+			continue
+		}
+
+		rateLimit = k.currentRateLimit(ctx, rateLimit)
+		rateLimit.RevertOutflow(amount, pendingSendPacket.SendTime)
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
+
+// addOutflow adds the provided amount to the outflow of the rate limit of the provided denom
+// over the provided port and channel, if any. It returns whether a rate limit was found.
+func (k Keeper) addOutflow(ctx sdk.Context, portID, channelID, denom string, amount sdkmath.Int) (bool, error) {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, denom)
+	if !found {
+		return false, nil
+	}
+
+	rateLimit = k.currentRateLimit(ctx, rateLimit)
+	if err := rateLimit.AddOutflow(amount); err != nil {
+		return false, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return true, nil
+}
+
+// addInflow adds the provided amount to the inflow of the rate limit of the provided denom
+// over the provided port and channel, if any.
+func (k Keeper) addInflow(ctx sdk.Context, portID, channelID, denom string, amount sdkmath.Int) error {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	rateLimit = k.currentRateLimit(ctx, rateLimit)
+	if err := rateLimit.AddInflow(amount); err != nil {
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// unmarshalPacketData unmarshals the provided ICS-20 packet data bytes using the application
+// version of the provided channel.
+func (k Keeper) unmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (transfertypes.FungibleTokenPacketDataV2, error) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return transfertypes.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", portID, channelID)
+	}
+
+	return transfertypes.UnmarshalPacketData(bz, appVersion)
+}
+
+// sentDenom returns the denom on this chain of a token sent in a packet. The denom of the packet
+// data is the full denom path of the sent token.
+func sentDenom(denom string) string {
+	return transfertypes.ExtractDenomFromPath(denom).IBCDenom()
+}
+
+// receivedDenom returns the denom on this chain of a token received in the provided packet.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the token is native to this chain or was received over another channel, remove the prefix
+		// added by the sending chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ExtractDenomFromPath(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	// a voucher is minted for the token, prefixed with the destination port and channel
+	return transfertypes.ReceivedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom).IBCDenom()
+}
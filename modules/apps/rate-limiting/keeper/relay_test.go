@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendPacket() {
+	var (
+		amount      sdkmath.Int
+		expOutflow  sdkmath.Int
+		checkStored bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: denom is not rate limited",
+			func() {
+				checkStored = false
+			},
+			nil,
+		},
+		{
+			"success: rate limit over another port is not applied",
+			func() {
+				ctx := suite.chainA.GetContext()
+				quota := types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(1), sdkmath.ZeroInt(), defaultPeriod)
+				rateLimit := types.NewRateLimit(ibctesting.MockPort, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota, types.NewFlow(sdkmath.NewInt(1000), ctx.BlockTime()))
+				suite.chainA.GetSimApp().RateLimitingKeeper.SetRateLimit(ctx, rateLimit)
+
+				checkStored = false
+			},
+			nil,
+		},
+		{
+			"success: outflow within absolute quota",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+			},
+			nil,
+		},
+		{
+			"success: outflow is not limited",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.ZeroInt(), sdkmath.NewInt(1), defaultPeriod))
+			},
+			nil,
+		},
+		{
+			"success: flow leaves the rolling window once its bucket ends a period ago",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+				suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(100), sdkmath.ZeroInt())
+
+				suite.coordinator.IncrementTimeBy(defaultPeriod + defaultPeriod/types.BucketsPerPeriod)
+			},
+			nil,
+		},
+		{
+			"success: inflow offsets outflow",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+				suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(100), sdkmath.NewInt(50))
+
+				amount = sdkmath.NewInt(50)
+				expOutflow = sdkmath.NewInt(150)
+			},
+			nil,
+		},
+		{
+			"failure: flow is still within the rolling window once the period has elapsed",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod))
+				suite.addFlow(sdk.DefaultBondDenom, sdkmath.NewInt(100), sdkmath.ZeroInt())
+
+				suite.coordinator.IncrementTimeBy(defaultPeriod)
+			},
+			types.ErrQuotaExceeded,
+		},
+		{
+			"failure: absolute quota exceeded",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(99), sdkmath.ZeroInt(), defaultPeriod))
+			},
+			types.ErrQuotaExceeded,
+		},
+		{
+			"failure: percentage quota exceeded",
+			func() {
+				suite.setRateLimit(sdk.DefaultBondDenom, types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(1), sdkmath.ZeroInt(), defaultPeriod))
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+				amount = supply.Amount.QuoRaw(100).AddRaw(1)
+			},
+			types.ErrQuotaExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			amount = sdkmath.NewInt(100)
+			expOutflow = sdkmath.NewInt(100)
+			checkStored = true
+
+			tc.malleate()
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, amount), suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			_, err := suite.chainA.SendMsgs(msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				if checkStored {
+					rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+					suite.Require().True(found)
+					suite.Require().Equal(expOutflow, rateLimit.Flow.Outflow)
+				}
+			} else {
+				suite.Require().ErrorContains(err, tc.expError.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketVoucher() {
+	// receive vouchers of chainB's staking denom on chainA
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		coin, suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "",
+	)
+
+	res, err := suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = suite.path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	suite.setRateLimit(denomTrace.IBCDenom(), types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(50), sdkmath.ZeroInt(), defaultPeriod))
+
+	// sending back more than half of the vouchers exceeds the quota
+	msg = transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(denomTrace.IBCDenom(), sdkmath.NewInt(51)), suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().ErrorContains(err, types.ErrQuotaExceeded.Error())
+
+	msg.Token.Amount = sdkmath.NewInt(50)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	rateLimit, found := suite.chainA.GetSimApp().RateLimitingKeeper.GetRateLimit(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, denomTrace.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(50), rateLimit.Flow.Outflow)
+}
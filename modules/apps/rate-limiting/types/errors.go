@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// rate limiting sentinel errors
+var (
+	ErrInvalidQuota             = errorsmod.Register(ModuleName, 2, "invalid rate limit quota")
+	ErrInvalidFlow              = errorsmod.Register(ModuleName, 3, "invalid rate limit flow")
+	ErrRateLimitNotFound        = errorsmod.Register(ModuleName, 4, "rate limit not found")
+	ErrRateLimitAlreadyExists   = errorsmod.Register(ModuleName, 5, "rate limit already exists")
+	ErrQuotaExceeded            = errorsmod.Register(ModuleName, 6, "rate limit quota exceeded")
+	ErrZeroSupply               = errorsmod.Register(ModuleName, 7, "denom has no supply")
+	ErrInvalidPendingSendPacket = errorsmod.Register(ModuleName, 8, "invalid pending send packet")
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a rate limiting GenesisState instance.
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns a default instance of the rate limiting GenesisState.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyRateLimit(rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for denom %s over port %s and channel %s", rateLimit.Denom, rateLimit.PortId, rateLimit.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	for _, pendingSendPacket := range gs.PendingSendPackets {
+		if err := pendingSendPacket.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyPendingSendPacket(pendingSendPacket.PortId, pendingSendPacket.ChannelId, pendingSendPacket.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate pending send packet with sequence %d over port %s and channel %s", pendingSendPacket.Sequence, pendingSendPacket.PortId, pendingSendPacket.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	rateLimit := types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, types.NewFlow(sdkmath.NewInt(1000), time.Now()))
+	pendingSendPacket := types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, time.Now())
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			types.DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			types.NewGenesisState([]types.RateLimit{rateLimit}, []types.PendingSendPacket{pendingSendPacket}),
+			true,
+		},
+		{
+			"invalid rate limit",
+			types.NewGenesisState([]types.RateLimit{types.NewRateLimit(ibctesting.TransferPort, "", "uatom", validQuota, rateLimit.Flow)}, nil),
+			false,
+		},
+		{
+			"duplicate rate limit",
+			types.NewGenesisState([]types.RateLimit{rateLimit, rateLimit}, nil),
+			false,
+		},
+		{
+			"invalid pending send packet",
+			types.NewGenesisState(nil, []types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 0, time.Now())}),
+			false,
+		},
+		{
+			"duplicate pending send packet",
+			types.NewGenesisState(nil, []types.PendingSendPacket{pendingSendPacket, pendingSendPacket}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import "fmt"
+
+const (
+	// ModuleName defines the rate limiting middleware name
+	ModuleName = "ratelimiting"
+
+	// StoreKey is the store key string for the rate limiting middleware
+	StoreKey = ModuleName
+
+	// RateLimitKeyPrefix is the key prefix for the rate limits stored in state
+	RateLimitKeyPrefix = "rateLimit"
+
+	// PendingSendPacketKeyPrefix is the key prefix for the pending send packets stored in state
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+)
+
+// KeyRateLimit returns the key under which the rate limit of the provided denom
+// over the provided port and channel is stored.
+func KeyRateLimit(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", RateLimitKeyPrefix, portID, channelID, denom))
+}
+
+// KeyPendingSendPacket returns the key under which the send time of the packet with the provided
+// source port, source channel and sequence is stored.
+func KeyPendingSendPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingSendPacketKeyPrefix, portID, channelID, sequence))
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgAddRateLimit)(nil)
+	_ sdk.Msg = (*MsgResetRateLimit)(nil)
+	_ sdk.Msg = (*MsgRemoveRateLimit)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgAddRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+)
+
+// NewMsgAddRateLimit creates a new MsgAddRateLimit instance
+func NewMsgAddRateLimit(signer, portID, channelID, denom string, quota Quota) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validateRateLimitPath(msg.Signer, msg.PortId, msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance
+func NewMsgResetRateLimit(signer, portID, channelID, denom string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	return validateRateLimitPath(msg.Signer, msg.PortId, msg.ChannelId, msg.Denom)
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer, portID, channelID, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	return validateRateLimitPath(msg.Signer, msg.PortId, msg.ChannelId, msg.Denom)
+}
+
+// validateRateLimitPath validates the signer, port and channel identifiers and denom shared by all
+// rate limiting messages.
+func validateRateLimitPath(signer, portID, channelID, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(denom)
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestMsgAddRateLimitValidateBasic(t *testing.T) {
+	signer := ibctesting.TestAccAddress
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAddRateLimit
+		expPass bool
+	}{
+		{"success", types.NewMsgAddRateLimit(signer, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota), true},
+		{"failure: invalid signer", types.NewMsgAddRateLimit(ibctesting.InvalidID, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota), false},
+		{"failure: invalid port identifier", types.NewMsgAddRateLimit(signer, "", ibctesting.FirstChannelID, "uatom", validQuota), false},
+		{"failure: invalid channel identifier", types.NewMsgAddRateLimit(signer, ibctesting.TransferPort, "", "uatom", validQuota), false},
+		{"failure: invalid denom", types.NewMsgAddRateLimit(signer, ibctesting.TransferPort, ibctesting.FirstChannelID, "", validQuota), false},
+		{"failure: invalid quota", types.NewMsgAddRateLimit(signer, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", types.Quota{}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgResetAndRemoveRateLimitValidateBasic(t *testing.T) {
+	signer := ibctesting.TestAccAddress
+
+	testCases := []struct {
+		name      string
+		signer    string
+		portID    string
+		channelID string
+		denom     string
+		expPass   bool
+	}{
+		{"success", signer, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", true},
+		{"failure: invalid signer", ibctesting.InvalidID, ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", false},
+		{"failure: invalid port identifier", signer, "", ibctesting.FirstChannelID, "uatom", false},
+		{"failure: invalid channel identifier", signer, ibctesting.TransferPort, "", "uatom", false},
+		{"failure: invalid denom", signer, ibctesting.TransferPort, ibctesting.FirstChannelID, "", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		resetErr := types.NewMsgResetRateLimit(tc.signer, tc.portID, tc.channelID, tc.denom).ValidateBasic()
+		removeErr := types.NewMsgRemoveRateLimit(tc.signer, tc.portID, tc.channelID, tc.denom).ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, resetErr, tc.name)
+			require.NoError(t, removeErr, tc.name)
+		} else {
+			require.Error(t, resetErr, tc.name)
+			require.Error(t, removeErr, tc.name)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxPercentage is the maximum threshold of a percentage quota
+var MaxPercentage = sdkmath.NewInt(100)
+
+// BucketsPerPeriod is the number of buckets into which the period of a quota is divided. The
+// flows are measured over the buckets overlapping the rolling window ending at the current time.
+const BucketsPerPeriod = 10
+
+// NewQuota creates a new Quota instance
+func NewQuota(quotaType QuotaType, maxSend, maxRecv sdkmath.Int, period time.Duration) Quota {
+	return Quota{
+		Type:    quotaType,
+		MaxSend: maxSend,
+		MaxRecv: maxRecv,
+		Period:  period,
+	}
+}
+
+// Validate performs a stateless validation of the quota
+func (q Quota) Validate() error {
+	if q.Type != PERCENTAGE && q.Type != ABSOLUTE {
+		return errorsmod.Wrapf(ErrInvalidQuota, "unsupported quota type %s", q.Type)
+	}
+
+	if q.MaxSend.IsNil() || q.MaxSend.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "send threshold must not be negative: %s", q.MaxSend)
+	}
+
+	if q.MaxRecv.IsNil() || q.MaxRecv.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "receive threshold must not be negative: %s", q.MaxRecv)
+	}
+
+	if q.MaxSend.IsZero() && q.MaxRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "at least one of the send and receive thresholds must be set")
+	}
+
+	if q.Type == PERCENTAGE && (q.MaxSend.GT(MaxPercentage) || q.MaxRecv.GT(MaxPercentage)) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "percentage thresholds must not exceed %s", MaxPercentage)
+	}
+
+	if q.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "period must be positive: %s", q.Period)
+	}
+
+	return nil
+}
+
+// BucketDuration returns the duration of the buckets of the rolling window of the quota.
+func (q Quota) BucketDuration() time.Duration {
+	if bucketDuration := q.Period / BucketsPerPeriod; bucketDuration > 0 {
+		return bucketDuration
+	}
+
+	return q.Period
+}
+
+// threshold returns the maximum net flow permitted by the provided limit, given the supply
+// of the denom at the start of the current bucket.
+func (q Quota) threshold(limit, supply sdkmath.Int) sdkmath.Int {
+	if q.Type == PERCENTAGE {
+		return supply.Mul(limit).Quo(MaxPercentage)
+	}
+
+	return limit
+}
+
+// NewFlow creates a new Flow instance without any inflow or outflow, tracked from the provided time.
+func NewFlow(supply sdkmath.Int, startTime time.Time) Flow {
+	return Flow{
+		Inflow:    sdkmath.ZeroInt(),
+		Outflow:   sdkmath.ZeroInt(),
+		Supply:    supply,
+		StartTime: startTime,
+	}
+}
+
+// NewFlowBucket creates a new FlowBucket instance without any inflow or outflow, starting at the provided time.
+func NewFlowBucket(start time.Time) FlowBucket {
+	return FlowBucket{
+		Start:   start,
+		Inflow:  sdkmath.ZeroInt(),
+		Outflow: sdkmath.ZeroInt(),
+	}
+}
+
+// Validate performs a stateless validation of the flow
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFlow, "inflow must not be negative: %s", f.Inflow)
+	}
+
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFlow, "outflow must not be negative: %s", f.Outflow)
+	}
+
+	if f.Supply.IsNil() || f.Supply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidFlow, "supply must not be negative: %s", f.Supply)
+	}
+
+	inflow, outflow := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for i, bucket := range f.Buckets {
+		if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFlow, "bucket inflow must not be negative: %s", bucket.Inflow)
+		}
+
+		if bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFlow, "bucket outflow must not be negative: %s", bucket.Outflow)
+		}
+
+		if i > 0 && !bucket.Start.After(f.Buckets[i-1].Start) {
+			return errorsmod.Wrapf(ErrInvalidFlow, "buckets must be ordered by start time: %s is not after %s", bucket.Start, f.Buckets[i-1].Start)
+		}
+
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	if !f.Inflow.Equal(inflow) || !f.Outflow.Equal(outflow) {
+		return errorsmod.Wrapf(ErrInvalidFlow, "inflow %s and outflow %s must equal the sums of the buckets %s and %s", f.Inflow, f.Outflow, inflow, outflow)
+	}
+
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(portID, channelID, denom string, quota Quota, flow Flow) RateLimit {
+	return RateLimit{
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      flow,
+	}
+}
+
+// Validate performs a stateless validation of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := host.PortIdentifierValidator(rl.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// BucketStart returns the start time of the bucket of the rolling window containing the provided time.
+func (rl RateLimit) BucketStart(t time.Time) time.Time {
+	return t.Truncate(rl.Quota.BucketDuration())
+}
+
+// UpdateWindow slides the rolling window of the flow to end at the provided block time. Buckets
+// which ended before the start of the window are removed, and their amounts are no longer counted
+// in the flow. A bucket ending within the window is counted in full, so that the net flow within
+// any span of the period never exceeds the thresholds of the quota. It returns true if a new
+// bucket was started at the provided block time.
+func (rl *RateLimit) UpdateWindow(blockTime time.Time) bool {
+	bucketDuration := rl.Quota.BucketDuration()
+	windowStart := blockTime.Add(-rl.Quota.Period)
+
+	buckets := make([]FlowBucket, 0, len(rl.Flow.Buckets)+1)
+	for _, bucket := range rl.Flow.Buckets {
+		if bucket.Start.Add(bucketDuration).After(windowStart) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	bucketStart := rl.BucketStart(blockTime)
+	newBucket := len(buckets) == 0 || !buckets[len(buckets)-1].Start.Equal(bucketStart)
+	if newBucket {
+		buckets = append(buckets, NewFlowBucket(bucketStart))
+	}
+
+	rl.Flow.Buckets = buckets
+	rl.Flow.Inflow, rl.Flow.Outflow = sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, bucket := range buckets {
+		rl.Flow.Inflow = rl.Flow.Inflow.Add(bucket.Inflow)
+		rl.Flow.Outflow = rl.Flow.Outflow.Add(bucket.Outflow)
+	}
+
+	return newBucket
+}
+
+// AddOutflow adds the provided amount to the outflow of the current bucket of the rolling window,
+// which must have been updated to end at the current block time. An error is returned if the
+// resulting net outflow within the window exceeds the send threshold of the quota.
+func (rl *RateLimit) AddOutflow(amount sdkmath.Int) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+
+	if !rl.Quota.MaxSend.IsZero() {
+		threshold := rl.Quota.threshold(rl.Quota.MaxSend, rl.Flow.Supply)
+		if netOutflow := outflow.Sub(rl.Flow.Inflow); netOutflow.GT(threshold) {
+			return errorsmod.Wrapf(ErrQuotaExceeded, "net outflow of %s%s over port %s and channel %s would exceed the threshold of %s", netOutflow, rl.Denom, rl.PortId, rl.ChannelId, threshold)
+		}
+	}
+
+	rl.Flow.Outflow = outflow
+	current := &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+	current.Outflow = current.Outflow.Add(amount)
+
+	return nil
+}
+
+// RevertOutflow subtracts the provided amount from the outflow of the bucket containing the
+// provided send time, without reducing it below zero. It is used to revert the outflow of packets
+// which failed or timed out. Nothing is reverted if the bucket is no longer within the rolling window.
+func (rl *RateLimit) RevertOutflow(amount sdkmath.Int, sendTime time.Time) {
+	bucketStart := rl.BucketStart(sendTime)
+	for i := range rl.Flow.Buckets {
+		bucket := &rl.Flow.Buckets[i]
+		if !bucket.Start.Equal(bucketStart) {
+			continue
+		}
+
+		reverted := sdkmath.MinInt(amount, bucket.Outflow)
+		bucket.Outflow = bucket.Outflow.Sub(reverted)
+		rl.Flow.Outflow = rl.Flow.Outflow.Sub(reverted)
+
+		return
+	}
+}
+
+// AddInflow adds the provided amount to the inflow of the current bucket of the rolling window,
+// which must have been updated to end at the current block time. An error is returned if the
+// resulting net inflow within the window exceeds the receive threshold of the quota.
+func (rl *RateLimit) AddInflow(amount sdkmath.Int) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+
+	if !rl.Quota.MaxRecv.IsZero() {
+		threshold := rl.Quota.threshold(rl.Quota.MaxRecv, rl.Flow.Supply)
+		if netInflow := inflow.Sub(rl.Flow.Outflow); netInflow.GT(threshold) {
+			return errorsmod.Wrapf(ErrQuotaExceeded, "net inflow of %s%s over port %s and channel %s would exceed the threshold of %s", netInflow, rl.Denom, rl.PortId, rl.ChannelId, threshold)
+		}
+	}
+
+	rl.Flow.Inflow = inflow
+	current := &rl.Flow.Buckets[len(rl.Flow.Buckets)-1]
+	current.Inflow = current.Inflow.Add(amount)
+
+	return nil
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(portID, channelID string, sequence uint64, sendTime time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  sequence,
+		SendTime:  sendTime,
+	}
+}
+
+// Validate performs a stateless validation of the pending send packet
+func (p PendingSendPacket) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return err
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "packet sequence cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/rate-limiting/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var (
+	defaultPeriod = time.Hour
+	validQuota    = types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.NewInt(100), defaultPeriod)
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quota    types.Quota
+		expError error
+	}{
+		{"success: absolute quota", validQuota, nil},
+		{"success: percentage quota", types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(100), sdkmath.NewInt(1), defaultPeriod), nil},
+		{"success: only outflows limited", types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod), nil},
+		{"failure: unspecified quota type", types.NewQuota(types.UNSPECIFIED, sdkmath.NewInt(100), sdkmath.NewInt(100), defaultPeriod), types.ErrInvalidQuota},
+		{"failure: nil send threshold", types.Quota{Type: types.ABSOLUTE, MaxRecv: sdkmath.NewInt(100), Period: defaultPeriod}, types.ErrInvalidQuota},
+		{"failure: negative receive threshold", types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.NewInt(-1), defaultPeriod), types.ErrInvalidQuota},
+		{"failure: no thresholds", types.NewQuota(types.ABSOLUTE, sdkmath.ZeroInt(), sdkmath.ZeroInt(), defaultPeriod), types.ErrInvalidQuota},
+		{"failure: percentage above 100", types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(101), sdkmath.ZeroInt(), defaultPeriod), types.ErrInvalidQuota},
+		{"failure: zero period", types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.NewInt(100), 0), types.ErrInvalidQuota},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.quota.Validate()
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
+func TestRateLimitValidate(t *testing.T) {
+	validFlow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+
+	testCases := []struct {
+		name      string
+		rateLimit types.RateLimit
+		expPass   bool
+	}{
+		{"success", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, validFlow), true},
+		{"success: ibc denom", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", validQuota, validFlow), true},
+		{"failure: invalid port identifier", types.NewRateLimit("", ibctesting.FirstChannelID, "uatom", validQuota, validFlow), false},
+		{"failure: invalid channel identifier", types.NewRateLimit(ibctesting.TransferPort, "", "uatom", validQuota, validFlow), false},
+		{"failure: invalid denom", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "", validQuota, validFlow), false},
+		{"failure: invalid quota", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", types.Quota{}, validFlow), false},
+		{"failure: invalid flow", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, types.Flow{}), false},
+		{"failure: flow does not match its buckets", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, func() types.Flow {
+			flow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+			flow.Outflow = sdkmath.NewInt(1)
+			return flow
+		}()), false},
+		{"failure: buckets are not ordered", types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, func() types.Flow {
+			flow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+			flow.Buckets = []types.FlowBucket{types.NewFlowBucket(time.Unix(60, 0)), types.NewFlowBucket(time.Unix(0, 0))}
+			return flow
+		}()), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.rateLimit.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAddFlow(t *testing.T) {
+	testCases := []struct {
+		name       string
+		quota      types.Quota
+		inflow     int64
+		outflow    int64
+		amount     int64
+		expOutflow bool
+		expInflow  bool
+	}{
+		{"within absolute thresholds", validQuota, 0, 0, 100, true, true},
+		{"absolute thresholds exceeded", validQuota, 0, 0, 101, false, false},
+		{"net flow within absolute thresholds", validQuota, 50, 50, 100, true, true},
+		{"within percentage thresholds", types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(10), sdkmath.NewInt(10), defaultPeriod), 0, 0, 100, true, true},
+		{"percentage thresholds exceeded", types.NewQuota(types.PERCENTAGE, sdkmath.NewInt(10), sdkmath.NewInt(10), defaultPeriod), 0, 0, 101, false, false},
+		{"only outflows limited", types.NewQuota(types.ABSOLUTE, sdkmath.NewInt(100), sdkmath.ZeroInt(), defaultPeriod), 0, 0, 101, false, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		bucket := types.NewFlowBucket(time.Now())
+		bucket.Inflow = sdkmath.NewInt(tc.inflow)
+		bucket.Outflow = sdkmath.NewInt(tc.outflow)
+
+		flow := types.NewFlow(sdkmath.NewInt(1000), time.Now())
+		flow.Inflow = bucket.Inflow
+		flow.Outflow = bucket.Outflow
+		flow.Buckets = []types.FlowBucket{bucket}
+
+		rateLimit := types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", tc.quota, flow)
+		err := rateLimit.AddOutflow(sdkmath.NewInt(tc.amount))
+		if tc.expOutflow {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, sdkmath.NewInt(tc.outflow+tc.amount), rateLimit.Flow.Outflow, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrQuotaExceeded, tc.name)
+			require.Equal(t, sdkmath.NewInt(tc.outflow), rateLimit.Flow.Outflow, tc.name)
+		}
+
+		rateLimit = types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", tc.quota, flow)
+		err = rateLimit.AddInflow(sdkmath.NewInt(tc.amount))
+		if tc.expInflow {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, sdkmath.NewInt(tc.inflow+tc.amount), rateLimit.Flow.Inflow, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrQuotaExceeded, tc.name)
+			require.Equal(t, sdkmath.NewInt(tc.inflow), rateLimit.Flow.Inflow, tc.name)
+		}
+	}
+}
+
+func TestUpdateWindow(t *testing.T) {
+	bucketDuration := defaultPeriod / types.BucketsPerPeriod
+	rateLimit := types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, types.NewFlow(sdkmath.NewInt(1000), time.Now()))
+	bucketStart := rateLimit.BucketStart(time.Now())
+	require.Equal(t, bucketDuration, rateLimit.Quota.BucketDuration())
+
+	require.True(t, rateLimit.UpdateWindow(bucketStart.Add(bucketDuration/2)))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(100)))
+	require.False(t, rateLimit.UpdateWindow(bucketStart.Add(bucketDuration-time.Nanosecond)))
+
+	// the bucket remains within the rolling window until it ended a period ago, so that the quota
+	// cannot be used twice across the end of a period
+	for _, blockTime := range []time.Time{bucketStart.Add(defaultPeriod), bucketStart.Add(defaultPeriod + bucketDuration - time.Nanosecond)} {
+		rateLimit.UpdateWindow(blockTime)
+		require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+		require.ErrorIs(t, rateLimit.AddOutflow(sdkmath.NewInt(1)), types.ErrQuotaExceeded)
+	}
+
+	require.True(t, rateLimit.UpdateWindow(bucketStart.Add(defaultPeriod+bucketDuration)))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(100)))
+	require.NoError(t, rateLimit.Flow.Validate())
+}
+
+func TestRevertOutflow(t *testing.T) {
+	bucketDuration := defaultPeriod / types.BucketsPerPeriod
+	rateLimit := types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, "uatom", validQuota, types.NewFlow(sdkmath.NewInt(1000), time.Now()))
+	sendTime := rateLimit.BucketStart(time.Now())
+
+	rateLimit.UpdateWindow(sendTime)
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(60)))
+
+	rateLimit.UpdateWindow(sendTime.Add(bucketDuration))
+	require.NoError(t, rateLimit.AddOutflow(sdkmath.NewInt(30)))
+
+	// only the outflow of the bucket in which the packet was sent is reverted
+	rateLimit.RevertOutflow(sdkmath.NewInt(100), sendTime)
+	require.Equal(t, sdkmath.NewInt(30), rateLimit.Flow.Outflow)
+	require.True(t, rateLimit.Flow.Buckets[0].Outflow.IsZero())
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	// nothing is reverted once the bucket has left the rolling window
+	rateLimit.UpdateWindow(sendTime.Add(defaultPeriod + 2*bucketDuration - time.Nanosecond))
+	rateLimit.RevertOutflow(sdkmath.NewInt(30), sendTime.Add(-defaultPeriod))
+	require.Equal(t, sdkmath.NewInt(30), rateLimit.Flow.Outflow)
+}
@@ -18,10 +18,17 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenom(),
+		GetCmdQueryDenoms(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryChannelParams(),
+		GetCmdQueryTransferEnabled(),
+		GetCmdQueryEscrowReconciliation(),
+		GetCmdQueryRefundAddress(),
+		GetCmdQueryProtocolFee(),
 	)
 
 	return queryCmd
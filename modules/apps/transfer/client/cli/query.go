@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -12,6 +13,12 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
+const (
+	flagPortID    = "port-id"
+	flagChannelID = "channel-id"
+	flagBaseDenom = "base-denom"
+)
+
 // GetCmdQueryDenomTrace defines the command to query a a denomination trace from a given trace hash or ibc denom.
 func GetCmdQueryDenomTrace() *cobra.Command {
 	cmd := &cobra.Command{
@@ -83,6 +90,100 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenom defines the command to query a denomination from a given hash or ibc denom.
+func GetCmdQueryDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom [hash/denom]",
+		Short:   "Query the denomination from a given hash or ibc denom",
+		Long:    "Query the base denomination and the hops of the trace of a denomination from a given hash or ibc denom",
+		Example: fmt.Sprintf("%s query ibc-transfer denom 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.Denom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenoms defines the command to query all the denominations, optionally filtered by hop or base denomination.
+func GetCmdQueryDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms",
+		Short: "Query all token denominations",
+		Long:  "Query all token denominations, optionally filtered by a hop (port and channel identifiers) of their trace or by their base denomination",
+		Example: fmt.Sprintf(
+			"%s query ibc-transfer denoms --%s transfer --%s channel-0 --%s uatom", version.AppName, flagPortID, flagChannelID, flagBaseDenom,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			portID, err := cmd.Flags().GetString(flagPortID)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			baseDenom, err := cmd.Flags().GetString(flagBaseDenom)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomsRequest{
+				Pagination: pageReq,
+				PortId:     portID,
+				ChannelId:  channelID,
+				BaseDenom:  baseDenom,
+			}
+
+			res, err := queryClient.Denoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPortID, "", "Port identifier of a hop the denominations must have been transferred through")
+	cmd.Flags().String(flagChannelID, "", "Channel identifier of a hop the denominations must have been transferred through")
+	cmd.Flags().String(flagBaseDenom, "", "Base denomination the denominations must have")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -201,3 +302,196 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryChannelParams defines the command to query the parameters enabling or disabling transfers over channels.
+func GetCmdQueryChannelParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel-params",
+		Short: "Query the parameters enabling or disabling transfers over channels",
+		Long:  "Query the parameters enabling or disabling transfers over channels, optionally filtered by channel",
+		Example: fmt.Sprintf(
+			"%s query ibc-transfer channel-params --%s transfer --%s channel-0", version.AppName, flagPortID, flagChannelID,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			portID, err := cmd.Flags().GetString(flagPortID)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := cmd.Flags().GetString(flagChannelID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelParamsRequest{
+				Pagination: pageReq,
+				PortId:     portID,
+				ChannelId:  channelID,
+			}
+
+			res, err := queryClient.ChannelParams(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagPortID, "", "Port identifier of the channel the parameters apply to")
+	cmd.Flags().String(flagChannelID, "", "Channel identifier of the channel the parameters apply to")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel params")
+
+	return cmd
+}
+
+// GetCmdQueryTransferEnabled defines the command to query whether transfers are enabled over a channel.
+func GetCmdQueryTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-enabled [port] [channel-id] [denom]",
+		Short:   "Query whether transfers are enabled over a channel",
+		Long:    "Query whether transfers are enabled over a channel, optionally for a single denomination, taking into account the module and channel parameters",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-enabled transfer channel-0 uatom", version.AppName),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferEnabledRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			if len(args) == 3 {
+				req.Denom = args[2]
+			}
+
+			res, err := queryClient.TransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowReconciliation defines the command to compare the escrow balances against the total escrow.
+func GetCmdQueryEscrowReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-reconciliation",
+		Short:   "Compare the balances of the escrow addresses against the total amounts expected in escrow",
+		Long:    "Compare the balances of the escrow addresses of all transfer channels against the total amounts expected in escrow, and report any discrepancies",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-reconciliation", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EscrowReconciliation(cmd.Context(), &types.QueryEscrowReconciliationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRefundAddress defines the command to query the alternate refund address of a sent packet.
+func GetCmdQueryRefundAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-address [port] [channel-id] [sequence]",
+		Short:   "Query the refund address of a transfer packet",
+		Long:    "Query the address refunded if the transfer packet identified by the port, channel and sequence fails or times out, if it was set in place of the sender",
+		Example: fmt.Sprintf("%s query ibc-transfer refund-address transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRefundAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.RefundAddress(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProtocolFee defines the command to preview the protocol fees charged on a transfer.
+func GetCmdQueryProtocolFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "protocol-fee [port] [channel-id] [coins]",
+		Short:   "Preview the protocol fees charged on a transfer",
+		Long:    "Preview the protocol fees charged on a transfer of the provided comma separated list of coins over a channel, and the tokens sent once the fees are deducted",
+		Example: fmt.Sprintf("%s query ibc-transfer protocol-fee transfer channel-0 1000uatom", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProtocolFeeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Tokens:    args[2],
+			}
+
+			res, err := queryClient.ProtocolFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
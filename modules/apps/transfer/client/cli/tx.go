@@ -26,20 +26,30 @@ const (
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
 	flagMemo                   = "memo"
+	flagForwarding             = "forwarding"
+	flagForwardingTimeout      = "forwarding-timeout"
+	flagRefundAddress          = "refund-address"
 )
 
 // NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
 func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
-		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified
+		Use:   "transfer [src-port] [src-channel] [receiver] [coins]",
+		Short: "Transfer fungible token(s) through IBC",
+		Long: strings.TrimSpace(`Transfer fungible token(s) through IBC. Multiple coins may be provided as a comma separated
+list, in which case they are transferred atomically within a single packet (requires an ics20-2 channel). Timeouts can be specified
 as absolute or relative using the "absolute-timeouts" flag. Timeout height can be set by passing in the height string
 in the form {revision}-{height} using the "packet-timeout-height" flag. Relative timeout height is added to the block
 height queried from the latest consensus state corresponding to the counterparty channel. Relative timeout timestamp 
 is added to the greater value of the local clock time and the block timestamp queried from the latest consensus state 
-corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
-		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
+corresponding to the counterparty channel. Any timeout set to 0 is disabled. The tokens can be forwarded through
+further hops after being received on the destination chain by passing a comma separated list of {port}/{channel} hops
+using the "forwarding" flag (requires ics20-2 channels). The memo is then delivered to the final destination chain.
+The timeout of the packets sent to each hop, relative to the block time of the forwarding chain, can be set with the
+"forwarding-timeout" flag.
+If the transfer fails or times out, the tokens are refunded to the sender, or to the address passed with the
+"refund-address" flag.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [coins]", version.AppName),
 		Args:    cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -51,15 +61,17 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coin, err := sdk.ParseCoinNormalized(args[3])
+			coins, err := sdk.ParseCoinsNormalized(args[3])
 			if err != nil {
 				return err
 			}
 
-			if !strings.HasPrefix(coin.Denom, "ibc/") {
-				denomTrace := types.ParseDenomTrace(coin.Denom)
-				coin.Denom = denomTrace.IBCDenom()
+			for i, coin := range coins {
+				if !strings.HasPrefix(coin.Denom, "ibc/") {
+					coins[i].Denom = types.ExtractDenomFromPath(coin.Denom).IBCDenom()
+				}
 			}
+			coins = sdk.NewCoins(coins...)
 
 			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
 			if err != nil {
@@ -85,6 +97,26 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				return err
 			}
 
+			forwardingHops, err := cmd.Flags().GetStringSlice(flagForwarding)
+			if err != nil {
+				return err
+			}
+
+			hops, err := parseHops(forwardingHops)
+			if err != nil {
+				return err
+			}
+
+			forwardingTimeout, err := cmd.Flags().GetDuration(flagForwardingTimeout)
+			if err != nil {
+				return err
+			}
+
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
 			// if the timeouts are not absolute, retrieve latest block height and block timestamp
 			// for the consensus state connected to the destination port/channel.
 			// localhost clients must rely solely on local clock time in order to use relative timestamps.
@@ -147,9 +179,25 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 				}
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
-			)
+			var msg *types.MsgTransfer
+			if len(coins) == 1 {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, coins[0], sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+			} else {
+				msg = types.NewMsgTransfer(
+					srcPort, srcChannel, sdk.Coin{}, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
+				)
+				msg.Tokens = coins
+			}
+
+			if len(hops) > 0 {
+				msg.Forwarding = types.NewForwarding(hops...)
+				msg.Forwarding.RelativeTimeout = uint64(forwardingTimeout)
+			}
+
+			msg.RefundAddress = refundAddress
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -158,7 +206,25 @@ corresponding to the counterparty channel. Any timeout set to 0 is disabled.`),
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, types.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().StringSlice(flagForwarding, []string{}, "Comma separated list of {port}/{channel} hops the tokens are forwarded through after being received.")
+	cmd.Flags().Duration(flagForwardingTimeout, 0, fmt.Sprintf("Timeout of the packets sent to each forwarding hop, relative to the block time of the forwarding chain. Defaults to %s when set to 0.", types.DefaultForwardingPacketTimeout))
+	cmd.Flags().String(flagRefundAddress, "", "Address refunded if the transfer fails or times out. Defaults to the sender.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseHops parses a list of hops in the format {port}/{channel}.
+func parseHops(hopsStr []string) ([]types.Hop, error) {
+	hops := make([]types.Hop, 0, len(hopsStr))
+	for _, hopStr := range hopsStr {
+		portID, channelID, found := strings.Cut(hopStr, "/")
+		if !found {
+			return nil, fmt.Errorf("expected hop in the format {port}/{channel}, got %s", hopStr)
+		}
+
+		hops = append(hops, types.NewHop(portID, channelID))
+	}
+
+	return hops, nil
+}
@@ -1,6 +1,7 @@
 package transfer
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -89,8 +90,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	if !types.IsSupportedVersion(version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -116,8 +117,8 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	// OpenTry must claim the channelCapability that IBC passes into the callback
@@ -125,7 +126,7 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -136,8 +137,8 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 	return nil
 }
@@ -172,7 +173,8 @@ func (IBCModule) OnChanCloseConfirm(
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
 // is returned if the packet data is successfully decoded and the receive application
-// logic returns without error.
+// logic returns without error. If the tokens are forwarded to a next hop, a nil
+// acknowledgement is returned and the acknowledgement is written asynchronously.
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -181,9 +183,14 @@ func (im IBCModule) OnRecvPacket(
 	logger := im.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
+	var data types.FungibleTokenPacketDataV2
 	var ackErr error
-	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+	appVersion, found := im.keeper.GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if !found {
+		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "app version not found for port %s and channel %s", packet.GetDestPort(), packet.GetDestChannel())
+		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
+		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+	} else if data, ackErr = types.UnmarshalPacketData(packet.GetData(), appVersion); ackErr != nil {
 		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
@@ -206,11 +213,12 @@ func (im IBCModule) OnRecvPacket(
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
+	}
+	eventAttributes = append(eventAttributes, tokensAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens, data.Tokens)...)
+	eventAttributes = append(eventAttributes,
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
-	}
+	)
 
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
@@ -223,6 +231,12 @@ func (im IBCModule) OnRecvPacket(
 		),
 	)
 
+	if ack.Success() && data.HasForwarding() {
+		// NOTE: acknowledgement will be written asynchronously once the packet
+		// forwarding the tokens to the next hop is acknowledged or timed out.
+		return nil
+	}
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
@@ -238,25 +252,34 @@ func (im IBCModule) OnAcknowledgementPacket(
 	if err := types.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
 		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
 	}
-	var data types.FungibleTokenPacketData
-	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+
+	data, err := im.unmarshalSentPacketData(ctx, packet)
+	if err != nil {
+		return err
 	}
 
+	// the refund address is deleted once the packet is acknowledged
+	refundReceiver := im.keeper.GetRefundReceiver(ctx, packet, data)
+
 	if err := im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack); err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
+	}
+	eventAttributes = append(eventAttributes, tokensAttributes(types.AttributeKeyDenom, types.AttributeKeyAmount, types.AttributeKeyTokens, data.Tokens)...)
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+		sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+	)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypePacket,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
-			sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
-			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+			eventAttributes...,
 		),
 	)
 
@@ -273,6 +296,7 @@ func (im IBCModule) OnAcknowledgementPacket(
 			sdk.NewEvent(
 				types.EventTypePacket,
 				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+				sdk.NewAttribute(types.AttributeKeyRefundReceiver, refundReceiver),
 			),
 		)
 	}
@@ -286,23 +310,30 @@ func (im IBCModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	var data types.FungibleTokenPacketData
-	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
-		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	data, err := im.unmarshalSentPacketData(ctx, packet)
+	if err != nil {
+		return err
 	}
+
+	// the refund address is deleted once the tokens are refunded
+	refundReceiver := im.keeper.GetRefundReceiver(ctx, packet, data)
+
 	// refund tokens
 	if err := im.keeper.OnTimeoutPacket(ctx, packet, data); err != nil {
 		return err
 	}
 
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, refundReceiver),
+	}
+	eventAttributes = append(eventAttributes, tokensAttributes(types.AttributeKeyRefundDenom, types.AttributeKeyRefundAmount, types.AttributeKeyRefundTokens, data.Tokens)...)
+	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyMemo, data.Memo))
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			eventAttributes...,
 		),
 	)
 
@@ -315,8 +346,8 @@ func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	if !types.IsSupportedVersion(proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -328,8 +359,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
@@ -337,8 +368,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !types.IsSupportedVersion(counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return nil
@@ -349,13 +380,65 @@ func (IBCModule) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, pr
 }
 
 // UnmarshalPacketData attempts to unmarshal the provided packet data bytes
-// into a FungibleTokenPacketData. This function implements the optional
-// PacketDataUnmarshaler interface required for ADR 008 support.
+// into a FungibleTokenPacketData or a FungibleTokenPacketDataV2. This function
+// implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
+//
+// As the channel version is not known, the packet data is first strictly decoded as
+// FungibleTokenPacketData and, if that fails, as FungibleTokenPacketDataV2.
 func (IBCModule) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	var packetData types.FungibleTokenPacketData
-	if err := json.Unmarshal(bz, &packetData); err != nil {
+	if err := unmarshalJSONStrict(bz, &packetData); err == nil {
+		return packetData, nil
+	}
+
+	var packetDataV2 types.FungibleTokenPacketDataV2
+	if err := unmarshalJSONStrict(bz, &packetDataV2); err != nil {
 		return nil, err
 	}
 
-	return packetData, nil
+	return packetDataV2, nil
+}
+
+// unmarshalSentPacketData unmarshals the data of a packet sent by this chain according to the
+// app version of the source channel end.
+func (im IBCModule) unmarshalSentPacketData(ctx sdk.Context, packet channeltypes.Packet) (types.FungibleTokenPacketDataV2, error) {
+	appVersion, found := im.keeper.GetAppVersion(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return types.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), appVersion)
+	if err != nil {
+		return types.FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	return data, nil
+}
+
+// unmarshalJSONStrict unmarshals the provided JSON bytes into the destination,
+// returning an error if any unknown fields are present.
+func unmarshalJSONStrict(bz []byte, dst interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(dst)
+}
+
+// tokensAttributes returns the event attributes for the provided tokens. The JSON encoding of the tokens is always
+// emitted, while the denomination and amount attributes are also emitted for packets carrying a single token, so
+// that the events of single token transfers remain compatible with ics20-1 packets.
+func tokensAttributes(denomKey, amountKey, tokensKey string, tokens []types.Token) []sdk.Attribute {
+	bz, err := json.Marshal(tokens)
+	if err != nil {
+		panic(fmt.Errorf("cannot marshal tokens into JSON: %w", err))
+	}
+
+	if len(tokens) != 1 {
+		return []sdk.Attribute{sdk.NewAttribute(tokensKey, string(bz))}
+	}
+
+	return []sdk.Attribute{
+		sdk.NewAttribute(denomKey, tokens[0].Denom),
+		sdk.NewAttribute(amountKey, tokens[0].Amount),
+		sdk.NewAttribute(tokensKey, string(bz)),
+	}
 }
@@ -44,6 +44,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, true,
 		},
+		{
+			"success: ics20-2 version", func() {
+				channel.Version = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -103,7 +108,12 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+
+				expVersion := channel.Version
+				if expVersion == "" {
+					expVersion = types.Version
+				}
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal(version, "")
@@ -129,6 +139,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		{
 			"success", func() {}, true,
 		},
+		{
+			"success: ics20-2 counterparty version", func() {
+				counterpartyVersion = types.V2
+			}, true,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -194,7 +209,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(counterpartyVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Equal("", version)
@@ -264,6 +279,14 @@ func (suite *TransferTestSuite) TestOnChanUpgradeInit() {
 			func() {}, // successful happy path for a standalone transfer app is swapping out the underlying connection
 			nil,
 		},
+		{
+			"success: upgrade version to ics20-2",
+			func() {
+				path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+				path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+			},
+			nil,
+		},
 		{
 			"invalid upgrade connection",
 			func() {
@@ -475,7 +498,7 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 		receiver = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
 
 		data          []byte
-		expPacketData types.FungibleTokenPacketData
+		expPacketData interface{}
 	)
 
 	testCases := []struct {
@@ -493,7 +516,7 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 					Receiver: receiver,
 					Memo:     "some memo",
 				}
-				data = expPacketData.GetBytes()
+				data = expPacketData.(types.FungibleTokenPacketData).GetBytes()
 			},
 			true,
 		},
@@ -507,7 +530,21 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 					Receiver: receiver,
 					Memo:     "",
 				}
-				data = expPacketData.GetBytes()
+				data = expPacketData.(types.FungibleTokenPacketData).GetBytes()
+			},
+			true,
+		},
+		{
+			"success: valid packet data v2",
+			func() {
+				expPacketData = types.NewFungibleTokenPacketDataV2(
+					[]types.Token{
+						{Denom: ibctesting.TestCoin.Denom, Amount: ibctesting.TestCoin.Amount.String()},
+						{Denom: "transfer/channel-0/atom", Amount: "1"},
+					},
+					sender, receiver, "some memo",
+				)
+				data = expPacketData.(types.FungibleTokenPacketDataV2).GetBytes()
 			},
 			true,
 		},
@@ -518,6 +555,13 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 			},
 			false,
 		},
+		{
+			"failure: packet data with fields of both versions",
+			func() {
+				data = []byte(`{"denom":"stake","amount":"100","tokens":[{"denom":"stake","amount":"100"}],"sender":"sender","receiver":"receiver"}`)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
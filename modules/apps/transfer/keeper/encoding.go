@@ -34,3 +34,73 @@ func (k Keeper) MarshalDenomTrace(denomTrace types.DenomTrace) ([]byte, error) {
 func (k Keeper) MustMarshalDenomTrace(denomTrace types.DenomTrace) []byte {
 	return k.cdc.MustMarshal(&denomTrace)
 }
+
+// UnmarshalDenom attempts to decode and return a Denom object from
+// raw encoded bytes.
+func (k Keeper) UnmarshalDenom(bz []byte) (types.Denom, error) {
+	var denom types.Denom
+	if err := k.cdc.Unmarshal(bz, &denom); err != nil {
+		return types.Denom{}, err
+	}
+
+	return denom, nil
+}
+
+// MustUnmarshalDenom attempts to decode and return a Denom object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalDenom(bz []byte) types.Denom {
+	var denom types.Denom
+	k.cdc.MustUnmarshal(bz, &denom)
+	return denom
+}
+
+// MarshalDenom attempts to encode a Denom object and returns the
+// raw encoded bytes.
+func (k Keeper) MarshalDenom(denom types.Denom) ([]byte, error) {
+	return k.cdc.Marshal(&denom)
+}
+
+// MustMarshalDenom attempts to encode a Denom object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalDenom(denom types.Denom) []byte {
+	return k.cdc.MustMarshal(&denom)
+}
+
+// UnmarshalChannelParams attempts to decode and return a ChannelParams object from
+// raw encoded bytes.
+func (k Keeper) UnmarshalChannelParams(bz []byte) (types.ChannelParams, error) {
+	var channelParams types.ChannelParams
+	if err := k.cdc.Unmarshal(bz, &channelParams); err != nil {
+		return types.ChannelParams{}, err
+	}
+
+	return channelParams, nil
+}
+
+// MustUnmarshalChannelParams attempts to decode and return a ChannelParams object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalChannelParams(bz []byte) types.ChannelParams {
+	var channelParams types.ChannelParams
+	k.cdc.MustUnmarshal(bz, &channelParams)
+	return channelParams
+}
+
+// MustMarshalChannelParams attempts to encode a ChannelParams object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalChannelParams(channelParams types.ChannelParams) []byte {
+	return k.cdc.MustMarshal(&channelParams)
+}
+
+// MustUnmarshalPacketProtocolFee attempts to decode and return a PacketProtocolFee object from
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustUnmarshalPacketProtocolFee(bz []byte) types.PacketProtocolFee {
+	var packetFee types.PacketProtocolFee
+	k.cdc.MustUnmarshal(bz, &packetFee)
+	return packetFee
+}
+
+// MustMarshalPacketProtocolFee attempts to encode a PacketProtocolFee object and returns the
+// raw encoded bytes. It panics on error.
+func (k Keeper) MustMarshalPacketProtocolFee(packetFee types.PacketProtocolFee) []byte {
+	return k.cdc.MustMarshal(&packetFee)
+}
@@ -4,9 +4,33 @@ package keeper
 	This file is to allow for unexported functions and fields to be accessible to the testing package.
 */
 
-import porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+import (
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+)
 
 // GetICS4Wrapper is a getter for the keeper's ICS4Wrapper.
 func (k *Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
+
+// SetLegacyDenomTrace stores the denomination trace under the key used prior to the migration to structured denominations.
+func (k Keeper) SetLegacyDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store.Set(denomTrace.Hash(), k.MustMarshalDenomTrace(denomTrace))
+}
+
+// HasLegacyDenomTrace checks if the denomination trace is stored under the key used prior to the migration to structured denominations.
+func (k Keeper) HasLegacyDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	return store.Has(denomTrace.Hash())
+}
+
+// SetDefaultTokenHandler replaces the token handlers of the keeper with a registry using the provided default handler.
+func (k *Keeper) SetDefaultTokenHandler(handler types.TokenHandler) {
+	k.tokenHandlers = types.NewTokenHandlerRegistry(handler)
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// forwardPacket sends the coins received in the provided packet to the next hop specified in the
+// forwarding information of the packet data. The coins are sent from the forward address of the
+// channel the packet was received on. The received packet is stored so that its acknowledgement
+// can be written once the packet sent to the next hop is acknowledged or timed out.
+func (k Keeper) forwardPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, receivedCoins sdk.Coins) error {
+	if !k.GetParams(ctx).SendEnabled {
+		return types.ErrSendDisabled
+	}
+
+	for _, coin := range receivedCoins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	nextHop := data.Forwarding.Hops[0]
+
+	// the relative timeout is carried over to the remaining hops
+	var remainingForwarding *types.Forwarding
+	if remainingHops := data.Forwarding.Hops[1:]; len(remainingHops) > 0 {
+		remainingForwarding = types.NewForwarding(remainingHops...)
+		remainingForwarding.RelativeTimeout = data.Forwarding.RelativeTimeout
+	}
+
+	forwardAddress := types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(data.Forwarding.PacketTimeout()).UnixNano())
+
+	sequence, err := k.sendTransfer(
+		ctx, nextHop.PortId, nextHop.ChannelId, receivedCoins, forwardAddress, data.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, data.Forwarding.DestinationMemo, remainingForwarding,
+	)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to forward coins over port %s and channel %s", nextHop.PortId, nextHop.ChannelId)
+	}
+
+	k.SetForwardedPacket(ctx, nextHop.PortId, nextHop.ChannelId, sequence, packet)
+
+	k.Logger(ctx).Info("forwarded ICS-20 packet",
+		"src-port", packet.GetDestPort(), "src-channel", packet.GetDestChannel(), "sequence", packet.GetSequence(),
+		"next-port", nextHop.PortId, "next-channel", nextHop.ChannelId, "next-sequence", sequence,
+	)
+
+	return nil
+}
+
+// acknowledgeForwardedPacket writes a successful acknowledgement for the previously received
+// packet after the packet forwarding its tokens to the next hop has been successfully acknowledged.
+func (k Keeper) acknowledgeForwardedPacket(ctx sdk.Context, prevPacket, forwardedPacket channeltypes.Packet) error {
+	k.deleteForwardedPacket(ctx, forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	return k.writeForwardedPacketAcknowledgement(ctx, prevPacket, ack)
+}
+
+// revertForwardedPacket reverts the receipt of the previously received packet after the packet
+// forwarding its tokens to the next hop has failed or timed out. The forwarded tokens have already
+// been refunded to the forward address, so the vouchers minted upon receipt are burned and the
+// unescrowed tokens are escrowed again. An error acknowledgement is then written for the previously
+// received packet, so that the tokens are refunded on the previous hop.
+func (k Keeper) revertForwardedPacket(ctx sdk.Context, prevPacket, forwardedPacket channeltypes.Packet, failure error) error {
+	k.deleteForwardedPacket(ctx, forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+
+	appVersion, found := k.GetAppVersion(ctx, prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+	if !found {
+		return errorsmod.Wrapf(ibcerrors.ErrNotFound, "app version not found for port %s and channel %s", prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+	}
+
+	data, err := types.UnmarshalPacketData(prevPacket.GetData(), appVersion)
+	if err != nil {
+		return err
+	}
+
+	forwardAddress := types.GetForwardAddress(prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+
+	for _, token := range data.Tokens {
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+
+		if types.ReceiverChainIsSource(prevPacket.GetSourcePort(), prevPacket.GetSourceChannel(), token.Denom) {
+			// the tokens were unescrowed upon receipt, escrow them again
+			voucherPrefix := types.GetDenomPrefix(prevPacket.GetSourcePort(), prevPacket.GetSourceChannel())
+			denom := k.denomFromPath(ctx, token.Denom[len(voucherPrefix):])
+			coin := sdk.NewCoin(denom.IBCDenom(), transferAmount)
+
+			escrowAddress := types.GetEscrowAddress(prevPacket.GetDestPort(), prevPacket.GetDestChannel())
+			if err := k.escrowToken(ctx, forwardAddress, escrowAddress, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// the vouchers were minted upon receipt, burn them
+		denom := k.receivedDenom(ctx, prevPacket.GetDestPort(), prevPacket.GetDestChannel(), token.Denom)
+		voucher := sdk.NewCoin(denom.IBCDenom(), transferAmount)
+
+		if err := k.getTokenHandler(voucher.Denom).BurnTokens(ctx, forwardAddress, voucher); err != nil {
+			return err
+		}
+	}
+
+	ack := channeltypes.NewErrorAcknowledgement(failure)
+	return k.writeForwardedPacketAcknowledgement(ctx, prevPacket, ack)
+}
+
+// writeForwardedPacketAcknowledgement asynchronously writes the acknowledgement of the previously
+// received packet whose tokens were forwarded to the next hop.
+func (k Keeper) writeForwardedPacketAcknowledgement(ctx sdk.Context, prevPacket channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(prevPacket.GetDestPort(), prevPacket.GetDestChannel()))
+	if !ok {
+		return errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, channelCap, prevPacket, ack)
+}
@@ -0,0 +1,364 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// setupForwardingPaths creates ics20-2 transfer paths between chainA and chainB and between chainB and chainC.
+func (suite *KeeperTestSuite) setupForwardingPaths() (*ibctesting.Path, *ibctesting.Path) {
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	pathAtoB.Setup()
+
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = types.V2
+	pathBtoC.EndpointB.ChannelConfig.Version = types.V2
+	pathBtoC.Setup()
+
+	return pathAtoB, pathBtoC
+}
+
+// sendAndReceiveForwardedTransfer sends a transfer from chainA which is forwarded by chainB to chainC.
+// The packet is received on chainB and the returned packets are the packet sent by chainA and the
+// packet sent by chainB to forward the tokens to chainC.
+func (suite *KeeperTestSuite) sendAndReceiveForwardedTransfer(pathAtoB, pathBtoC *ibctesting.Path, coin sdk.Coin, receiver, memo string) (channeltypes.Packet, channeltypes.Packet) {
+	msg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), receiver, suite.chainB.GetTimeoutHeight(), 0, memo,
+	)
+	msg.Forwarding = types.NewForwarding(types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written asynchronously
+	_, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().Error(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	storedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, storedPacket)
+
+	return packet, forwardedPacket
+}
+
+// TestForwardingSuccess tests forwarding tokens from chainA through chainB to chainC.
+func (suite *KeeperTestSuite) TestForwardingSuccess() {
+	suite.SetupTest()
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	packet, forwardedPacket := suite.sendAndReceiveForwardedTransfer(pathAtoB, pathBtoC, coin, receiver.String(), "memo")
+
+	// the memo is delivered to the final destination
+	forwardedData, err := types.UnmarshalPacketData(forwardedPacket.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Equal("memo", forwardedData.Memo)
+	suite.Require().Nil(forwardedData.Forwarding)
+	suite.Require().Equal(types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID).String(), forwardedData.Sender)
+
+	res, err := pathBtoC.EndpointB.RecvPacketWithResult(forwardedPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	res, err = pathBtoC.EndpointA.AcknowledgePacketWithResult(forwardedPacket, ack)
+	suite.Require().NoError(err)
+
+	// the acknowledgement of the packet sent by chainA has been written by chainB
+	ack, err = ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+	suite.Require().False(found)
+
+	err = pathAtoB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	// tokens are escrowed on chainA
+	escrowAddress := types.GetEscrowAddress(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID)
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom))
+
+	// vouchers are escrowed on chainB
+	denomTraceB := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, coin.Denom))
+	escrowAddress = types.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	suite.Require().Equal(coin.Amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, denomTraceB.IBCDenom()).Amount)
+
+	forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+
+	// vouchers are received on chainC
+	denomTraceC := types.ParseDenomTrace(types.GetPrefixedDenom(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, denomTraceB.GetFullDenomPath()))
+	suite.Require().Equal(coin.Amount, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, denomTraceC.IBCDenom()).Amount)
+}
+
+// TestForwardingWithMinimumFee tests that forwarding tokens over a fee enabled channel with a minimum fee succeeds,
+// as the fees of a packet sent by chainB to forward the tokens cannot be paid before it is sent.
+func (suite *KeeperTestSuite) TestForwardingWithMinimumFee() {
+	suite.SetupTest()
+
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.EndpointA.ChannelConfig.Version = types.V2
+	pathAtoB.EndpointB.ChannelConfig.Version = types.V2
+	pathAtoB.Setup()
+
+	feeTransferVersion := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: types.V2}))
+	pathBtoC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBtoC.EndpointA.ChannelConfig.Version = feeTransferVersion
+	pathBtoC.EndpointB.ChannelConfig.Version = feeTransferVersion
+	pathBtoC.Setup()
+
+	minimumFee := feetypes.NewFee(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))), nil, nil)
+	suite.chainB.GetSimApp().IBCFeeKeeper.SetMinimumFee(suite.chainB.GetContext(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, minimumFee)
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	receiver := suite.chainC.SenderAccount.GetAddress()
+
+	// a transfer sent by a user of chainB without paying the minimum fee fails
+	msg := types.NewMsgTransfer(
+		pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, coin,
+		suite.chainB.SenderAccount.GetAddress().String(), receiver.String(), suite.chainC.GetTimeoutHeight(), 0, "",
+	)
+	_, err := suite.chainB.SendMsgs(msg)
+	suite.Require().ErrorContains(err, feetypes.ErrMinimumFeeNotMet.Error())
+
+	_, forwardedPacket := suite.sendAndReceiveForwardedTransfer(pathAtoB, pathBtoC, coin, receiver.String(), "")
+
+	res, err := pathBtoC.EndpointB.RecvPacketWithResult(forwardedPacket)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointA.AcknowledgePacket(forwardedPacket, ack)
+	suite.Require().NoError(err)
+
+	// vouchers are received on chainC
+	denomB := types.ReceivedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, coin.Denom)
+	denomC := types.ReceivedDenom(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, denomB.Path())
+	suite.Require().Equal(coin.Amount, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiver, denomC.IBCDenom()).Amount)
+}
+
+// TestForwardingFailedAcknowledgement tests that an error acknowledgement written by the final
+// destination is propagated back and the original sender is refunded.
+func (suite *KeeperTestSuite) TestForwardingFailedAcknowledgement() {
+	testCases := []struct {
+		name            string
+		sendFromChainB  bool
+		expEscrowAmount sdkmath.Int
+	}{
+		{
+			"vouchers minted on the intermediate chain are burned",
+			false,
+			sdkmath.ZeroInt(),
+		},
+		{
+			"tokens unescrowed on the intermediate chain are escrowed again",
+			true,
+			sdkmath.NewInt(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+			if tc.sendFromChainB {
+				// send native tokens of chainB to chainA, which are then sent back to be forwarded
+				msg := types.NewMsgTransfer(
+					pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, coin,
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "",
+				)
+
+				res, err := suite.chainB.SendMsgs(msg)
+				suite.Require().NoError(err) // message committed
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+				suite.Require().NoError(err)
+
+				err = pathAtoB.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coin.Denom))
+				coin = sdk.NewCoin(denomTrace.IBCDenom(), coin.Amount)
+			}
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+
+			// the receiver is not a valid address on chainC
+			packet, forwardedPacket := suite.sendAndReceiveForwardedTransfer(pathAtoB, pathBtoC, coin, "invalid", "")
+
+			res, err := pathBtoC.EndpointB.RecvPacketWithResult(forwardedPacket)
+			suite.Require().NoError(err)
+
+			ack, err := ibctesting.ParseAckFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			res, err = pathBtoC.EndpointA.AcknowledgePacketWithResult(forwardedPacket, ack)
+			suite.Require().NoError(err)
+
+			ack, err = ibctesting.ParseAckFromEvents(res.Events)
+			suite.Require().NoError(err)
+			suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketFailed).Acknowledgement(), ack)
+
+			err = pathAtoB.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = pathAtoB.EndpointA.AcknowledgePacket(packet, ack)
+			suite.Require().NoError(err)
+
+			// the sender on chainA has been refunded
+			suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom))
+
+			// the forward address and the escrow towards chainC on chainB are empty
+			forwardAddress := types.GetForwardAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), forwardAddress).IsZero())
+
+			escrowAddress := types.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), escrowAddress).IsZero())
+
+			// the escrow towards chainA on chainB holds the tokens it held before receiving the packet
+			escrowAddress = types.GetEscrowAddress(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expEscrowAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(tc.expEscrowAmount, suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom).Amount)
+
+			// no vouchers exist on chainB
+			denomTraceB := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomTraceB.IBCDenom()).IsZero())
+		})
+	}
+}
+
+// TestForwardingTimeout tests that the timeout of the packet sent to the next hop is propagated
+// back as an error acknowledgement and the original sender is refunded.
+func (suite *KeeperTestSuite) TestForwardingTimeout() {
+	suite.SetupTest()
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+
+	packet, forwardedPacket := suite.sendAndReceiveForwardedTransfer(pathAtoB, pathBtoC, coin, suite.chainC.SenderAccount.GetAddress().String(), "")
+
+	// advance the time of chainC past the timeout of the forwarded packet
+	suite.coordinator.IncrementTimeBy(types.DefaultForwardingPacketTimeout)
+	suite.coordinator.CommitBlock(suite.chainC)
+
+	err := pathBtoC.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointA.TimeoutPacket(forwardedPacket)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), forwardedPacket.GetSourcePort(), forwardedPacket.GetSourceChannel(), forwardedPacket.GetSequence())
+	suite.Require().False(found)
+
+	err = pathAtoB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardedPacketTimedOut).Acknowledgement()
+	err = pathAtoB.EndpointA.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	// the sender on chainA has been refunded
+	suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom))
+
+	// no vouchers exist on chainB
+	denomTraceB := types.ParseDenomTrace(types.GetPrefixedDenom(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, coin.Denom))
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomTraceB.IBCDenom()).IsZero())
+}
+
+// TestForwardingRelativeTimeout tests that the packet sent to the next hop times out after the
+// relative timeout specified by the sender.
+func (suite *KeeperTestSuite) TestForwardingRelativeTimeout() {
+	suite.SetupTest()
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	relativeTimeout := 10 * time.Minute
+
+	msg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	msg.Forwarding = types.NewForwarding(types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+	msg.Forwarding.RelativeTimeout = uint64(relativeTimeout)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	packetData, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(relativeTimeout), packetData.Forwarding.RelativeTimeout)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	blockTime := suite.chainB.ProposedHeader.Time
+	res, err = pathAtoB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	forwardedPacket, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(blockTime.Add(relativeTimeout).UnixNano()), forwardedPacket.GetTimeoutTimestamp())
+}
+
+// TestForwardingOverV1Channel tests that tokens cannot be forwarded over ics20-1 channels.
+func (suite *KeeperTestSuite) TestForwardingOverV1Channel() {
+	suite.SetupTest()
+
+	pathAtoB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAtoB.Setup()
+
+	msg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	msg.Forwarding = types.NewForwarding(types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidVersion)
+}
@@ -14,7 +14,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	for _, trace := range state.DenomTraces {
 		k.SetDenomTrace(ctx, trace)
-		k.setDenomMetadata(ctx, trace)
+
+		// metadata received from the source chain or set through governance is preserved
+		if !k.bankKeeper.HasDenomMetaData(ctx, trace.IBCDenom()) {
+			k.setDenomMetadata(ctx, trace.ToDenom())
+		}
 	}
 
 	// Only try to bind to port if it is not already bound, since we may already own
@@ -35,14 +39,36 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, forwardedPacket := range state.ForwardedPackets {
+		forwardKey := forwardedPacket.ForwardKey
+		k.SetForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardedPacket.Packet)
+	}
+
+	for _, channelParams := range state.ChannelParams {
+		k.SetChannelParams(ctx, channelParams)
+	}
+
+	for _, refundAddress := range state.RefundAddresses {
+		packetID := refundAddress.PacketId
+		k.SetRefundAddress(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence, refundAddress.RefundAddress)
+	}
+
+	for _, packetFee := range state.ProtocolFees {
+		k.SetPacketProtocolFee(ctx, packetFee)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:           k.GetPort(ctx),
+		DenomTraces:      k.GetAllDenomTraces(ctx),
+		Params:           k.GetParams(ctx),
+		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
+		ForwardedPackets: k.GetAllForwardedPackets(ctx),
+		ChannelParams:    k.GetAllChannelParams(ctx),
+		RefundAddresses:  k.GetAllRefundAddresses(ctx),
+		ProtocolFees:     k.GetAllPacketProtocolFees(ctx),
 	}
 }
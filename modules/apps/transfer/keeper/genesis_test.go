@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
@@ -45,11 +48,45 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denom, amount))
 	}
 
+	forwardedPacket := types.ForwardedPacket{
+		ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+		Packet: channeltypes.NewPacket(
+			ibctesting.MockPacketData, 1, types.PortID, ibctesting.FirstChannelID, types.PortID, ibctesting.FirstChannelID,
+			clienttypes.NewHeight(1, 100), 0,
+		),
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), forwardedPacket.ForwardKey.PortId, forwardedPacket.ForwardKey.ChannelId, forwardedPacket.ForwardKey.Sequence, forwardedPacket.Packet)
+
+	channelParams := []types.ChannelParams{
+		types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, "", false, true),
+		types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, sdk.DefaultBondDenom, true, false),
+	}
+	for _, cp := range channelParams {
+		suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), cp)
+	}
+
+	refundAddress := types.PacketRefundAddress{
+		PacketId:      channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+		RefundAddress: suite.chainA.SenderAccount.GetAddress().String(),
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetRefundAddress(suite.chainA.GetContext(), refundAddress.PacketId.PortId, refundAddress.PacketId.ChannelId, refundAddress.PacketId.Sequence, refundAddress.RefundAddress)
+
+	packetFee := types.PacketProtocolFee{
+		PacketId:     channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+		FeeCollector: "fee_collector",
+		Fees:         sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10))),
+	}
+	suite.chainA.GetSimApp().TransferKeeper.SetPacketProtocolFee(suite.chainA.GetContext(), packetFee)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denomTraces.Sort(), genesis.DenomTraces)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.ForwardedPacket{forwardedPacket}, genesis.ForwardedPackets)
+	suite.Require().Equal(channelParams, genesis.ChannelParams)
+	suite.Require().Equal([]types.PacketRefundAddress{refundAddress}, genesis.RefundAddresses)
+	suite.Require().Equal([]types.PacketProtocolFee{packetFee}, genesis.ProtocolFees)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
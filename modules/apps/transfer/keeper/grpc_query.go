@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -53,15 +54,15 @@ func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var traces types.Traces
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
 
 	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
-		result, err := k.UnmarshalDenomTrace(value)
+		result, err := k.UnmarshalDenom(value)
 		if err != nil {
 			return err
 		}
 
-		traces = append(traces, result)
+		traces = append(traces, result.ToDenomTrace())
 		return nil
 	})
 	if err != nil {
@@ -74,6 +75,79 @@ func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// Denom implements the Query/Denom gRPC method
+func (k Keeper) Denom(c context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Hash, "ibc/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom hash: %s, error: %s", hash.String(), err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denom, found := k.GetDenom(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	return &types.QueryDenomResponse{
+		Denom: &denom,
+	}, nil
+}
+
+// Denoms implements the Query/Denoms gRPC method
+func (k Keeper) Denoms(c context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	filterByHop := req.PortId != "" || req.ChannelId != ""
+	if filterByHop {
+		if err := types.NewHop(req.PortId, req.ChannelId).Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var denoms types.Denoms
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		denom, err := k.UnmarshalDenom(value)
+		if err != nil {
+			return false, err
+		}
+
+		if filterByHop && !denom.HasHop(req.PortId, req.ChannelId) {
+			return false, nil
+		}
+
+		if req.BaseDenom != "" && denom.Base != req.BaseDenom {
+			return false, nil
+		}
+
+		if accumulate {
+			denoms = append(denoms, denom)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsResponse{
+		Denoms:     denoms.Sort(),
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -90,15 +164,15 @@ func (k Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	// Convert given request trace path to DenomTrace struct to confirm the path in a valid denom trace format
-	denomTrace := types.ParseDenomTrace(req.Trace)
-	if err := denomTrace.Validate(); err != nil {
+	// Convert given request trace path to a Denom to confirm the path is in a valid denom format
+	denom := types.ExtractDenomFromPath(req.Trace)
+	if err := denom.Validate(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	denomHash := denomTrace.Hash()
-	found := k.HasDenomTrace(ctx, denomHash)
+	denomHash := denom.Hash()
+	found := k.HasDenom(ctx, denomHash)
 	if !found {
 		return nil, status.Error(
 			codes.NotFound,
@@ -142,3 +216,136 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// ChannelParams implements the Query/ChannelParams gRPC method
+func (k Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	storePrefix := types.ChannelParamsKey
+	if req.PortId != "" || req.ChannelId != "" {
+		if err := types.NewChannelParams(req.PortId, req.ChannelId, "", true, true).Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		storePrefix = append(append([]byte{}, types.ChannelParamsKey...), types.ChannelParamsPrefix(req.PortId, req.ChannelId)...)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var channelParams []types.ChannelParams
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		cp, err := k.UnmarshalChannelParams(value)
+		if err != nil {
+			return err
+		}
+
+		channelParams = append(channelParams, cp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelParamsResponse{
+		ChannelParams: channelParams,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// TransferEnabled implements the Query/TransferEnabled gRPC method
+func (k Keeper) TransferEnabled(c context.Context, req *types.QueryTransferEnabledRequest) (*types.QueryTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.NewChannelParams(req.PortId, req.ChannelId, req.Denom, true, true).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryTransferEnabledResponse{
+		SendEnabled:    params.SendEnabled && k.IsChannelSendEnabled(ctx, req.PortId, req.ChannelId, req.Denom),
+		ReceiveEnabled: params.ReceiveEnabled && k.IsChannelReceiveEnabled(ctx, req.PortId, req.ChannelId, req.Denom),
+	}, nil
+}
+
+// EscrowReconciliation implements the Query/EscrowReconciliation gRPC method
+func (k Keeper) EscrowReconciliation(c context.Context, req *types.QueryEscrowReconciliationRequest) (*types.QueryEscrowReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	escrowBalances := k.GetEscrowBalances(ctx)
+
+	return &types.QueryEscrowReconciliationResponse{
+		EscrowBalances: escrowBalances,
+		Discrepancies:  k.GetEscrowDiscrepancies(ctx, escrowBalances),
+	}, nil
+}
+
+// RefundAddress implements the Query/RefundAddress gRPC method
+func (k Keeper) RefundAddress(c context.Context, req *types.QueryRefundAddressRequest) (*types.QueryRefundAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	refundAddress, found := k.GetRefundAddress(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRefundAddressNotFound, "port ID (%s) channel ID (%s) sequence (%d)", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	return &types.QueryRefundAddressResponse{RefundAddress: refundAddress}, nil
+}
+
+// ProtocolFee implements the Query/ProtocolFee gRPC method
+func (k Keeper) ProtocolFee(c context.Context, req *types.QueryProtocolFeeRequest) (*types.QueryProtocolFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(req.Tokens)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if coins.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "tokens cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	protocolFee := k.GetParams(ctx).ProtocolFee
+	fees := protocolFee.GetFees(req.PortId, req.ChannelId, coins)
+
+	return &types.QueryProtocolFeeResponse{
+		Fees:         fees,
+		NetTokens:    coins.Sub(fees...),
+		FeeCollector: protocolFee.FeeCollector,
+	}, nil
+}
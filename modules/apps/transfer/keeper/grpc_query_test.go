@@ -7,8 +7,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -153,6 +156,411 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenom() {
+	var (
+		req      *types.QueryDenomRequest
+		expDenom types.Denom
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: correct ibc denom",
+			func() {
+				expDenom = types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB"))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), expDenom)
+
+				req = &types.QueryDenomRequest{
+					Hash: expDenom.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"success: correct hex hash",
+			func() {
+				expDenom = types.NewDenom("uatom", types.NewHop("transfer", "channelToA"))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), expDenom)
+
+				req = &types.QueryDenomRequest{
+					Hash: expDenom.Hash().String(),
+				}
+			},
+			true,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req = &types.QueryDenomRequest{
+					Hash: "!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"failure: not found denom",
+			func() {
+				expDenom = types.NewDenom("uatom", types.NewHop("transfer", "channelToA"))
+				req = &types.QueryDenomRequest{
+					Hash: expDenom.IBCDenom(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Denom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expDenom, res.Denom)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenoms() {
+	var req *types.QueryDenomsRequest
+
+	denoms := types.Denoms{
+		types.NewDenom("uatom", types.NewHop("transfer", "channelToB")),
+		types.NewDenom("uatom", types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB")),
+		types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channelToA")),
+		types.NewDenom("uosmo", types.NewHop("transfer", "channelToC")),
+	}
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expDenoms types.Denoms
+		expPass   bool
+	}{
+		{
+			"success: no filters",
+			func() {},
+			denoms,
+			true,
+		},
+		{
+			"success: filter by hop",
+			func() {
+				req.PortId = "transfer"
+				req.ChannelId = "channelToB"
+			},
+			types.Denoms{denoms[0], denoms[1]},
+			true,
+		},
+		{
+			"success: filter by base denom",
+			func() {
+				req.BaseDenom = "gamm/pool/1"
+			},
+			types.Denoms{denoms[2]},
+			true,
+		},
+		{
+			"success: filter by hop and base denom",
+			func() {
+				req.PortId = "transfer"
+				req.ChannelId = "channelToA"
+				req.BaseDenom = "uatom"
+			},
+			types.Denoms{denoms[1]},
+			true,
+		},
+		{
+			"success: no denom matches the filters",
+			func() {
+				req.PortId = "transfer"
+				req.ChannelId = "channelToC"
+				req.BaseDenom = "uatom"
+			},
+			nil,
+			true,
+		},
+		{
+			"failure: channel identifier of hop is missing",
+			func() {
+				req.PortId = "transfer"
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			for _, denom := range denoms {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(ctx, denom)
+			}
+
+			req = &types.QueryDenomsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      10,
+					CountTotal: true,
+				},
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Denoms(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().ElementsMatch(tc.expDenoms, res.Denoms)
+				suite.Require().Equal(uint64(len(tc.expDenoms)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelParams() {
+	var req *types.QueryChannelParamsRequest
+
+	channelParams := []types.ChannelParams{
+		types.NewChannelParams("transfer", "channel-0", "", false, true),
+		types.NewChannelParams("transfer", "channel-0", "uatom", true, false),
+		types.NewChannelParams("transfer", "channel-1", "uatom", false, false),
+	}
+
+	testCases := []struct {
+		msg              string
+		malleate         func()
+		expChannelParams []types.ChannelParams
+		expPass          bool
+	}{
+		{
+			"success: no filters",
+			func() {},
+			channelParams,
+			true,
+		},
+		{
+			"success: filter by channel",
+			func() {
+				req.PortId = "transfer"
+				req.ChannelId = "channel-0"
+			},
+			channelParams[:2],
+			true,
+		},
+		{
+			"success: no channel params for channel",
+			func() {
+				req.PortId = "transfer"
+				req.ChannelId = "channel-2"
+			},
+			nil,
+			true,
+		},
+		{
+			"failure: channel identifier is missing",
+			func() {
+				req.PortId = "transfer"
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			for _, cp := range channelParams {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(ctx, cp)
+			}
+
+			req = &types.QueryChannelParamsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      10,
+					CountTotal: true,
+				},
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelParams(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expChannelParams, res.ChannelParams)
+				suite.Require().Equal(uint64(len(tc.expChannelParams)), res.Pagination.Total)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferEnabled() {
+	var req *types.QueryTransferEnabledRequest
+
+	testCases := []struct {
+		msg               string
+		malleate          func()
+		expSendEnabled    bool
+		expReceiveEnabled bool
+		expPass           bool
+	}{
+		{
+			"success: no channel params",
+			func() {},
+			true,
+			true,
+			true,
+		},
+		{
+			"success: sending disabled over channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), types.NewChannelParams("transfer", "channel-0", "", false, true))
+			},
+			false,
+			true,
+			true,
+		},
+		{
+			"success: receiving of denom disabled over channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), types.NewChannelParams("transfer", "channel-0", "uatom", true, false))
+			},
+			true,
+			false,
+			true,
+		},
+		{
+			"success: receiving of another denom disabled over channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), types.NewChannelParams("transfer", "channel-0", "uosmo", true, false))
+			},
+			true,
+			true,
+			true,
+		},
+		{
+			"success: sending disabled by module params",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			},
+			false,
+			true,
+			true,
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryTransferEnabledRequest{
+				PortId:    "transfer",
+				ChannelId: "channel-0",
+				Denom:     "uatom",
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferEnabled(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(tc.expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEscrowReconciliation() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// escrow 100 tokens with a transfer
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	_, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	// the escrow address of a channel over a port which only starts with the transfer port is not included
+	otherPortID := path.EndpointA.ChannelConfig.PortID + "other"
+	otherChannel := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.UNORDERED, channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), []string{path.EndpointA.ConnectionID}, types.V2)
+	suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetChannel(suite.chainA.GetContext(), otherPortID, path.EndpointA.ChannelID, otherChannel)
+	otherEscrowAddress := types.GetEscrowAddress(otherPortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, otherEscrowAddress, sdk.NewCoins(coin)))
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	expEscrowBalance := types.EscrowBalance{
+		PortId:        path.EndpointA.ChannelConfig.PortID,
+		ChannelId:     path.EndpointA.ChannelID,
+		EscrowAddress: escrowAddress.String(),
+		Balances:      sdk.NewCoins(coin),
+	}
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(suite.chainA.GetContext(), &types.QueryEscrowReconciliationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowBalance{expEscrowBalance}, res.EscrowBalances)
+	suite.Require().Empty(res.Discrepancies)
+
+	// send tokens directly to the escrow address and lower the total escrow of another denomination
+	excess := sdk.NewCoin("uatom", sdkmath.NewInt(50))
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(excess)))
+	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(120)))
+
+	expEscrowBalance.Balances = sdk.NewCoins(coin, excess)
+	expDiscrepancies := []types.EscrowDiscrepancy{
+		{Denom: sdk.DefaultBondDenom, Expected: sdkmath.NewInt(120), Actual: sdkmath.NewInt(100)},
+		{Denom: "uatom", Expected: sdkmath.ZeroInt(), Actual: sdkmath.NewInt(50)},
+	}
+
+	res, err = suite.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(suite.chainA.GetContext(), &types.QueryEscrowReconciliationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.EscrowBalance{expEscrowBalance}, res.EscrowBalances)
+	suite.Require().Equal(expDiscrepancies, res.Discrepancies)
+
+	_, err = suite.chainA.GetSimApp().TransferKeeper.EscrowReconciliation(suite.chainA.GetContext(), nil)
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
@@ -364,3 +772,177 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryRefundAddress() {
+	var (
+		req              *types.QueryRefundAddressRequest
+		expRefundAddress string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expRefundAddress = suite.chainA.SenderAccount.GetAddress().String()
+				suite.chainA.GetSimApp().TransferKeeper.SetRefundAddress(suite.chainA.GetContext(), ibctesting.TransferPort, ibctesting.FirstChannelID, 1, expRefundAddress)
+
+				req = &types.QueryRefundAddressRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  1,
+				}
+			},
+			true,
+		},
+		{
+			"refund address not found",
+			func() {
+				req = &types.QueryRefundAddressRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryRefundAddressRequest{
+					PortId:    "",
+					ChannelId: ibctesting.FirstChannelID,
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryRefundAddressRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "",
+					Sequence:  1,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.RefundAddress(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRefundAddress, res.RefundAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryProtocolFee() {
+	var req *types.QueryProtocolFeeRequest
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expFees      sdk.Coins
+		expNetTokens sdk.Coins
+		expPass      bool
+	}{
+		{
+			"success",
+			func() {},
+			sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(2)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(998)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(999))),
+			true,
+		},
+		{
+			"success: channel without fees",
+			func() {
+				req.ChannelId = "channel-1"
+			},
+			sdk.NewCoins(),
+			sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1000)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000))),
+			true,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"invalid tokens",
+			func() {
+				req.Tokens = "invalid"
+			},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"empty tokens",
+			func() {
+				req.Tokens = ""
+			},
+			nil,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.ProtocolFee = types.NewProtocolFee(10, authtypes.FeeCollectorName, false,
+				types.NewProtocolFeeRate("", "", "uatom", 20),
+				types.NewProtocolFeeRate(ibctesting.TransferPort, "channel-1", "", 0),
+			)
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			req = &types.QueryProtocolFeeRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Tokens:    "1000uatom,1000stake",
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ProtocolFee(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expFees, res.Fees)
+				suite.Require().Equal(tc.expNetTokens, res.NetTokens)
+				suite.Require().Equal(authtypes.FeeCollectorName, res.FeeCollector)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
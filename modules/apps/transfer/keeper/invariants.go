@@ -33,9 +33,12 @@ func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
 		transferChannels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
 		for _, channel := range transferChannels {
 			escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
-			escrowBalances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
 
-			actualTotalEscrowed = actualTotalEscrowed.Add(escrowBalances...)
+			// the balances are queried through the token handler of each denomination in escrow
+			for _, expectedEscrow := range expectedTotalEscrowed {
+				escrowBalance := k.getTokenHandler(expectedEscrow.Denom).GetBalance(ctx, escrowAddress, expectedEscrow.Denom)
+				actualTotalEscrowed = actualTotalEscrowed.Add(escrowBalance)
+			}
 		}
 
 		// the actual escrowed amount must be greater than or equal to the expected amount for all denominations
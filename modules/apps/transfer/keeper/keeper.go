@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/log"
@@ -15,9 +16,11 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -36,6 +39,10 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	scopedKeeper  exported.ScopedKeeper
 
+	// tokenHandlers selects the handler used to move the tokens of a denomination. The
+	// registry is shared by all copies of the keeper.
+	tokenHandlers *types.TokenHandlerRegistry
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -73,6 +80,7 @@ func NewKeeper(
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
 		scopedKeeper:   scopedKeeper,
+		tokenHandlers:  types.NewTokenHandlerRegistry(NewBankTokenHandler(bankKeeper)),
 		authority:      authority,
 	}
 }
@@ -84,6 +92,26 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// RegisterTokenHandler registers the token handler used for all denominations starting with
+// the given prefix. Denominations which do not match any registered prefix are handled by the
+// bank module. It panics if the handler cannot be registered, and must only be called during
+// app initialization.
+func (k Keeper) RegisterTokenHandler(prefix string, handler types.TokenHandler) {
+	if err := k.tokenHandlers.Register(prefix, handler); err != nil {
+		panic(err)
+	}
+}
+
+// getTokenHandler returns the token handler used to move tokens of the given denomination.
+func (k Keeper) getTokenHandler(denom string) types.TokenHandler {
+	return k.tokenHandlers.GetHandler(denom)
+}
+
+// GetAppVersion calls the ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
 // GetAuthority returns the transfer module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -139,29 +167,76 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store.Set([]byte(types.ParamsKey), bz)
 }
 
+// GetDenom retrieves the denomination with the given hash from the store.
+func (k Keeper) GetDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) (types.Denom, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+	bz := store.Get(denomHash)
+	if len(bz) == 0 {
+		return types.Denom{}, false
+	}
+
+	denom := k.MustUnmarshalDenom(bz)
+	return denom, true
+}
+
+// HasDenom checks if the key with the given denomination hash exists on the store.
+func (k Keeper) HasDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+	return store.Has(denomHash)
+}
+
+// SetDenom sets a new {denom hash -> denom} pair to the store.
+func (k Keeper) SetDenom(ctx sdk.Context, denom types.Denom) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomKey)
+	bz := k.MustMarshalDenom(denom)
+	store.Set(denom.Hash(), bz)
+}
+
+// GetAllDenoms returns all the denominations.
+func (k Keeper) GetAllDenoms(ctx sdk.Context) types.Denoms {
+	denoms := types.Denoms{}
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	return denoms.Sort()
+}
+
+// IterateDenoms iterates over the denominations in the store
+// and performs a callback function.
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom types.Denom) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		denom := k.MustUnmarshalDenom(iterator.Value())
+		if cb(denom) {
+			break
+		}
+	}
+}
+
 // GetDenomTrace retrieves the full identifiers trace and base denomination from the store.
 func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (types.DenomTrace, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	bz := store.Get(denomTraceHash)
-	if len(bz) == 0 {
+	denom, found := k.GetDenom(ctx, denomTraceHash)
+	if !found {
 		return types.DenomTrace{}, false
 	}
 
-	denomTrace := k.MustUnmarshalDenomTrace(bz)
-	return denomTrace, true
+	return denom.ToDenomTrace(), true
 }
 
 // HasDenomTrace checks if a the key with the given denomination trace hash exists on the store.
 func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	return store.Has(denomTraceHash)
+	return k.HasDenom(ctx, denomTraceHash)
 }
 
-// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+// SetDenomTrace sets a new {trace hash -> denom} pair to the store. The denomination
+// trace is stored as a Denom.
 func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
-	bz := k.MustMarshalDenomTrace(denomTrace)
-	store.Set(denomTrace.Hash(), bz)
+	k.SetDenom(ctx, denomTrace.ToDenom())
 }
 
 // GetAllDenomTraces returns the trace information for all the denominations.
@@ -175,41 +250,80 @@ func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
 	return traces.Sort()
 }
 
-// IterateDenomTraces iterates over the denomination traces in the store
-// and performs a callback function.
+// IterateDenomTraces iterates over the denominations in the store
+// and performs a callback function with their trace information.
 func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.DenomTrace) bool) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := storetypes.KVStorePrefixIterator(store, types.DenomTraceKey)
+	k.IterateDenoms(ctx, func(denom types.Denom) bool {
+		return cb(denom.ToDenomTrace())
+	})
+}
 
-	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
-	for ; iterator.Valid(); iterator.Next() {
-		denomTrace := k.MustUnmarshalDenomTrace(iterator.Value())
-		if cb(denomTrace) {
-			break
+// setDenomMetadata sets an IBC token's denomination metadata
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denom types.Denom) {
+	k.bankKeeper.SetDenomMetaData(ctx, newDenomMetadata(denom))
+}
+
+// setVoucherDenomMetadata sets the denomination metadata of an IBC voucher received
+// on this chain. If the bank metadata of the token on the sending chain is provided,
+// it is stored for the voucher unless metadata other than the one derived from the
+// denomination trace already exists (e.g. set through governance). Otherwise the
+// metadata is derived from the denomination trace if none exists yet.
+func (k Keeper) setVoucherDenomMetadata(ctx sdk.Context, denom types.Denom, sourceMetadata *banktypes.Metadata) {
+	existing, found := k.bankKeeper.GetDenomMetaData(ctx, denom.IBCDenom())
+	if sourceMetadata == nil {
+		if !found {
+			k.setDenomMetadata(ctx, denom)
 		}
+		return
+	}
+
+	defaultMetadata := newDenomMetadata(denom)
+	if found && !gogoproto.Equal(&existing, &defaultMetadata) {
+		return
 	}
+
+	metadata, err := types.NewVoucherDenomMetadata(denom, *sourceMetadata)
+	if err != nil {
+		k.Logger(ctx).Error("failed to set metadata received for IBC voucher", "denom", denom.IBCDenom(), "error", err)
+		if !found {
+			k.setDenomMetadata(ctx, denom)
+		}
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
-// setDenomMetadata sets an IBC token's denomination metadata
-func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
-	metadata := banktypes.Metadata{
-		Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
+// getSourceDenomMetadata returns the bank metadata of the provided denomination to be
+// embedded in outgoing packet data. Nil is returned if no valid metadata exists.
+func (k Keeper) getSourceDenomMetadata(ctx sdk.Context, denom string) *banktypes.Metadata {
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found || metadata.Validate() != nil {
+		return nil
+	}
+
+	return &metadata
+}
+
+// newDenomMetadata returns the minimal denomination metadata of an IBC token derived
+// from its denomination trace.
+func newDenomMetadata(denom types.Denom) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denom.Path()),
 		DenomUnits: []*banktypes.DenomUnit{
 			{
-				Denom:    denomTrace.BaseDenom,
+				Denom:    denom.Base,
 				Exponent: 0,
 			},
 		},
 		// Setting base as IBC hash denom since bank keepers's SetDenomMetadata uses
 		// Base as key path and the IBC hash is what gives this token uniqueness
 		// on the executing chain
-		Base:    denomTrace.IBCDenom(),
-		Display: denomTrace.GetFullDenomPath(),
-		Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
-		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
+		Base:    denom.IBCDenom(),
+		Display: denom.Path(),
+		Name:    fmt.Sprintf("%s IBC token", denom.Path()),
+		Symbol:  strings.ToUpper(denom.Base),
 	}
-
-	k.bankKeeper.SetDenomMetaData(ctx, metadata)
 }
 
 // GetTotalEscrowForDenom gets the total amount of source chain tokens that
@@ -287,6 +401,227 @@ func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, storeprefix []byte, cb fu
 	}
 }
 
+// GetForwardedPacket gets the packet which was received and forwarded to the next hop using the
+// packet identified by the provided port ID, channel ID and sequence.
+func (k Keeper) GetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketForwardKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+
+	return packet, true
+}
+
+// SetForwardedPacket stores the packet which was received and forwarded to the next hop, keyed by
+// the port ID, channel ID and sequence of the packet sent to the next hop.
+func (k Keeper) SetForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.PacketForwardKey(portID, channelID, sequence), bz)
+}
+
+// deleteForwardedPacket deletes the forwarded packet stored for the packet sent to the next hop.
+func (k Keeper) deleteForwardedPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketForwardKey(portID, channelID, sequence))
+}
+
+// GetAllForwardedPackets returns all the packets which have been forwarded to a next hop and are
+// awaiting the acknowledgement or timeout of the packet sent to the next hop.
+func (k Keeper) GetAllForwardedPackets(ctx sdk.Context) []types.ForwardedPacket {
+	var forwardedPackets []types.ForwardedPacket
+	k.IterateForwardedPackets(ctx, func(forwardedPacket types.ForwardedPacket) bool {
+		forwardedPackets = append(forwardedPackets, forwardedPacket)
+		return false
+	})
+
+	return forwardedPackets
+}
+
+// IterateForwardedPackets iterates over the forwarded packets in the store and performs
+// a callback function.
+func (k Keeper) IterateForwardedPackets(ctx sdk.Context, cb func(forwardedPacket types.ForwardedPacket) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ForwardedPacketKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()[len(types.ForwardedPacketKey):]), "/")
+		if len(keySplit) != 3 {
+			panic(fmt.Errorf("invalid forwarded packet key: %s", iterator.Key()))
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[2], 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("invalid forwarded packet key sequence: %w", err))
+		}
+
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		forwardedPacket := types.ForwardedPacket{
+			ForwardKey: channeltypes.NewPacketID(keySplit[0], keySplit[1], sequence),
+			Packet:     packet,
+		}
+
+		if cb(forwardedPacket) {
+			break
+		}
+	}
+}
+
+// GetRefundAddress gets the alternate refund address of the sent packet identified by the provided
+// port ID, channel ID and sequence.
+func (k Keeper) GetRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketRefundAddressKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetRefundAddress stores the address credited with the refunded tokens of the sent packet identified
+// by the provided port ID, channel ID and sequence, in place of the packet sender.
+func (k Keeper) SetRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64, refundAddress string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PacketRefundAddressKey(portID, channelID, sequence), []byte(refundAddress))
+}
+
+// deleteRefundAddress deletes the alternate refund address stored for the sent packet.
+func (k Keeper) deleteRefundAddress(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketRefundAddressKey(portID, channelID, sequence))
+}
+
+// GetAllRefundAddresses returns the alternate refund addresses of all the sent packets which are
+// awaiting an acknowledgement or timeout.
+func (k Keeper) GetAllRefundAddresses(ctx sdk.Context) []types.PacketRefundAddress {
+	var refundAddresses []types.PacketRefundAddress
+	k.IterateRefundAddresses(ctx, func(refundAddress types.PacketRefundAddress) bool {
+		refundAddresses = append(refundAddresses, refundAddress)
+		return false
+	})
+
+	return refundAddresses
+}
+
+// IterateRefundAddresses iterates over the alternate refund addresses in the store and performs
+// a callback function.
+func (k Keeper) IterateRefundAddresses(ctx sdk.Context, cb func(refundAddress types.PacketRefundAddress) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RefundAddressKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()[len(types.RefundAddressKey):]), "/")
+		if len(keySplit) != 3 {
+			panic(fmt.Errorf("invalid refund address key: %s", iterator.Key()))
+		}
+
+		sequence, err := strconv.ParseUint(keySplit[2], 10, 64)
+		if err != nil {
+			panic(fmt.Errorf("invalid refund address key sequence: %w", err))
+		}
+
+		refundAddress := types.PacketRefundAddress{
+			PacketId:      channeltypes.NewPacketID(keySplit[0], keySplit[1], sequence),
+			RefundAddress: string(iterator.Value()),
+		}
+
+		if cb(refundAddress) {
+			break
+		}
+	}
+}
+
+// GetChannelParams returns the parameters of the provided channel and denomination. An empty
+// denomination returns the parameters applying to the whole channel.
+func (k Keeper) GetChannelParams(ctx sdk.Context, portID, channelID, denom string) (types.ChannelParams, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelParamsKey)
+	bz := store.Get(types.ChannelParamsStoreKey(portID, channelID, denom))
+	if len(bz) == 0 {
+		return types.ChannelParams{}, false
+	}
+
+	return k.MustUnmarshalChannelParams(bz), true
+}
+
+// SetChannelParams stores the provided channel parameters. Parameters enabling both sending and
+// receiving are equivalent to the default and are deleted from the store instead.
+func (k Keeper) SetChannelParams(ctx sdk.Context, channelParams types.ChannelParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ChannelParamsKey)
+	key := types.ChannelParamsStoreKey(channelParams.PortId, channelParams.ChannelId, channelParams.Denom)
+
+	if channelParams.IsDefault() {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, k.MustMarshalChannelParams(channelParams))
+}
+
+// GetAllChannelParams returns the parameters of all channels.
+func (k Keeper) GetAllChannelParams(ctx sdk.Context) []types.ChannelParams {
+	var channelParams []types.ChannelParams
+	k.IterateChannelParams(ctx, func(cp types.ChannelParams) bool {
+		channelParams = append(channelParams, cp)
+		return false
+	})
+
+	return channelParams
+}
+
+// IterateChannelParams iterates over the channel parameters in the store and performs
+// a callback function.
+func (k Keeper) IterateChannelParams(ctx sdk.Context, cb func(channelParams types.ChannelParams) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelParamsKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		channelParams := k.MustUnmarshalChannelParams(iterator.Value())
+		if cb(channelParams) {
+			break
+		}
+	}
+}
+
+// IsChannelSendEnabled returns true if the channel parameters enable sending the denomination
+// over the channel. Both the parameters of the channel and of the denomination over the
+// channel must enable sending. The module parameters are not taken into account.
+func (k Keeper) IsChannelSendEnabled(ctx sdk.Context, portID, channelID, denom string) bool {
+	if channelParams, found := k.GetChannelParams(ctx, portID, channelID, ""); found && !channelParams.SendEnabled {
+		return false
+	}
+
+	if channelParams, found := k.GetChannelParams(ctx, portID, channelID, denom); found && !channelParams.SendEnabled {
+		return false
+	}
+
+	return true
+}
+
+// IsChannelReceiveEnabled returns true if the channel parameters enable receiving the denomination
+// over the channel. Both the parameters of the channel and of the denomination over the
+// channel must enable receiving. The module parameters are not taken into account.
+func (k Keeper) IsChannelReceiveEnabled(ctx sdk.Context, portID, channelID, denom string) bool {
+	if channelParams, found := k.GetChannelParams(ctx, portID, channelID, ""); found && !channelParams.ReceiveEnabled {
+		return false
+	}
+
+	if channelParams, found := k.GetChannelParams(ctx, portID, channelID, denom); found && !channelParams.ReceiveEnabled {
+		return false
+	}
+
+	return true
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -3,6 +3,9 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -31,10 +34,13 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 }
 
 // MigrateTraces migrates the DenomTraces to the correct format, accounting for slashes in the BaseDenom.
+// The denomination traces are then moved to the store of structured denominations, in which each
+// hop of the trace is stored as a port and channel identifier pair. The hash under which each
+// denomination is stored, and thus its IBC denomination, remains unchanged.
 func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 	// list of traces that must replace the old traces in store
 	var newTraces []types.DenomTrace
-	m.keeper.IterateDenomTraces(ctx,
+	m.keeper.iterateLegacyDenomTraces(ctx,
 		func(dt types.DenomTrace) (stop bool) {
 			// check if the new way of splitting FullDenom
 			// is the same as the current DenomTrace.
@@ -52,27 +58,28 @@ func (m Migrator) MigrateTraces(ctx sdk.Context) error {
 				panic(fmt.Errorf("migration will result in corrupted state. Previous IBC token (%s) requires a bank migration. Expected denom trace (%s)", dt, newTrace))
 			}
 
-			if !equalTraces(newTrace, dt) {
-				newTraces = append(newTraces, newTrace)
-			}
-
+			newTraces = append(newTraces, newTrace)
 			return false
 		})
 
-	// replace the outdated traces with the new trace information
+	// replace the outdated traces with the new structured denominations
+	store := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.DenomTraceKey)
 	for _, nt := range newTraces {
-		m.keeper.SetDenomTrace(ctx, nt)
+		store.Delete(nt.Hash())
+		m.keeper.SetDenom(ctx, nt.ToDenom())
 	}
+
+	m.keeper.Logger(ctx).Info("successfully migrated denomination traces", "number of denominations", len(newTraces))
 	return nil
 }
 
 // MigrateDenomMetadata sets token metadata for all the IBC denom traces
 func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
-	m.keeper.IterateDenomTraces(ctx,
-		func(dt types.DenomTrace) (stop bool) {
-			// check if the metadata for the given denom trace does not already exist
-			if !m.keeper.bankKeeper.HasDenomMetaData(ctx, dt.IBCDenom()) {
-				m.keeper.setDenomMetadata(ctx, dt)
+	m.keeper.IterateDenoms(ctx,
+		func(denom types.Denom) (stop bool) {
+			// check if the metadata for the given denom does not already exist
+			if !m.keeper.bankKeeper.HasDenomMetaData(ctx, denom.IBCDenom()) {
+				m.keeper.setDenomMetadata(ctx, denom)
 			}
 			return false
 		})
@@ -102,6 +109,17 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	return nil
 }
 
-func equalTraces(dtA, dtB types.DenomTrace) bool {
-	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
+// iterateLegacyDenomTraces iterates over the denomination traces stored prior to
+// the migration to structured denominations and performs a callback function.
+func (k Keeper) iterateLegacyDenomTraces(ctx sdk.Context, cb func(denomTrace types.DenomTrace) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomTraceKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		denomTrace := k.MustUnmarshalDenomTrace(iterator.Value())
+		if cb(denomTrace) {
+			break
+		}
+	}
 }
@@ -57,7 +57,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: two slashes in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
 					suite.chainA.GetContext(),
 					transfertypes.DenomTrace{
 						BaseDenom: "pool/1", Path: "transfer/channel-0/gamm",
@@ -72,7 +72,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: one slash in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
 					suite.chainA.GetContext(),
 					transfertypes.DenomTrace{
 						BaseDenom: "0x85bcBCd7e79Ec36f4fBBDc54F90C643d921151AA", Path: "transfer/channel-149/erc",
@@ -87,7 +87,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: multiple slashes in a row in base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
 					suite.chainA.GetContext(),
 					transfertypes.DenomTrace{
 						BaseDenom: "1", Path: "transfer/channel-5/gamm//pool",
@@ -102,7 +102,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 		{
 			"success: multihop base denom",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
 					suite.chainA.GetContext(),
 					transfertypes.DenomTrace{
 						BaseDenom: "transfer/channel-1/uatom", Path: "transfer/channel-0",
@@ -114,10 +114,25 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 				},
 			},
 		},
+		{
+			"success: trace is already in the correct format",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
+					suite.chainA.GetContext(),
+					transfertypes.DenomTrace{
+						BaseDenom: "uatom", Path: "transfer/channel-0",
+					})
+			},
+			transfertypes.Traces{
+				{
+					BaseDenom: "uatom", Path: "transfer/channel-0",
+				},
+			},
+		},
 		{
 			"success: non-standard port",
 			func() {
-				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(
+				suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(
 					suite.chainA.GetContext(),
 					transfertypes.DenomTrace{
 						BaseDenom: "customport/channel-7/uatom", Path: "transfer/channel-0/transfer/channel-1",
@@ -144,6 +159,13 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 
 			traces := suite.chainA.GetSimApp().TransferKeeper.GetAllDenomTraces(suite.chainA.GetContext())
 			suite.Require().Equal(tc.expectedTraces, traces)
+
+			for _, trace := range traces {
+				denom, found := suite.chainA.GetSimApp().TransferKeeper.GetDenom(suite.chainA.GetContext(), trace.Hash())
+				suite.Require().True(found)
+				suite.Require().Equal(trace.IBCDenom(), denom.IBCDenom())
+				suite.Require().False(suite.chainA.GetSimApp().TransferKeeper.HasLegacyDenomTrace(suite.chainA.GetContext(), trace))
+			}
 		})
 	}
 }
@@ -154,7 +176,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateTracesCorruptionDetection() {
 		BaseDenom: "customport/channel-0/uatom",
 		Path:      "",
 	}
-	suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), corruptedDenomTrace)
+	suite.chainA.GetSimApp().TransferKeeper.SetLegacyDenomTrace(suite.chainA.GetContext(), corruptedDenomTrace)
 
 	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
 	suite.Panics(func() {
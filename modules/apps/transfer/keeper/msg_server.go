@@ -4,10 +4,12 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
@@ -26,31 +28,80 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
-	if !k.bankKeeper.IsSendEnabledCoin(ctx, msg.Token) {
-		return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", msg.Token.Denom)
+	coins := msg.GetCoins()
+
+	for _, coin := range coins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return nil, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
 	}
 
 	if k.bankKeeper.BlockedAddr(sender) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if msg.RefundAddress != "" {
+		refundAddress, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.bankKeeper.BlockedAddr(refundAddress) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", refundAddress)
+		}
+	}
+
+	// the protocol fees are deducted from the transferred coins before they are escrowed or burned
+	protocolFee := k.GetParams(ctx).ProtocolFee
+	fees, err := k.chargeProtocolFees(ctx, protocolFee, msg.SourcePort, msg.SourceChannel, sender, coins)
+	if err != nil {
+		return nil, err
+	}
+	coins = coins.Sub(fees...)
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		msg.Memo, msg.Forwarding)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	if protocolFee.RefundOnFailure && !fees.IsZero() {
+		k.SetPacketProtocolFee(ctx, types.PacketProtocolFee{
+			PacketId:     channeltypes.NewPacketID(msg.SourcePort, msg.SourceChannel, sequence),
+			FeeCollector: protocolFee.FeeCollector,
+			Fees:         fees,
+		})
+	}
+
+	// the refund address is kept in local state only, the counterparty never sees it
+	if msg.RefundAddress != "" {
+		k.SetRefundAddress(ctx, msg.SourcePort, msg.SourceChannel, sequence, msg.RefundAddress)
+	}
+
+	k.Logger(ctx).Info("IBC fungible token transfer", "tokens", coins.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+	}
+	// the amount and denomination of single token transfers are emitted as for ics20-1 transfers
+	if len(coins) == 1 {
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributeKeyAmount, coins[0].Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, coins[0].Denom),
+		)
+	}
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeKeyTokens, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+		sdk.NewAttribute(types.AttributeKeyRefundAddress, msg.RefundAddress),
+	)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
-			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Token.Denom),
-			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
+			eventAttributes...,
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -72,3 +123,112 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateDenomMetadata defines an rpc handler method for MsgUpdateDenomMetadata. Overrides the bank metadata of an IBC voucher denomination.
+func (k Keeper) UpdateDenomMetadata(goCtx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.DenomPathFromHash(ctx, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+// UpdateChannelParams defines an rpc handler method for MsgUpdateChannelParams. Enables or disables transfers over a channel.
+func (k Keeper) UpdateChannelParams(goCtx context.Context, msg *types.MsgUpdateChannelParams) (*types.MsgUpdateChannelParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.ChannelParams.PortId, msg.ChannelParams.ChannelId); !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.ChannelParams.PortId, msg.ChannelParams.ChannelId)
+	}
+
+	k.SetChannelParams(ctx, msg.ChannelParams)
+
+	k.Logger(ctx).Info("updated channel params", "port-id", msg.ChannelParams.PortId, "channel-id", msg.ChannelParams.ChannelId,
+		"denom", msg.ChannelParams.Denom, "send-enabled", msg.ChannelParams.SendEnabled, "receive-enabled", msg.ChannelParams.ReceiveEnabled)
+
+	return &types.MsgUpdateChannelParamsResponse{}, nil
+}
+
+// ReconcileEscrow defines an rpc handler method for MsgReconcileEscrow. Either sets the total escrow of a
+// denomination to the balance of the escrow addresses, or moves the excess escrow balance to a recipient.
+func (k Keeper) ReconcileEscrow(goCtx context.Context, msg *types.MsgReconcileEscrow) (*types.MsgReconcileEscrowResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalEscrow := k.GetTotalEscrowForDenom(ctx, msg.Denom)
+	totalEscrowBalance := k.getTotalEscrowBalance(ctx, msg.Denom)
+	recovered := sdk.NewCoin(msg.Denom, sdkmath.ZeroInt())
+
+	if msg.Recipient == "" {
+		// the accounted total escrow is set to the actual balance of the escrow addresses
+		totalEscrow = sdk.NewCoin(msg.Denom, totalEscrowBalance)
+		k.SetTotalEscrowForDenom(ctx, totalEscrow)
+
+		k.Logger(ctx).Info("reconciled total escrow", "denom", msg.Denom, "total-escrow", totalEscrow.Amount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReconcileEscrow,
+				sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+				sdk.NewAttribute(types.AttributeKeyTotalEscrow, totalEscrow.String()),
+			),
+		)
+
+		return &types.MsgReconcileEscrowResponse{TotalEscrow: totalEscrow, Recovered: recovered}, nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	excess := totalEscrowBalance.Sub(totalEscrow.Amount)
+	if !excess.IsPositive() {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no excess escrow balance for %s: balance %s, expected %s", msg.Denom, totalEscrowBalance, totalEscrow.Amount)
+	}
+
+	// the tokens accounted for in the total escrow are never moved
+	handler := k.getTokenHandler(msg.Denom)
+	escrowAddress := types.GetEscrowAddress(msg.PortId, msg.ChannelId)
+	escrowBalance := handler.GetBalance(ctx, escrowAddress, msg.Denom)
+	recovered = sdk.NewCoin(msg.Denom, sdkmath.MinInt(excess, escrowBalance.Amount))
+	if recovered.IsZero() {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "escrow address %s holds no %s", escrowAddress, msg.Denom)
+	}
+
+	if err := handler.UnescrowTokens(ctx, escrowAddress, recipient, recovered); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("recovered excess escrow", "escrow-address", escrowAddress.String(), "recipient", msg.Recipient, "recovered", recovered.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReconcileEscrow,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyTotalEscrow, totalEscrow.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowAddress, escrowAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyRecovered, recovered.String()),
+		),
+	)
+
+	return &types.MsgReconcileEscrowResponse{TotalEscrow: totalEscrow, Recovered: recovered}, nil
+}
@@ -4,15 +4,21 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 // TestMsgTransfer tests Transfer rpc handler
 func (suite *KeeperTestSuite) TestMsgTransfer() {
-	var msg *types.MsgTransfer
+	var (
+		msg  *types.MsgTransfer
+		coin sdk.Coin
+	)
 
 	testCases := []struct {
 		name     string
@@ -80,6 +86,39 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"success with refund address",
+			func() {
+				msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			true,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = "address"
+			},
+			false,
+		},
+		{
+			"refund address is a blocked address",
+			func() {
+				msg.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			false,
+		},
+		{
+			"multiple coins over ics20-1 channel",
+			func() {
+				atom := sdk.NewCoin("atom", sdkmath.NewInt(100))
+				err := banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(atom))
+				suite.Require().NoError(err)
+
+				msg.Token = sdk.Coin{}
+				msg.Tokens = sdk.NewCoins(coin, atom)
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -91,7 +130,7 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
-			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			coin = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
 			msg = types.NewMsgTransfer(
 				path.EndpointA.ChannelConfig.PortID,
 				path.EndpointA.ChannelID,
@@ -109,11 +148,13 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			events := ctx.EventManager().Events().ToABCIEvents()
 			expEvents := ibctesting.EventsMap{
 				"ibc_transfer": {
-					"sender":   suite.chainA.SenderAccount.GetAddress().String(),
-					"receiver": suite.chainB.SenderAccount.GetAddress().String(),
-					"amount":   coin.Amount.String(),
-					"denom":    coin.Denom,
-					"memo":     "memo",
+					"sender":         suite.chainA.SenderAccount.GetAddress().String(),
+					"receiver":       suite.chainB.SenderAccount.GetAddress().String(),
+					"amount":         coin.Amount.String(),
+					"denom":          coin.Denom,
+					"tokens":         sdk.NewCoins(coin).String(),
+					"memo":           "memo",
+					"refund_address": msg.RefundAddress,
 				},
 			}
 
@@ -122,6 +163,10 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 				suite.Require().NotNil(res)
 				suite.Require().NotEqual(res.Sequence, uint64(0))
 				ibctesting.AssertEventsLegacy(&suite.Suite, expEvents, events)
+
+				refundAddress, found := suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(ctx, msg.SourcePort, msg.SourceChannel, res.Sequence)
+				suite.Require().Equal(msg.RefundAddress != "", found)
+				suite.Require().Equal(msg.RefundAddress, refundAddress)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
@@ -179,3 +224,270 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestUpdateDenomMetadata tests UpdateDenomMetadata rpc handler
+func (suite *KeeperTestSuite) TestUpdateDenomMetadata() {
+	var msg *types.MsgUpdateDenomMetadata
+
+	denomTrace := types.ParseDenomTrace("transfer/channel-0/uatom")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: denomination trace not found",
+			func() {
+				msg.Metadata = newStakeMetadata(types.ParseDenomTrace("transfer/channel-1/uatom").IBCDenom())
+			},
+			types.ErrTraceNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(ctx, denomTrace)
+
+			msg = types.NewMsgUpdateDenomMetadata(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), newStakeMetadata(denomTrace.IBCDenom()))
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateDenomMetadata(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom())
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Metadata, metadata)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestUpdateChannelParams tests UpdateChannelParams rpc handler
+func (suite *KeeperTestSuite) TestUpdateChannelParams() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgUpdateChannelParams
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: disable channel",
+			func() {},
+			nil,
+		},
+		{
+			"success: disable denom over channel",
+			func() {
+				msg.ChannelParams.Denom = sdk.DefaultBondDenom
+			},
+			nil,
+		},
+		{
+			"success: restore default",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), msg.ChannelParams)
+				msg.ChannelParams.SendEnabled = true
+				msg.ChannelParams.ReceiveEnabled = true
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelParams.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			channelParams := types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", false, true)
+			msg = types.NewMsgUpdateChannelParams(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), channelParams)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.UpdateChannelParams(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				channelParams, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelParams(ctx, msg.ChannelParams.PortId, msg.ChannelParams.ChannelId, msg.ChannelParams.Denom)
+				if msg.ChannelParams.IsDefault() {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(msg.ChannelParams, channelParams)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestReconcileEscrow tests ReconcileEscrow rpc handler
+func (suite *KeeperTestSuite) TestReconcileEscrow() {
+	var (
+		path          *ibctesting.Path
+		msg           *types.MsgReconcileEscrow
+		escrowAddress sdk.AccAddress
+		recipient     sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		expTotalEscrow sdkmath.Int
+		expRecovered   sdkmath.Int
+		expError       error
+	}{
+		{
+			"success: total escrow set to the escrow balance",
+			func() {},
+			sdkmath.NewInt(150),
+			sdkmath.ZeroInt(),
+			nil,
+		},
+		{
+			"success: total escrow set to a lower escrow balance",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200)))
+			},
+			sdkmath.NewInt(150),
+			sdkmath.ZeroInt(),
+			nil,
+		},
+		{
+			"success: excess escrow moved to the recipient",
+			func() {
+				msg = types.NewMsgReconcileEscrow(msg.Signer, msg.Denom, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, recipient.String())
+			},
+			sdkmath.NewInt(100),
+			sdkmath.NewInt(50),
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			sdkmath.NewInt(100),
+			sdkmath.ZeroInt(),
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: no excess escrow",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150)))
+				msg = types.NewMsgReconcileEscrow(msg.Signer, msg.Denom, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, recipient.String())
+			},
+			sdkmath.NewInt(150),
+			sdkmath.ZeroInt(),
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg = types.NewMsgReconcileEscrow(msg.Signer, msg.Denom, path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, recipient.String())
+			},
+			sdkmath.NewInt(100),
+			sdkmath.ZeroInt(),
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// escrow 100 tokens with a transfer
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			_, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			// send 50 tokens directly to the escrow address, outside of the transfer module accounting
+			escrowAddress = types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			excess := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+			suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(excess)))
+
+			recipient = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			msg = types.NewMsgReconcileEscrow(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), sdk.DefaultBondDenom, "", "", "")
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			recipientBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ReconcileEscrow(ctx, msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expTotalEscrow, res.TotalEscrow.Amount)
+				suite.Require().Equal(tc.expRecovered, res.Recovered.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom)
+			suite.Require().Equal(tc.expTotalEscrow, totalEscrow.Amount)
+
+			expRecipientBalance := recipientBalance.AddAmount(tc.expRecovered)
+			suite.Require().Equal(expRecipientBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+
+			expEscrowBalance := sdkmath.NewInt(150).Sub(tc.expRecovered)
+			suite.Require().Equal(expEscrowBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).Amount)
+		})
+	}
+}
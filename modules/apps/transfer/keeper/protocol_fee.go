@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// chargeProtocolFees deducts the protocol fees of the provided transfer from the sender
+// and returns them. Fees which may be refunded are held by the transfer module until
+// the packet is acknowledged, otherwise they are sent to the fee collector directly.
+func (k Keeper) chargeProtocolFees(ctx sdk.Context, protocolFee types.ProtocolFee, portID, channelID string, sender sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	fees := protocolFee.GetFees(portID, channelID, coins)
+	if fees.IsZero() {
+		return fees, nil
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(protocolFee.FeeCollector)
+	if feeCollector == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidProtocolFee, "fee collector module account %s does not exist", protocolFee.FeeCollector)
+	}
+
+	recipient := feeCollector
+	if protocolFee.RefundOnFailure {
+		recipient = k.authKeeper.GetModuleAddress(types.ModuleName)
+	}
+
+	for _, fee := range fees {
+		if err := k.getTokenHandler(fee.Denom).EscrowTokens(ctx, sender, recipient, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFee,
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, protocolFee.FeeCollector),
+			sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+		),
+	)
+
+	return fees, nil
+}
+
+// settleProtocolFees sends the protocol fees held for the provided packet, if any, to the
+// fee collector.
+func (k Keeper) settleProtocolFees(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetFee, found := k.GetPacketProtocolFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	feeCollector := k.authKeeper.GetModuleAddress(packetFee.FeeCollector)
+	if feeCollector == nil {
+		return errorsmod.Wrapf(types.ErrInvalidProtocolFee, "fee collector module account %s does not exist", packetFee.FeeCollector)
+	}
+
+	if err := k.sendHeldProtocolFees(ctx, feeCollector, packetFee.Fees); err != nil {
+		return err
+	}
+
+	k.deletePacketProtocolFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return nil
+}
+
+// refundProtocolFees refunds the protocol fees held for the provided packet, if any, to
+// the provided receiver.
+func (k Keeper) refundProtocolFees(ctx sdk.Context, packet channeltypes.Packet, receiver sdk.AccAddress) error {
+	packetFee, found := k.GetPacketProtocolFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	if err := k.sendHeldProtocolFees(ctx, receiver, packetFee.Fees); err != nil {
+		return err
+	}
+
+	k.deletePacketProtocolFee(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProtocolFeeRefund,
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyFees, packetFee.Fees.String()),
+		),
+	)
+
+	return nil
+}
+
+// sendHeldProtocolFees sends the provided fees held by the transfer module to the recipient.
+func (k Keeper) sendHeldProtocolFees(ctx sdk.Context, recipient sdk.AccAddress, fees sdk.Coins) error {
+	moduleAddress := k.authKeeper.GetModuleAddress(types.ModuleName)
+	for _, fee := range fees {
+		if err := k.getTokenHandler(fee.Denom).UnescrowTokens(ctx, moduleAddress, recipient, fee); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPacketProtocolFee gets the refundable protocol fees of the sent packet identified by the
+// provided port ID, channel ID and sequence.
+func (k Keeper) GetPacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketProtocolFee, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketProtocolFeeKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PacketProtocolFee{}, false
+	}
+
+	return k.MustUnmarshalPacketProtocolFee(bz), true
+}
+
+// SetPacketProtocolFee stores the refundable protocol fees of a sent packet, keyed by the
+// identifiers of the packet.
+func (k Keeper) SetPacketProtocolFee(ctx sdk.Context, packetFee types.PacketProtocolFee) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.MustMarshalPacketProtocolFee(packetFee)
+	store.Set(types.PacketProtocolFeeKey(packetFee.PacketId.PortId, packetFee.PacketId.ChannelId, packetFee.PacketId.Sequence), bz)
+}
+
+// deletePacketProtocolFee deletes the refundable protocol fees stored for the sent packet.
+func (k Keeper) deletePacketProtocolFee(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PacketProtocolFeeKey(portID, channelID, sequence))
+}
+
+// GetAllPacketProtocolFees returns the refundable protocol fees of all the sent packets which
+// are awaiting an acknowledgement or timeout.
+func (k Keeper) GetAllPacketProtocolFees(ctx sdk.Context) []types.PacketProtocolFee {
+	var packetFees []types.PacketProtocolFee
+	k.IteratePacketProtocolFees(ctx, func(packetFee types.PacketProtocolFee) bool {
+		packetFees = append(packetFees, packetFee)
+		return false
+	})
+
+	return packetFees
+}
+
+// IteratePacketProtocolFees iterates over the refundable protocol fees in the store and performs
+// a callback function.
+func (k Keeper) IteratePacketProtocolFees(ctx sdk.Context, cb func(packetFee types.PacketProtocolFee) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProtocolFeeKey)
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(k.MustUnmarshalPacketProtocolFee(iterator.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestProtocolFee() {
+	var (
+		path         *ibctesting.Path
+		protocolFee  types.ProtocolFee
+		settlePacket func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expFee      sdkmath.Int
+		expHeld     bool
+		expRefunded bool
+		expError    error
+	}{
+		{
+			"success: fees sent to the fee collector",
+			func() {},
+			sdkmath.NewInt(1),
+			false,
+			false,
+			nil,
+		},
+		{
+			"success: no fees charged",
+			func() {
+				protocolFee.BasisPoints = 0
+			},
+			sdkmath.ZeroInt(),
+			false,
+			false,
+			nil,
+		},
+		{
+			"success: channel rate applies",
+			func() {
+				protocolFee.Rates = []types.ProtocolFeeRate{types.NewProtocolFeeRate(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", 500)}
+			},
+			sdkmath.NewInt(5),
+			false,
+			false,
+			nil,
+		},
+		{
+			"success: fees held and sent to the fee collector on acknowledgement",
+			func() {
+				protocolFee.RefundOnFailure = true
+			},
+			sdkmath.NewInt(1),
+			true,
+			false,
+			nil,
+		},
+		{
+			"success: fees held and refunded on timeout",
+			func() {
+				protocolFee.RefundOnFailure = true
+				settlePacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+					return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+				}
+			},
+			sdkmath.NewInt(1),
+			true,
+			true,
+			nil,
+		},
+		{
+			"success: fees held and refunded on error acknowledgement",
+			func() {
+				protocolFee.RefundOnFailure = true
+				settlePacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+					ack := channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled)
+					return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+				}
+			},
+			sdkmath.NewInt(1),
+			true,
+			true,
+			nil,
+		},
+		{
+			"success: fees not refunded if refunds are disabled",
+			func() {
+				settlePacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+					return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+				}
+			},
+			sdkmath.NewInt(1),
+			false,
+			false,
+			nil,
+		},
+		{
+			"failure: fee collector module account does not exist",
+			func() {
+				protocolFee.FeeCollector = "unknown"
+			},
+			sdkmath.ZeroInt(),
+			false,
+			false,
+			types.ErrInvalidProtocolFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			protocolFee = types.NewProtocolFee(100, govtypes.ModuleName, false)
+			settlePacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+			}
+
+			tc.malleate()
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.ProtocolFee = protocolFee
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			feeCollector := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+			moduleAddress := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			collectorBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeCollector, sdk.DefaultBondDenom)
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+
+			res, err := suite.chainA.SendMsgs(msg)
+			if tc.expError != nil {
+				// the error returned by the tx result only contains the error message
+				suite.Require().ErrorContains(err, tc.expError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			data, err := types.UnmarshalPacketData(packet.GetData(), types.V1)
+			suite.Require().NoError(err)
+
+			fee := sdk.NewCoin(sdk.DefaultBondDenom, tc.expFee)
+			suite.Require().Equal(coin.Amount.Sub(fee.Amount).String(), data.Tokens[0].Amount, "fees must be deducted from the transferred amount")
+
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+			suite.Require().Equal(coin.Amount.Sub(fee.Amount), totalEscrow.Amount)
+
+			packetFee, found := suite.chainA.GetSimApp().TransferKeeper.GetPacketProtocolFee(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().Equal(tc.expHeld, found)
+			if tc.expHeld {
+				suite.Require().Equal(sdk.NewCoins(fee), packetFee.Fees)
+				suite.Require().Equal(fee, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), moduleAddress, sdk.DefaultBondDenom))
+			}
+
+			err = settlePacket(packet, data)
+			suite.Require().NoError(err)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetPacketProtocolFee(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), moduleAddress, sdk.DefaultBondDenom).IsZero())
+
+			expCollectorBalance := collectorBalance.AddAmount(tc.expFee)
+			if tc.expRefunded {
+				expCollectorBalance = collectorBalance
+			}
+			suite.Require().Equal(expCollectorBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeCollector, sdk.DefaultBondDenom))
+
+			if tc.expRefunded {
+				suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetEscrowBalances returns the balances held by the escrow addresses of all transfer channels.
+// Escrow addresses holding no tokens are omitted.
+func (k Keeper) GetEscrowBalances(ctx sdk.Context) []types.EscrowBalance {
+	totalEscrowed := k.GetAllTotalEscrowed(ctx)
+
+	var escrowBalances []types.EscrowBalance
+
+	portID := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		// channels are selected by port prefix, which also matches ports starting with the transfer port
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		balances := k.getEscrowAddressBalances(ctx, escrowAddress, totalEscrowed)
+		if balances.Empty() {
+			continue
+		}
+
+		escrowBalances = append(escrowBalances, types.EscrowBalance{
+			PortId:        channel.PortId,
+			ChannelId:     channel.ChannelId,
+			EscrowAddress: escrowAddress.String(),
+			Balances:      balances,
+		})
+	}
+
+	return escrowBalances
+}
+
+// GetEscrowDiscrepancies returns the denominations for which the total balance of the provided
+// escrow balances differs from the total amount expected in escrow, sorted by denomination.
+func (k Keeper) GetEscrowDiscrepancies(ctx sdk.Context, escrowBalances []types.EscrowBalance) []types.EscrowDiscrepancy {
+	var actualTotalEscrowed sdk.Coins
+	for _, escrowBalance := range escrowBalances {
+		actualTotalEscrowed = actualTotalEscrowed.Add(escrowBalance.Balances...)
+	}
+
+	expectedTotalEscrowed := k.GetAllTotalEscrowed(ctx)
+
+	denoms := make(map[string]struct{})
+	for _, coin := range actualTotalEscrowed {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, coin := range expectedTotalEscrowed {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	var discrepancies []types.EscrowDiscrepancy
+	for denom := range denoms {
+		expected := expectedTotalEscrowed.AmountOf(denom)
+		actual := actualTotalEscrowed.AmountOf(denom)
+		if !expected.Equal(actual) {
+			discrepancies = append(discrepancies, types.EscrowDiscrepancy{
+				Denom:    denom,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+
+	sort.Slice(discrepancies, func(i, j int) bool {
+		return discrepancies[i].Denom < discrepancies[j].Denom
+	})
+
+	return discrepancies
+}
+
+// getTotalEscrowBalance returns the total balance of the denomination held by the escrow
+// addresses of all transfer channels.
+func (k Keeper) getTotalEscrowBalance(ctx sdk.Context, denom string) sdkmath.Int {
+	total := sdkmath.ZeroInt()
+	handler := k.getTokenHandler(denom)
+
+	portID := k.GetPort(ctx)
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		total = total.Add(handler.GetBalance(ctx, escrowAddress, denom).Amount)
+	}
+
+	return total
+}
+
+// getEscrowAddressBalances returns the bank balances of the escrow address, together with its
+// balances of the denominations expected in escrow which are not held in the bank module.
+func (k Keeper) getEscrowAddressBalances(ctx sdk.Context, escrowAddress sdk.AccAddress, totalEscrowed sdk.Coins) sdk.Coins {
+	balances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+	for _, coin := range totalEscrowed {
+		if balances.AmountOf(coin.Denom).IsPositive() {
+			continue
+		}
+
+		// the balances of denominations with a registered token handler may be held outside of the bank module
+		balances = balances.Add(k.getTokenHandler(coin.Denom).GetBalance(ctx, escrowAddress, coin.Denom))
+	}
+
+	return balances
+}
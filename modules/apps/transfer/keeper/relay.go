@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"fmt"
 	"strings"
 
 	metrics "github.com/hashicorp/go-metrics"
@@ -20,7 +19,9 @@ import (
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
-// sendTransfer handles transfer sending logic. There are 2 possible cases:
+// sendTransfer handles transfer sending logic. Every coin provided is handled
+// independently and all coins are then sent within a single packet. For each
+// coin there are 2 possible cases:
 //
 // 1. Sender chain is acting as the source zone. The coins are transferred
 // to an escrow address (i.e locked) on the sender chain and then transferred
@@ -56,18 +57,34 @@ func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
 	sourceChannel string,
-	token sdk.Coin,
+	coins sdk.Coins,
 	sender sdk.AccAddress,
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
+	forwarding *types.Forwarding,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	if !found {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "application version not found for source port: %s and source channel: %s", sourcePort, sourceChannel)
+	}
+
+	if appVersion == types.V1 && len(coins) != 1 {
+		// a ics20-1 packet can only carry a single denomination
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot transfer multiple coins over a channel with version %s", types.V1)
+	}
+
+	if appVersion == types.V1 && len(forwarding.GetHops()) > 0 {
+		// forwarding information can only be carried by ics20-2 packets
+		return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "cannot forward coins over a channel with version %s", types.V1)
+	}
+
 	destinationPort := channel.Counterparty.PortId
 	destinationChannel := channel.Counterparty.ChannelId
 
@@ -78,92 +95,116 @@ func (k Keeper) sendTransfer(
 		return 0, errorsmod.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
 	}
 
-	// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
-	fullDenomPath := token.Denom
+	// the bank metadata of tokens can only be carried by ics20-2 packets
+	sendDenomMetadata := appVersion == types.V2 && k.GetParams(ctx).SendDenomMetadata
 
-	var err error
+	tokens := make([]types.Token, 0, len(coins))
+	tokenLabels := make([][]metrics.Label, 0, len(coins))
 
-	// deconstruct the token denomination into the denomination trace info
-	// to determine if the sender is the source chain
-	if strings.HasPrefix(token.Denom, "ibc/") {
-		fullDenomPath, err = k.DenomPathFromHash(ctx, token.Denom)
-		if err != nil {
-			return 0, err
+	for _, coin := range coins {
+		if !k.IsChannelSendEnabled(ctx, sourcePort, sourceChannel, coin.Denom) {
+			return 0, errorsmod.Wrapf(types.ErrSendDisabled, "%s transfers over port %s and channel %s are currently disabled", coin.Denom, sourcePort, sourceChannel)
 		}
-	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
-		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
-	}
-
-	// NOTE: SendTransfer simply sends the denomination as it exists on its own
-	// chain inside the packet data. The receiving chain will perform denom
-	// prefixing as necessary.
+		// NOTE: denomination and hex hash correctness checked during msg.ValidateBasic
+		fullDenomPath := coin.Denom
 
-	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+		var err error
 
-		// obtain the escrow address for the source channel end
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
-			return 0, err
+		// deconstruct the token denomination into the denomination trace info
+		// to determine if the sender is the source chain
+		if strings.HasPrefix(coin.Denom, "ibc/") {
+			fullDenomPath, err = k.DenomPathFromHash(ctx, coin.Denom)
+			if err != nil {
+				return 0, err
+			}
 		}
 
-	} else {
-		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+		token := types.Token{
+			Denom:  fullDenomPath,
+			Amount: coin.Amount.String(),
+		}
 
-		// transfer the coins to the module account and burn them
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(
-			ctx, sender, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			return 0, err
+		labels := []metrics.Label{
+			telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
+			telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
 		}
 
-		if err := k.bankKeeper.BurnCoins(
-			ctx, types.ModuleName, sdk.NewCoins(token),
-		); err != nil {
-			// NOTE: should not happen as the module account was
-			// retrieved on the step above and it has enough balance
-			// to burn.
-			panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+		// NOTE: SendTransfer simply sends the denomination as it exists on its own
+		// chain inside the packet data. The receiving chain will perform denom
+		// prefixing as necessary.
+
+		if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+			labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
+
+			// obtain the escrow address for the source channel end
+			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+			if err := k.escrowToken(ctx, sender, escrowAddress, coin); err != nil {
+				return 0, err
+			}
+
+			// the metadata is only used by the receiving chain if it mints vouchers
+			if sendDenomMetadata {
+				token.Metadata = k.getSourceDenomMetadata(ctx, coin.Denom)
+			}
+
+		} else {
+			labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "false"))
+
+			// burn the vouchers held by the sender
+			if err := k.getTokenHandler(coin.Denom).BurnTokens(ctx, sender, coin); err != nil {
+				return 0, err
+			}
 		}
+
+		tokens = append(tokens, token)
+
+		tokenLabels = append(tokenLabels, labels)
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
-	)
+	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, forwarding)
+	if err != nil {
+		return 0, err
+	}
 
-	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetDataBytes)
 	if err != nil {
 		return 0, err
 	}
 
 	defer func() {
-		if token.Amount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"tx", "msg", "ibc", "transfer"},
-				float32(token.Amount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, fullDenomPath)},
+		for i, coin := range coins {
+			if coin.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "ibc", "transfer"},
+					float32(coin.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, tokens[i].Denom)},
+				)
+			}
+
+			telemetry.IncrCounterWithLabels(
+				[]string{"ibc", types.ModuleName, "send"},
+				1,
+				tokenLabels[i],
 			)
 		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "send"},
-			1,
-			labels,
-		)
 	}()
 
 	return sequence, nil
 }
 
-// OnRecvPacket processes a cross chain fungible token transfer. If the
-// sender chain is the source of minted tokens then vouchers will be minted
-// and sent to the receiving address. Otherwise if the sender chain is sending
-// back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// OnRecvPacket processes a cross chain fungible token transfer. For each token
+// in the packet: if the sender chain is the source of minted tokens then vouchers
+// will be minted and sent to the receiving address. Otherwise if the sender chain
+// is sending back tokens this chain originally transferred to it, the tokens are
+// unescrowed and sent to the receiving address. If the processing of any token
+// fails, none of the tokens are received.
+//
+// If the packet data contains forwarding hops, the tokens are received by the
+// forward address of the destination channel and sent to the next hop. The
+// acknowledgement of the packet is then written asynchronously once the packet
+// sent to the next hop is acknowledged or timed out.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
@@ -173,63 +214,131 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
-	}
+	var (
+		receiver      sdk.AccAddress
+		receivedCoins sdk.Coins
+		err           error
+	)
 
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+	if data.HasForwarding() {
+		// the receiver address is only decoded by the final destination chain
+		receiver = types.GetForwardAddress(packet.GetDestPort(), packet.GetDestChannel())
+	} else {
+		// decode the receiver address
+		receiver, err = sdk.AccAddressFromBech32(data.Receiver)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		}
 	}
 
-	labels := []metrics.Label{
-		telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
-		telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
-	}
+	for _, token := range data.Tokens {
+		// parse the transfer amount
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", token.Amount)
+		}
 
-	// This is the prefix that would have been prefixed to the denomination
-	// on sender chain IF and only if the token originally came from the
-	// receiving chain.
-	//
-	// NOTE: We use SourcePort and SourceChannel here, because the counterparty
-	// chain would have prefixed with DestPort and DestChannel when originally
-	// receiving this coin as seen in the "sender chain is the source" condition.
-	if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		// sender chain is not the source, unescrow tokens
-
-		// remove prefix added by sender chain
-		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
-
-		// coin denomination used in sending from the escrow address
-		denom := unprefixedDenom
-
-		// The denomination used to send the coins is either the native denom or the hash of the path
-		// if the denomination is not native.
-		denomTrace := types.ParseDenomTrace(unprefixedDenom)
-		if !denomTrace.IsNativeDenom() {
-			denom = denomTrace.IBCDenom()
+		labels := []metrics.Label{
+			telemetry.NewLabel(coretypes.LabelSourcePort, packet.GetSourcePort()),
+			telemetry.NewLabel(coretypes.LabelSourceChannel, packet.GetSourceChannel()),
 		}
-		token := sdk.NewCoin(denom, transferAmount)
 
-		if k.bankKeeper.BlockedAddr(receiver) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+		// This is the prefix that would have been prefixed to the denomination
+		// on sender chain IF and only if the token originally came from the
+		// receiving chain.
+		//
+		// NOTE: We use SourcePort and SourceChannel here, because the counterparty
+		// chain would have prefixed with DestPort and DestChannel when originally
+		// receiving this coin as seen in the "sender chain is the source" condition.
+		if types.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			// sender chain is not the source, unescrow tokens
+
+			// remove prefix added by sender chain
+			voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+			unprefixedDenom := token.Denom[len(voucherPrefix):]
+
+			// The denomination used to send the coins from the escrow address is either the native
+			// denom or the hash of the path if the denomination is not native.
+			denom := k.denomFromPath(ctx, unprefixedDenom).IBCDenom()
+			coin := sdk.NewCoin(denom, transferAmount)
+
+			if !k.IsChannelReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel(), denom) {
+				return errorsmod.Wrapf(types.ErrReceiveDisabled, "%s transfers over port %s and channel %s are currently disabled", denom, packet.GetDestPort(), packet.GetDestChannel())
+			}
+
+			if k.bankKeeper.BlockedAddr(receiver) {
+				return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+			}
+
+			escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+			if err := k.unescrowToken(ctx, escrowAddress, receiver, coin); err != nil {
+				return err
+			}
+
+			receivedCoins = receivedCoins.Add(coin)
+
+			defer func() {
+				if transferAmount.IsInt64() {
+					telemetry.SetGaugeWithLabels(
+						[]string{"ibc", types.ModuleName, "packet", "receive"},
+						float32(transferAmount.Int64()),
+						[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, unprefixedDenom)},
+					)
+				}
+
+				telemetry.IncrCounterWithLabels(
+					[]string{"ibc", types.ModuleName, "receive"},
+					1,
+					append(
+						labels, telemetry.NewLabel(coretypes.LabelSource, "true"),
+					),
+				)
+			}()
+
+			continue
 		}
 
-		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
+		// sender chain is the source, mint vouchers
+
+		// since SendPacket did not prefix the denomination, we must prefix the trace of the
+		// denomination with the destination port and channel here
+		denom := k.receivedDenom(ctx, packet.GetDestPort(), packet.GetDestChannel(), token.Denom)
+
+		if !k.IsChannelReceiveEnabled(ctx, packet.GetDestPort(), packet.GetDestChannel(), denom.IBCDenom()) {
+			return errorsmod.Wrapf(types.ErrReceiveDisabled, "%s transfers over port %s and channel %s are currently disabled", denom.IBCDenom(), packet.GetDestPort(), packet.GetDestChannel())
+		}
+
+		traceHash := denom.Hash()
+		if !k.HasDenom(ctx, traceHash) {
+			k.SetDenom(ctx, denom)
+		}
+
+		voucherDenom := denom.IBCDenom()
+		k.setVoucherDenomMetadata(ctx, denom, token.Metadata)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDenomTrace,
+				sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
+				sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
+			),
+		)
+		voucher := sdk.NewCoin(voucherDenom, transferAmount)
+
+		// mint new tokens to the receiver if the source of the transfer is the same chain
+		if err := k.getTokenHandler(voucherDenom).MintTokens(ctx, receiver, voucher); err != nil {
 			return err
 		}
 
+		receivedCoins = receivedCoins.Add(voucher)
+
+		receivedDenom := token.Denom
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
 					[]string{"ibc", types.ModuleName, "packet", "receive"},
 					float32(transferAmount.Int64()),
-					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, unprefixedDenom)},
+					[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, receivedDenom)},
 				)
 			}
 
@@ -237,73 +346,16 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 				[]string{"ibc", types.ModuleName, "receive"},
 				1,
 				append(
-					labels, telemetry.NewLabel(coretypes.LabelSource, "true"),
+					labels, telemetry.NewLabel(coretypes.LabelSource, "false"),
 				),
 			)
 		}()
-
-		return nil
 	}
 
-	// sender chain is the source, mint vouchers
-
-	// since SendPacket did not prefix the denomination, we must prefix denomination here
-	prefixedDenom := types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
-
-	// construct the denomination trace from the full raw denomination
-	denomTrace := types.ParseDenomTrace(prefixedDenom)
-
-	traceHash := denomTrace.Hash()
-	if !k.HasDenomTrace(ctx, traceHash) {
-		k.SetDenomTrace(ctx, denomTrace)
+	if data.HasForwarding() {
+		return k.forwardPacket(ctx, packet, data, receivedCoins)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
-	if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
-		k.setDenomMetadata(ctx, denomTrace)
-	}
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeDenomTrace,
-			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
-		),
-	)
-	voucher := sdk.NewCoin(voucherDenom, transferAmount)
-
-	// mint new tokens if the source of the transfer is the same chain
-	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(voucher),
-	); err != nil {
-		return errorsmod.Wrap(err, "failed to mint IBC tokens")
-	}
-
-	// send to receiver
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
-	}
-
-	defer func() {
-		if transferAmount.IsInt64() {
-			telemetry.SetGaugeWithLabels(
-				[]string{"ibc", types.ModuleName, "packet", "receive"},
-				float32(transferAmount.Int64()),
-				[]metrics.Label{telemetry.NewLabel(coretypes.LabelDenom, data.Denom)},
-			)
-		}
-
-		telemetry.IncrCounterWithLabels(
-			[]string{"ibc", types.ModuleName, "receive"},
-			1,
-			append(
-				labels, telemetry.NewLabel(coretypes.LabelSource, "false"),
-			),
-		)
-	}()
-
 	return nil
 }
 
@@ -311,64 +363,117 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 // acknowledgement written on the receiving chain. If the acknowledgement
 // was a success then nothing occurs. If the acknowledgement failed, then
 // the sender is refunded their tokens using the refundPacketToken function.
-func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+//
+// If the packet was sent to forward a previously received packet, the
+// acknowledgement of the received packet is written accordingly.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
-		// the acknowledgement succeeded on the receiving chain so nothing
-		// needs to be executed and no error needs to be returned
-		return nil
+		// the acknowledgement succeeded on the receiving chain so no tokens
+		// need to be refunded
+		k.deleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+		if err := k.settleProtocolFees(ctx, packet); err != nil {
+			return err
+		}
+
+		prevPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if !found {
+			return nil
+		}
+
+		return k.acknowledgeForwardedPacket(ctx, prevPacket, packet)
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+
+		prevPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		if !found {
+			return nil
+		}
+
+		return k.revertForwardedPacket(ctx, prevPacket, packet, types.ErrForwardedPacketFailed)
 	default:
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
 	}
 }
 
 // OnTimeoutPacket refunds the sender since the original packet sent was
-// never received and has been timed out.
-func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+// never received and has been timed out. If the packet was sent to forward
+// a previously received packet, an error acknowledgement is written for the
+// received packet.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	prevPacket, found := k.GetForwardedPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	return k.revertForwardedPacket(ctx, prevPacket, packet, types.ErrForwardedPacketTimedOut)
+}
+
+// GetRefundReceiver returns the address credited with the refunded tokens of the
+// provided sent packet: the refund address set in the MsgTransfer if any, otherwise
+// the packet sender.
+func (k Keeper) GetRefundReceiver(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) string {
+	if refundAddress, found := k.GetRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()); found {
+		return refundAddress
+	}
+
+	return data.Sender
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
-func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// the sending address. All tokens contained in the packet are refunded.
+// If a refund address was set for the packet, the tokens are sent to it
+// instead of the sender.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
-	// parse the transfer amount
-	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
-	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
-	}
-	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
-
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	// decode the refund receiver address
+	sender, err := sdk.AccAddressFromBech32(k.GetRefundReceiver(ctx, packet, data))
 	if err != nil {
 		return err
 	}
 
-	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
+	for _, token := range data.Tokens {
+		// parse the denomination from the full denom path
+		denom := k.denomFromPath(ctx, token.Denom)
+
+		// parse the transfer amount
+		transferAmount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", token.Amount)
+		}
+		coin := sdk.NewCoin(denom.IBCDenom(), transferAmount)
+
+		if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom) {
+			// unescrow tokens back to sender
+			escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+			if err := k.unescrowToken(ctx, escrowAddress, sender, coin); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		// mint vouchers back to sender
+		if err := k.getTokenHandler(coin.Denom).MintTokens(ctx, sender, coin); err != nil {
+			return err
+		}
 	}
 
-	// mint vouchers back to sender
-	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(token),
-	); err != nil {
+	if err := k.refundProtocolFees(ctx, packet, sender); err != nil {
 		return err
 	}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(token)); err != nil {
-		panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
-	}
+	k.deleteRefundAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	return nil
 }
@@ -376,7 +481,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 // escrowToken will send the given token from the provided sender to the escrow address. It will also
 // update the total escrowed amount by adding the escrowed token to the current total escrow.
 func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
-	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token)); err != nil {
+	if err := k.getTokenHandler(token.GetDenom()).EscrowTokens(ctx, sender, escrowAddress, token); err != nil {
 		// failure is expected for insufficient balances
 		return err
 	}
@@ -392,7 +497,7 @@ func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddres
 // unescrowToken will send the given token from the escrow address to the provided receiver. It will also
 // update the total escrow by deducting the unescrowed token from the current total escrow.
 func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
-	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+	if err := k.getTokenHandler(token.GetDenom()).UnescrowTokens(ctx, escrowAddress, receiver, token); err != nil {
 		// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 		// counterparty module. The bug may occur in bank or any part of the code that allows
 		// the escrow address to be drained. A malicious counterparty module could drain the
@@ -419,11 +524,62 @@ func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error)
 		return "", errorsmod.Wrap(types.ErrInvalidDenomForTransfer, err.Error())
 	}
 
-	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	ibcDenom, found := k.GetDenom(ctx, hash)
 	if !found {
 		return "", errorsmod.Wrap(types.ErrTraceNotFound, hexHash)
 	}
 
-	fullDenomPath := denomTrace.GetFullDenomPath()
-	return fullDenomPath, nil
+	return ibcDenom.Path(), nil
+}
+
+// denomFromPath returns the denomination with the provided full denomination path. The denomination
+// stored under the hash of the path is returned if it exists, since it records the trace of the voucher
+// as it was received. Otherwise, the trace is parsed from the path, which splits a base denomination
+// containing a valid channel identifier into hops.
+func (k Keeper) denomFromPath(ctx sdk.Context, fullPath string) types.Denom {
+	if denom, found := k.GetDenom(ctx, types.NewDenom(fullPath).Hash()); found {
+		return denom
+	}
+
+	return types.ExtractDenomFromPath(fullPath)
+}
+
+// receivedDenom returns the denomination of a token, described by its full denomination path on the
+// sending chain, once it has been received over the provided destination port and channel. The
+// denomination stored for a previous receipt of the token is returned if it exists.
+func (k Keeper) receivedDenom(ctx sdk.Context, destPort, destChannel, fullPath string) types.Denom {
+	denom := types.ReceivedDenom(destPort, destChannel, fullPath)
+	if storedDenom, found := k.GetDenom(ctx, denom.Hash()); found {
+		return storedDenom
+	}
+
+	return denom
+}
+
+// createPacketDataBytesFromVersion creates the packet data bytes to be sent based on the application version.
+// If forwarding hops are provided, the memo is carried as the destination memo of the forwarding information.
+func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens []types.Token, forwarding *types.Forwarding) ([]byte, error) {
+	hops := forwarding.GetHops()
+
+	switch appVersion {
+	case types.V1:
+		// sanity check: tokens must always be of length 1 and no hops must be set if using app version V1
+		if len(tokens) != 1 || len(hops) != 0 {
+			return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "expected a single token and no forwarding hops for version %s", types.V1)
+		}
+
+		token := tokens[0]
+		return types.NewFungibleTokenPacketData(token.Denom, token.Amount, sender, receiver, memo).GetBytes(), nil
+	case types.V2:
+		packetData := types.NewFungibleTokenPacketDataV2(tokens, sender, receiver, memo)
+		if len(hops) > 0 {
+			packetData.Memo = ""
+			packetData.Forwarding = types.NewForwardingPacketData(memo, hops...)
+			packetData.Forwarding.RelativeTimeout = forwarding.RelativeTimeout
+		}
+
+		return packetData.GetBytes(), nil
+	default:
+		return nil, errorsmod.Wrapf(types.ErrInvalidVersion, "app version must be one of %s", types.SupportedVersions)
+	}
 }
@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -101,6 +104,36 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.chainA.GetSimApp().ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), capability) //nolint:errcheck // ignore error for testing
 			}, false,
 		},
+		{
+			"successful transfer with receiving disabled over channel",
+			func() {
+				channelParams := types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", true, false)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), channelParams)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true,
+		},
+		{
+			"successful transfer with sending of another denom disabled over channel",
+			func() {
+				channelParams := types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "uatom", false, true)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), channelParams)
+				expEscrowAmount = sdkmath.NewInt(100)
+			}, true,
+		},
+		{
+			"transfer failed - sending disabled over channel",
+			func() {
+				channelParams := types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", false, true)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), channelParams)
+			}, false,
+		},
+		{
+			"transfer failed - sending of denom disabled over channel",
+			func() {
+				channelParams := types.NewChannelParams(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom, false, true)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelParams(suite.chainA.GetContext(), channelParams)
+			}, false,
+		},
 		{
 			"SendPacket fails, timeout height and timeout timestamp are zero",
 			func() {
@@ -403,7 +436,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, memo)
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			// check total amount in escrow of received token denom on receiving chain
 			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
@@ -427,6 +460,173 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketChannelParams tests that tokens are only received over channels on which
+// the channel parameters enable receiving.
+func (suite *KeeperTestSuite) TestOnRecvPacketChannelParams() {
+	testCases := []struct {
+		name          string
+		channelParams func(path *ibctesting.Path) types.ChannelParams
+		recvIsSource  bool
+		expError      error
+	}{
+		{
+			"success: sending disabled over channel",
+			func(path *ibctesting.Path) types.ChannelParams {
+				return types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "", false, true)
+			},
+			false,
+			nil,
+		},
+		{
+			"success: receiving of another denom disabled over channel",
+			func(path *ibctesting.Path) types.ChannelParams {
+				return types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "uatom", true, false)
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: receiving disabled over channel",
+			func(path *ibctesting.Path) types.ChannelParams {
+				return types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, "", true, false)
+			},
+			false,
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receiving of voucher denom disabled over channel",
+			func(path *ibctesting.Path) types.ChannelParams {
+				voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+				return types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucherDenom, true, false)
+			},
+			false,
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: receiving of unescrowed denom disabled over channel",
+			func(path *ibctesting.Path) types.ChannelParams {
+				return types.NewChannelParams(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, true, false)
+			},
+			true,
+			types.ErrReceiveDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom := sdk.DefaultBondDenom
+			if tc.recvIsSource {
+				// send tokens from chain B to chain A, so that vouchers can be sent back to chain B
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+				msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+				res, err := suite.chainB.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+				suite.Require().NoError(err)
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				denom = types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			}
+
+			suite.chainB.GetSimApp().TransferKeeper.SetChannelParams(suite.chainB.GetContext(), tc.channelParams(path))
+
+			data := types.NewFungibleTokenPacketData(denom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestOnRecvPacketStoresDenom tests that the structured denomination of received vouchers is stored,
+// including for base denominations which cannot be parsed unambiguously from the full denomination path.
+func (suite *KeeperTestSuite) TestOnRecvPacketStoresDenom() {
+	testCases := []struct {
+		name      string
+		denom     string
+		baseDenom string
+		trace     []types.Hop
+	}{
+		{"native denom", "uatom", "uatom", nil},
+		{"base denom with slashes", "gamm/pool/1", "gamm/pool/1", nil},
+		{"base denom with channel identifier", "gamm/channel-7", "gamm/channel-7", nil},
+		{"denom with trace", "transfer/channel-7/uatom", "uatom", []types.Hop{types.NewHop("transfer", "channel-7")}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			receiver := suite.chainB.SenderAccount.GetAddress()
+			data := types.NewFungibleTokenPacketData(tc.denom, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
+			suite.Require().NoError(err)
+
+			trace := append([]types.Hop{types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)}, tc.trace...)
+			expDenom := types.NewDenom(tc.baseDenom, trace...)
+
+			denom, found := suite.chainB.GetSimApp().TransferKeeper.GetDenom(suite.chainB.GetContext(), expDenom.Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(expDenom, denom)
+
+			// the IBC denomination is the hash of the full denomination path
+			hash := sha256.Sum256([]byte(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, tc.denom)))
+			expIBCDenom := fmt.Sprintf("%s/%s", types.DenomPrefix, cmtbytes.HexBytes(hash[:]).String())
+			suite.Require().Equal(expIBCDenom, denom.IBCDenom())
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, expIBCDenom)
+			suite.Require().Equal(sdkmath.NewInt(100), balance.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketUsesStoredDenom() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// the base denomination contains a valid channel identifier, which cannot be told apart from a hop
+	// when parsing the path, so the denomination stored when the voucher was first received is used
+	storedDenom := types.NewDenom("gamm/channel-7/uatom", types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	suite.Require().NotEqual(storedDenom, types.ReceivedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, storedDenom.Base))
+	suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), storedDenom)
+
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	data := types.NewFungibleTokenPacketData(storedDenom.Base, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
+	suite.Require().NoError(err)
+
+	metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), storedDenom.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(storedDenom.Base, metadata.DenomUnits[0].Denom)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, storedDenom.IBCDenom())
+	suite.Require().Equal(sdkmath.NewInt(100), balance.Amount)
+}
+
 func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCToken() {
 	/*
 		Given the following flow of tokens:
@@ -512,7 +712,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
 	// execute onRecvPacket, when chaninB receives the source token the escrow amount should decrease
-	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on receiving chain
@@ -602,7 +802,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data), tc.ack)
 
 			// check total amount in escrow of sent token denom on sending chain
 			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom())
@@ -703,7 +903,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, data, ack)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data), ack)
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
@@ -803,7 +1003,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, types.PacketDataV1ToV2(data))
 
 			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
@@ -897,10 +1097,259 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
-	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, data)
+	err := suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data))
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on sending chain
 	totalEscrowChainB = suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
+
+// TestDenomMetadataPropagation tests that the bank metadata of tokens sent over an ics20-2 channel
+// is stored for the vouchers minted on the receiving chain.
+func (suite *KeeperTestSuite) TestRefundAddress() {
+	var (
+		path          *ibctesting.Path
+		refundAddress sdk.AccAddress
+		refundPacket  func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expRefunded bool
+	}{
+		{
+			"success: refund address credited on timeout",
+			func() {},
+			true,
+		},
+		{
+			"success: refund address credited on error acknowledgement",
+			func() {
+				refundPacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+					ack := channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled)
+					return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+				}
+			},
+			true,
+		},
+		{
+			"success: refund address deleted on successful acknowledgement",
+			func() {
+				refundPacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+					ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+					return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, ack)
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			refundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundPacket = func(packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			}
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			msg.RefundAddress = refundAddress.String()
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			storedAddress, found := suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(refundAddress.String(), storedAddress)
+
+			senderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+			refundBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom)
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, coin.Amount.String(), sender.String(), msg.Receiver, "")
+			err = refundPacket(packet, types.PacketDataV1ToV2(data))
+			suite.Require().NoError(err)
+
+			_, found = suite.chainA.GetSimApp().TransferKeeper.GetRefundAddress(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+
+			expRefundBalance := refundBalance
+			if tc.expRefunded {
+				expRefundBalance = refundBalance.Add(coin)
+			}
+
+			suite.Require().Equal(senderBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom))
+			suite.Require().Equal(expRefundBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddress, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDenomMetadataPropagation() {
+	var (
+		path           *ibctesting.Path
+		sourceMetadata banktypes.Metadata
+		expMetadata    func(denomTrace types.DenomTrace) banktypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: metadata of the source chain is stored for the voucher",
+			func() {},
+		},
+		{
+			"success: metadata derived from the denomination trace is replaced",
+			func() {
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainB.GetContext(), denomTrace)
+				suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), newDefaultDenomMetadata(denomTrace))
+			},
+		},
+		{
+			"success: metadata set through governance is preserved",
+			func() {
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+				govMetadata := newStakeMetadata(denomTrace.IBCDenom())
+				govMetadata.Symbol = "GOV"
+
+				suite.chainB.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainB.GetContext(), denomTrace)
+				_, err := suite.chainB.GetSimApp().TransferKeeper.UpdateDenomMetadata(suite.chainB.GetContext(), types.NewMsgUpdateDenomMetadata(suite.chainB.GetSimApp().TransferKeeper.GetAuthority(), govMetadata))
+				suite.Require().NoError(err)
+
+				expMetadata = func(types.DenomTrace) banktypes.Metadata { return govMetadata }
+			},
+		},
+		{
+			"success: metadata is not sent if disabled",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.SendDenomMetadata = false
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expMetadata = newDefaultDenomMetadata
+			},
+		},
+		{
+			"success: metadata is not sent over ics20-1 channels",
+			func() {
+				path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expMetadata = newDefaultDenomMetadata
+			},
+		},
+		{
+			"success: invalid metadata is not sent",
+			func() {
+				sourceMetadata.Name = ""
+				suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), sourceMetadata)
+
+				expMetadata = newDefaultDenomMetadata
+			},
+		},
+		{
+			"success: metadata is not sent if it does not exist",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(banktypes.StoreKey))
+				store.Delete(append(banktypes.DenomMetadataPrefix, []byte(sdk.DefaultBondDenom)...))
+
+				expMetadata = newDefaultDenomMetadata
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.V2
+			path.EndpointB.ChannelConfig.Version = types.V2
+			path.Setup()
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.SendDenomMetadata = true
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			sourceMetadata = newStakeMetadata(sdk.DefaultBondDenom)
+			suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), sourceMetadata)
+
+			expMetadata = func(denomTrace types.DenomTrace) banktypes.Metadata {
+				return newStakeMetadata(denomTrace.IBCDenom())
+			}
+
+			tc.malleate()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTrace.IBCDenom())
+			suite.Require().True(found)
+			suite.Require().Equal(expMetadata(denomTrace), metadata)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomTrace.IBCDenom())
+			suite.Require().Equal(coin.Amount, balance.Amount)
+		})
+	}
+}
+
+// newStakeMetadata returns valid bank metadata for the staking token with the provided base denomination.
+func newStakeMetadata(base string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "The native staking token of the test chains.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0, Aliases: []string{"ustake"}},
+			{Denom: "STAKE", Exponent: 6},
+		},
+		Base:    base,
+		Display: "STAKE",
+		Name:    "Stake",
+		Symbol:  "STAKE",
+		URI:     "https://example.com/stake.svg",
+	}
+}
+
+// newDefaultDenomMetadata returns the metadata derived from the provided denomination trace.
+func newDefaultDenomMetadata(denomTrace types.DenomTrace) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denomTrace.GetFullDenomPath()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denomTrace.GetBaseDenom(),
+				Exponent: 0,
+			},
+		},
+		Base:    denomTrace.IBCDenom(),
+		Display: denomTrace.GetFullDenomPath(),
+		Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
+		Symbol:  strings.ToUpper(denomTrace.GetBaseDenom()),
+	}
+}
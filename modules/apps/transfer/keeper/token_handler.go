@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+var _ types.TokenHandler = (*BankTokenHandler)(nil)
+
+// BankTokenHandler is the default TokenHandler, which moves tokens using the bank module.
+// Minted and burnt tokens transit through the transfer module account.
+type BankTokenHandler struct {
+	bankKeeper types.BankKeeper
+}
+
+// NewBankTokenHandler creates a new BankTokenHandler instance.
+func NewBankTokenHandler(bankKeeper types.BankKeeper) BankTokenHandler {
+	return BankTokenHandler{
+		bankKeeper: bankKeeper,
+	}
+}
+
+// EscrowTokens implements the TokenHandler interface.
+func (h BankTokenHandler) EscrowTokens(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
+	return h.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token))
+}
+
+// UnescrowTokens implements the TokenHandler interface.
+func (h BankTokenHandler) UnescrowTokens(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
+	return h.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token))
+}
+
+// MintTokens implements the TokenHandler interface. The tokens are minted to the transfer
+// module account and sent to the receiver.
+func (h BankTokenHandler) MintTokens(ctx sdk.Context, receiver sdk.AccAddress, token sdk.Coin) error {
+	if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return errorsmod.Wrap(err, "failed to mint IBC tokens")
+	}
+
+	if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(token)); err != nil {
+		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+	}
+
+	return nil
+}
+
+// BurnTokens implements the TokenHandler interface. The tokens are sent to the transfer
+// module account and burnt.
+func (h BankTokenHandler) BurnTokens(ctx sdk.Context, sender sdk.AccAddress, token sdk.Coin) error {
+	if err := h.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	if err := h.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(token)); err != nil {
+		// NOTE: should not happen as the module account was
+		// retrieved on the step above and it has enough balance
+		// to burn.
+		panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
+	}
+
+	return nil
+}
+
+// GetBalance implements the TokenHandler interface.
+func (h BankTokenHandler) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return h.bankKeeper.GetBalance(ctx, addr, denom)
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var _ types.TokenHandler = (*recordingTokenHandler)(nil)
+
+// recordingTokenHandler records the operations dispatched to it and delegates them to the bank token handler.
+type recordingTokenHandler struct {
+	keeper.BankTokenHandler
+	calls []string
+}
+
+func (h *recordingTokenHandler) EscrowTokens(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
+	h.calls = append(h.calls, "escrow")
+	return h.BankTokenHandler.EscrowTokens(ctx, sender, escrowAddress, token)
+}
+
+func (h *recordingTokenHandler) UnescrowTokens(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
+	h.calls = append(h.calls, "unescrow")
+	return h.BankTokenHandler.UnescrowTokens(ctx, escrowAddress, receiver, token)
+}
+
+func (h *recordingTokenHandler) MintTokens(ctx sdk.Context, receiver sdk.AccAddress, token sdk.Coin) error {
+	h.calls = append(h.calls, "mint")
+	return h.BankTokenHandler.MintTokens(ctx, receiver, token)
+}
+
+func (h *recordingTokenHandler) BurnTokens(ctx sdk.Context, sender sdk.AccAddress, token sdk.Coin) error {
+	h.calls = append(h.calls, "burn")
+	return h.BankTokenHandler.BurnTokens(ctx, sender, token)
+}
+
+func (suite *KeeperTestSuite) TestTokenHandlerDispatch() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	handler := &recordingTokenHandler{BankTokenHandler: keeper.NewBankTokenHandler(suite.chainA.GetSimApp().BankKeeper)}
+	suite.chainA.GetSimApp().TransferKeeper.RegisterTokenHandler("factory/", handler)
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	coin := sdk.NewCoin("factory/creator/token", sdkmath.NewInt(100))
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, sender, sdk.NewCoins(coin)))
+
+	// send the tokens from chain A to chain B, which escrows them through the registered handler
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"escrow"}, handler.calls)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(coin, handler.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom))
+
+	// vouchers minted on chain B are handled by the bank module
+	voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin.Denom, coin.Amount)
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	suite.Require().Equal(voucher, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom))
+
+	// send the vouchers back to chain A, which unescrows the tokens through the registered handler
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, receiver.String(), sender.String(), suite.chainA.GetTimeoutHeight(), 0, "")
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	suite.Require().Equal([]string{"escrow", "unescrow"}, handler.calls)
+	suite.Require().Equal(coin, handler.GetBalance(suite.chainA.GetContext(), sender, coin.Denom))
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Denom).IsZero())
+
+	// the total escrow invariant holds
+	_, broken := keeper.AllInvariants(&suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestTokenHandlerDispatchVouchers() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	handler := &recordingTokenHandler{BankTokenHandler: keeper.NewBankTokenHandler(suite.chainB.GetSimApp().BankKeeper)}
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+	transferKeeper.SetDefaultTokenHandler(handler)
+
+	// vouchers are minted on receipt through the default handler
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), receiver.String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+	suite.Require().NoError(transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, types.PacketDataV1ToV2(data)))
+	suite.Require().Equal([]string{"mint"}, handler.calls)
+
+	// vouchers sent back to their source are burned through the default handler
+	voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, voucher, receiver.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+	_, err := transferKeeper.Transfer(suite.chainB.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"mint", "burn"}, handler.calls)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucher.Denom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRegisterTokenHandler() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	handler := keeper.NewBankTokenHandler(suite.chainA.GetSimApp().BankKeeper)
+
+	suite.Require().NotPanics(func() {
+		transferKeeper.RegisterTokenHandler("cw20:", handler)
+	})
+
+	// the prefix is already registered
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterTokenHandler("cw20:", handler)
+	})
+
+	// vouchers are always handled by the bank module
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterTokenHandler("ibc/", handler)
+	})
+}
@@ -12,10 +12,13 @@ import (
 // TransferUnmarshaler defines the expected encoding store functions.
 type TransferUnmarshaler interface {
 	MustUnmarshalDenomTrace([]byte) types.DenomTrace
+	MustUnmarshalDenom([]byte) types.Denom
+	MustUnmarshalChannelParams([]byte) types.ChannelParams
+	MustUnmarshalPacketProtocolFee([]byte) types.PacketProtocolFee
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace, Denom, ChannelParams or PacketProtocolFee type.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,6 +30,24 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.DenomKey):
+			denomA := cdc.MustUnmarshalDenom(kvA.Value)
+			denomB := cdc.MustUnmarshalDenom(kvB.Value)
+			return fmt.Sprintf("Denom A: %s\nDenom B: %s", denomA.IBCDenom(), denomB.IBCDenom())
+
+		case bytes.Equal(kvA.Key[:1], types.ChannelParamsKey):
+			channelParamsA := cdc.MustUnmarshalChannelParams(kvA.Value)
+			channelParamsB := cdc.MustUnmarshalChannelParams(kvB.Value)
+			return fmt.Sprintf("ChannelParams A: %v\nChannelParams B: %v", channelParamsA, channelParamsB)
+
+		case bytes.Equal(kvA.Key[:1], types.RefundAddressKey):
+			return fmt.Sprintf("RefundAddress A: %s\nRefundAddress B: %s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ProtocolFeeKey):
+			packetFeeA := cdc.MustUnmarshalPacketProtocolFee(kvA.Value)
+			packetFeeB := cdc.MustUnmarshalPacketProtocolFee(kvB.Value)
+			return fmt.Sprintf("PacketProtocolFee A: %v\nPacketProtocolFee B: %v", packetFeeA, packetFeeB)
+
 		default:
 			panic(fmt.Errorf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -6,10 +6,15 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/simulation"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/simapp"
 )
 
@@ -22,6 +27,15 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-0"))
+	channelParams := types.NewChannelParams("transfer", "channel-0", "", false, true)
+	refundAddress := ibctesting.TestAccAddress
+	packetFee := types.PacketProtocolFee{
+		PacketId:     channeltypes.NewPacketID("transfer", "channel-0", 1),
+		FeeCollector: "fee_collector",
+		Fees:         sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10))),
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -32,6 +46,22 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.DenomKey,
+				Value: app.TransferKeeper.MustMarshalDenom(denom),
+			},
+			{
+				Key:   types.ChannelParamsKey,
+				Value: app.TransferKeeper.MustMarshalChannelParams(channelParams),
+			},
+			{
+				Key:   types.PacketRefundAddressKey("transfer", "channel-0", 1),
+				Value: []byte(refundAddress),
+			},
+			{
+				Key:   types.PacketProtocolFeeKey("transfer", "channel-0", 1),
+				Value: app.TransferKeeper.MustMarshalPacketProtocolFee(packetFee),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -44,6 +74,10 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"Denom", fmt.Sprintf("Denom A: %s\nDenom B: %s", denom.IBCDenom(), denom.IBCDenom())},
+		{"ChannelParams", fmt.Sprintf("ChannelParams A: %v\nChannelParams B: %v", channelParams, channelParams)},
+		{"RefundAddress", fmt.Sprintf("RefundAddress A: %s\nRefundAddress B: %s", refundAddress, refundAddress)},
+		{"PacketProtocolFee", fmt.Sprintf("PacketProtocolFee A: %v\nPacketProtocolFee B: %v", packetFee, packetFee)},
 		{"other", ""},
 	}
 
@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -143,6 +144,256 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// TestHandleMsgTransferMultiDenom transfers multiple denominations within a single
+// packet from chainA to chainB over an ics20-2 channel and sends them back again.
+func (suite *TransferTestSuite) TestHandleMsgTransferMultiDenom() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	path.Setup()
+
+	atom := sdk.NewCoin("atom", sdkmath.NewInt(100))
+	err := banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(atom))
+	suite.Require().NoError(err)
+
+	coinsToSendToB := sdk.NewCoins(atom, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	// send from chainA to chainB
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	msg.Tokens = coinsToSendToB
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	packetData, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+	suite.Require().NoError(err)
+	suite.Require().Len(packetData.Tokens, 2)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that the escrow address has locked all tokens
+	escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+	escrowBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress)
+	suite.Require().Equal(coinsToSendToB, escrowBalances)
+
+	// check that the vouchers for all tokens have been minted on chainB
+	var vouchers sdk.Coins
+	for _, coin := range coinsToSendToB {
+		voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), coin.Denom))
+		voucher := sdk.NewCoin(voucherDenomTrace.IBCDenom(), coin.Amount)
+
+		balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucher.Denom)
+		suite.Require().Equal(voucher, balance)
+
+		vouchers = vouchers.Add(voucher)
+	}
+
+	// send the vouchers back from chainB to chainA
+	msg = types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.Coin{}, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+	msg.Tokens = vouchers
+
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// check that all tokens have been unescrowed on chainA
+	escrowBalances = suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress)
+	suite.Require().Empty(escrowBalances)
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), atom.Denom)
+	suite.Require().Equal(atom, balance)
+
+	// check that the vouchers have been burned on chainB
+	for _, voucher := range vouchers {
+		supply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucher.Denom)
+		suite.Require().True(supply.IsZero())
+	}
+}
+
+// TestMultiDenomTransferAfterChannelUpgrade asserts that an existing ics20-1 channel
+// can opt in to multi-denom transfers by upgrading to ics20-2.
+func (suite *TransferTestSuite) TestMultiDenomTransferAfterChannelUpgrade() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	atom := sdk.NewCoin("atom", sdkmath.NewInt(100))
+	err := banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(atom))
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(atom, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	msg.Tokens = coins
+
+	// multiple denominations cannot be sent over an ics20-1 channel
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().Error(err)
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.V2
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	version, found := suite.chainA.GetSimApp().TransferKeeper.GetAppVersion(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.V2, version)
+
+	// escrow address remains unchanged after the upgrade
+	escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	msg.TimeoutHeight = suite.chainB.GetTimeoutHeight()
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	escrowBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress)
+	suite.Require().Equal(coins, escrowBalances)
+}
+
+// TestMultiDenomTransferTimeout asserts that all tokens of a multi-denom packet are
+// refunded to the sender when the packet times out.
+func (suite *TransferTestSuite) TestMultiDenomTransferTimeout() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = types.V2
+	path.EndpointB.ChannelConfig.Version = types.V2
+	path.Setup()
+
+	atom := sdk.NewCoin("atom", sdkmath.NewInt(100))
+	err := banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(atom))
+	suite.Require().NoError(err)
+
+	originalBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress())
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano())
+
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, timeoutTimestamp, "")
+	msg.Tokens = sdk.NewCoins(atom, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// need to update chainA's client representing chainB to prove missing ack
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err) // timeout committed
+
+	escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+	suite.Require().Empty(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), escrowAddress))
+
+	// the sender has been refunded all tokens (the stake balance is reduced by the fees paid for the transaction)
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), atom.Denom)
+	suite.Require().Equal(atom, balance)
+
+	balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalances.AmountOf(sdk.DefaultBondDenom), balance.Amount)
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TransferTestSuite))
 }
+
+// TestRecvPacketEvents asserts that the denomination and amount attributes are emitted alongside the tokens attribute
+// for single token packets, and that only the tokens attribute is emitted for multi-denom packets.
+func (suite *TransferTestSuite) TestRecvPacketEvents() {
+	testCases := []struct {
+		name       string
+		version    string
+		tokens     sdk.Coins
+		expAmounts bool
+	}{
+		{
+			"single token ics20-1 packet",
+			types.V1,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			true,
+		},
+		{
+			"single token ics20-2 packet",
+			types.V2,
+			sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			true,
+		},
+		{
+			"multi-denom ics20-2 packet",
+			types.V2,
+			sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = tc.version
+			path.EndpointB.ChannelConfig.Version = tc.version
+			path.Setup()
+
+			err := banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100))))
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.Coin{}, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			msg.Tokens = tc.tokens
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			res, err = path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			attributes := make(map[string]string)
+			for _, event := range res.Events {
+				if event.Type != types.EventTypePacket {
+					continue
+				}
+
+				for _, attr := range event.Attributes {
+					attributes[attr.Key] = attr.Value
+				}
+			}
+
+			suite.Require().Contains(attributes, types.AttributeKeyTokens)
+
+			denom, hasDenom := attributes[types.AttributeKeyDenom]
+			amount, hasAmount := attributes[types.AttributeKeyAmount]
+			suite.Require().Equal(tc.expAmounts, hasDenom)
+			suite.Require().Equal(tc.expAmounts, hasAmount)
+
+			if tc.expAmounts {
+				suite.Require().Equal(tc.tokens[0].Denom, denom)
+				suite.Require().Equal(tc.tokens[0].Amount.String(), amount)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewChannelParams creates a new ChannelParams instance. An empty denomination applies the
+// flags to all transfers over the channel.
+func NewChannelParams(portID, channelID, denom string, sendEnabled, receiveEnabled bool) ChannelParams {
+	return ChannelParams{
+		PortId:         portID,
+		ChannelId:      channelID,
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// Validate performs a basic validation of the ChannelParams fields.
+func (cp ChannelParams) Validate() error {
+	if err := host.PortIdentifierValidator(cp.PortId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelParams, "invalid port ID %s: %s", cp.PortId, err)
+	}
+	if err := host.ChannelIdentifierValidator(cp.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidChannelParams, "invalid channel ID %s: %s", cp.ChannelId, err)
+	}
+	if cp.Denom != "" {
+		if err := sdk.ValidateDenom(cp.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidChannelParams, "invalid denomination %s: %s", cp.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// IsDefault returns true if both sending and receiving are enabled, which is equivalent
+// to the absence of channel parameters.
+func (cp ChannelParams) IsDefault() bool {
+	return cp.SendEnabled && cp.ReceiveEnabled
+}
+
+// validateChannelParams performs a basic validation of the provided channel parameters
+// and checks that no channel and denomination pair is set more than once.
+func validateChannelParams(channelParams []ChannelParams) error {
+	seen := make(map[string]bool)
+	for _, cp := range channelParams {
+		if err := cp.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", cp.PortId, cp.ChannelId, cp.Denom)
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidChannelParams, "duplicate channel params for port ID %s, channel ID %s and denomination %s", cp.PortId, cp.ChannelId, cp.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
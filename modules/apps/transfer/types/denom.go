@@ -0,0 +1,204 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// NewDenom creates a new Denom instance given the base denomination and the
+// hops it was transferred through, ordered from the most recent to the oldest.
+func NewDenom(base string, trace ...Hop) Denom {
+	return Denom{
+		Base:  base,
+		Trace: trace,
+	}
+}
+
+// ExtractDenomFromPath returns the denomination described by the provided full
+// denomination path, i.e. the port and channel identifiers of each hop followed
+// by the base denomination. Hops are recognised by their channel identifier, so a base
+// denomination containing a valid channel identifier is split into hops; the denomination
+// stored for a voucher should be preferred when it is available.
+//
+// Examples:
+//
+// - "portidone/channel-0/uatom" => Denom{Base: "uatom", Trace: [{portidone channel-0}]}
+// - "portidone/channel-0/portidtwo/channel-1/uatom" => Denom{Base: "uatom", Trace: [{portidone channel-0} {portidtwo channel-1}]}
+// - "portidone/channel-0/gamm/pool/1" => Denom{Base: "gamm/pool/1", Trace: [{portidone channel-0}]}
+// - "gamm/pool/1" => Denom{Base: "gamm/pool/1"}
+// - "uatom" => Denom{Base: "uatom"}
+func ExtractDenomFromPath(fullPath string) Denom {
+	denomSplit := strings.Split(fullPath, "/")
+	if denomSplit[0] == fullPath {
+		return NewDenom(fullPath)
+	}
+
+	var trace []Hop
+	length := len(denomSplit)
+	for i := 0; i < length; i += 2 {
+		// The IBC specification does not guarantee the expected format of the
+		// destination port or destination channel identifier. The hops of the trace
+		// are therefore expected to use channel identifiers in the format ibc-go
+		// specifies, and the remainder of the path is the base denomination. If an
+		// intermediate hop uses a different format, the base denomination will be
+		// incorrectly parsed, but the hash of the full path, and thus the IBC
+		// denomination, remains the same.
+		if i < length-1 && length > 2 && channeltypes.IsValidChannelID(denomSplit[i+1]) {
+			trace = append(trace, NewHop(denomSplit[i], denomSplit[i+1]))
+			continue
+		}
+
+		return NewDenom(strings.Join(denomSplit[i:], "/"), trace...)
+	}
+
+	// every element of the path is part of a hop, so the base denomination is empty
+	return NewDenom("", trace...)
+}
+
+// ReceivedDenom returns the denomination of a token, described by its full denomination
+// path on the sending chain, once it has been received over the provided destination port
+// and channel, i.e. the denomination prefixed with the hop of the destination.
+func ReceivedDenom(destPort, destChannel, fullPath string) Denom {
+	denom := ExtractDenomFromPath(fullPath)
+	denom.Trace = append([]Hop{NewHop(destPort, destChannel)}, denom.Trace...)
+	return denom
+}
+
+// ToDenom returns the structured representation of the denomination trace.
+func (dt DenomTrace) ToDenom() Denom {
+	if dt.Path == "" {
+		return NewDenom(dt.BaseDenom)
+	}
+
+	identifiers := strings.Split(dt.Path, "/")
+	trace := make([]Hop, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		trace = append(trace, NewHop(identifiers[i], identifiers[i+1]))
+	}
+
+	return NewDenom(dt.BaseDenom, trace...)
+}
+
+// ToDenomTrace returns the denomination trace representation of the denomination.
+func (d Denom) ToDenomTrace() DenomTrace {
+	return DenomTrace{
+		Path:      d.tracePath(),
+		BaseDenom: d.Base,
+	}
+}
+
+// Path returns the full denomination according to the ICS20 specification:
+// tracePath + "/" + baseDenom
+// If there exists no trace then the base denomination is returned.
+func (d Denom) Path() string {
+	if d.IsNative() {
+		return d.Base
+	}
+
+	return d.tracePath() + "/" + d.Base
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the full denomination path.
+// The hash is equal to the hash of the equivalent DenomTrace.
+func (d Denom) Hash() cmtbytes.HexBytes {
+	hash := sha256.Sum256([]byte(d.Path()))
+	return hash[:]
+}
+
+// IBCDenom returns the coin denomination for an ICS20 fungible token in the format
+// 'ibc/{hash(tracePath + baseDenom)}'. If the trace is empty, it will return the base denomination.
+func (d Denom) IBCDenom() string {
+	if d.IsNative() {
+		return d.Base
+	}
+
+	return fmt.Sprintf("%s/%s", DenomPrefix, d.Hash())
+}
+
+// IsNative returns true if the denomination is native, thus containing no trace history.
+func (d Denom) IsNative() bool {
+	return len(d.Trace) == 0
+}
+
+// HasHop returns true if the denomination was transferred through the provided port and channel.
+func (d Denom) HasHop(portID, channelID string) bool {
+	for _, hop := range d.Trace {
+		if hop.PortId == portID && hop.ChannelId == channelID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs a basic validation of the Denom fields.
+func (d Denom) Validate() error {
+	if strings.TrimSpace(d.Base) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, "base denomination cannot be blank")
+	}
+
+	// NOTE: no base denomination validation
+
+	for i, hop := range d.Trace {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid hop at position %d", i)
+		}
+	}
+
+	return nil
+}
+
+// tracePath returns the port and channel identifiers of the trace joined by slashes.
+func (d Denom) tracePath() string {
+	identifiers := make([]string, 0, 2*len(d.Trace))
+	for _, hop := range d.Trace {
+		identifiers = append(identifiers, hop.PortId, hop.ChannelId)
+	}
+
+	return strings.Join(identifiers, "/")
+}
+
+// Denoms defines a wrapper type for a slice of Denom.
+type Denoms []Denom
+
+// Validate performs a basic validation of each denomination.
+func (d Denoms) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for i, denom := range d {
+		hash := denom.Hash().String()
+		if seenDenoms[hash] {
+			return fmt.Errorf("duplicated denomination with hash %s", hash)
+		}
+
+		if err := denom.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed denom %d validation", i)
+		}
+		seenDenoms[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = (*Denoms)(nil)
+
+// Len implements sort.Interface for Denoms
+func (d Denoms) Len() int { return len(d) }
+
+// Less implements sort.Interface for Denoms
+func (d Denoms) Less(i, j int) bool { return d[i].Path() < d[j].Path() }
+
+// Swap implements sort.Interface for Denoms
+func (d Denoms) Swap(i, j int) { d[i], d[j] = d[j], d[i] }
+
+// Sort is a helper function to sort the set of denominations in-place
+func (d Denoms) Sort() Denoms {
+	sort.Sort(d)
+	return d
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestExtractDenomFromPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		expDenom types.Denom
+	}{
+		{"base denom", "uatom", types.NewDenom("uatom")},
+		{"base denom with single '/'s", "gamm/pool/1", types.NewDenom("gamm/pool/1")},
+		{"trace info", "transfer/channel-1/uatom", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))},
+		{"trace info with multiple '/'s in base denom", "transfer/channel-1/gamm/pool/1", types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channel-1"))},
+		{"trace info with multiple port/channel pairs", "transfer/channel-1/customtransfer/channel-2/uatom", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"), types.NewHop("customtransfer", "channel-2"))},
+		{"non-standard channel identifier", "transfer/channelToA/uatom", types.NewDenom("transfer/channelToA/uatom")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			denom := types.ExtractDenomFromPath(tc.path)
+			require.Equal(t, tc.expDenom, denom)
+			require.Equal(t, tc.path, denom.Path())
+
+			// the legacy parsing of denomination traces must result in the same IBC denomination
+			require.Equal(t, types.ParseDenomTrace(tc.path).IBCDenom(), denom.IBCDenom())
+		})
+	}
+}
+
+func TestReceivedDenom(t *testing.T) {
+	denom := types.ReceivedDenom("transfer", "channel-1", "customtransfer/channel-2/gamm/pool/1")
+
+	require.Equal(t, types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channel-1"), types.NewHop("customtransfer", "channel-2")), denom)
+	require.Equal(t, types.NewDenom("uatom", types.NewHop("transfer", "channel-1")), types.ReceivedDenom("transfer", "channel-1", "uatom"))
+}
+
+func TestDenomTraceConversion(t *testing.T) {
+	testCases := []struct {
+		name  string
+		trace types.DenomTrace
+		denom types.Denom
+	}{
+		{"base denom", types.DenomTrace{BaseDenom: "uatom"}, types.NewDenom("uatom")},
+		{"trace info", types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}, types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))},
+		{"non-standard channel identifiers", types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA/transfer/channelToB"}, types.NewDenom("uatom", types.NewHop("transfer", "channelToA"), types.NewHop("transfer", "channelToB"))},
+		{"base denom with slashes and channel identifier", types.DenomTrace{BaseDenom: "gamm/channel-2", Path: "transfer/channel-1"}, types.NewDenom("gamm/channel-2", types.NewHop("transfer", "channel-1"))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.denom, tc.trace.ToDenom())
+			require.Equal(t, tc.trace, tc.denom.ToDenomTrace())
+
+			// the hash and the IBC denomination must not change
+			require.Equal(t, tc.trace.Hash(), tc.denom.Hash())
+			require.Equal(t, tc.trace.IBCDenom(), tc.denom.IBCDenom())
+			require.Equal(t, tc.trace.GetFullDenomPath(), tc.denom.Path())
+			require.Equal(t, tc.trace.IsNativeDenom(), tc.denom.IsNative())
+		})
+	}
+}
+
+func TestDenomHasHop(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-1"), types.NewHop("customtransfer", "channel-2"))
+
+	require.True(t, denom.HasHop("transfer", "channel-1"))
+	require.True(t, denom.HasHop("customtransfer", "channel-2"))
+	require.False(t, denom.HasHop("transfer", "channel-2"))
+	require.False(t, types.NewDenom("uatom").HasHop("transfer", "channel-1"))
+}
+
+func TestDenom_Validate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    types.Denom
+		expError bool
+	}{
+		{"base denom", types.NewDenom("uatom"), false},
+		{"base denom with slashes", types.NewDenom("gamm/pool/1", types.NewHop("transfer", "channel-1")), false},
+		{"trace info", types.NewDenom("uatom", types.NewHop("transfer", "channel-1"), types.NewHop("transfer", "channel-2")), false},
+		{"empty base denom", types.NewDenom("", types.NewHop("transfer", "channel-1")), true},
+		{"blank base denom", types.NewDenom("  "), true},
+		{"invalid port identifier", types.NewDenom("uatom", types.NewHop("(transfer)", "channel-1")), true},
+		{"invalid channel identifier", types.NewDenom("uatom", types.NewHop("transfer", "")), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.denom.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDenoms_Validate(t *testing.T) {
+	denom := types.NewDenom("uatom", types.NewHop("transfer", "channel-1"))
+
+	testCases := []struct {
+		name     string
+		denoms   types.Denoms
+		expError bool
+	}{
+		{"empty denoms", types.Denoms{}, false},
+		{"valid multiple denoms", types.Denoms{denom, types.NewDenom("uatom")}, false},
+		{"duplicate denoms", types.Denoms{denom, denom}, true},
+		{"invalid denom", types.Denoms{denom, types.NewDenom("")}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.denoms.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
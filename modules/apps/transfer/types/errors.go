@@ -16,4 +16,12 @@ var (
 	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 9, "max transfer channels")
 	ErrInvalidAuthorization    = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
 	ErrInvalidMemo             = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
+	ErrForwardedPacketFailed   = errorsmod.Register(ModuleName, 13, "forwarded packet failed")
+	ErrForwardedPacketTimedOut = errorsmod.Register(ModuleName, 14, "forwarded packet timed out")
+	ErrInvalidDenomMetadata    = errorsmod.Register(ModuleName, 15, "invalid denomination metadata")
+	ErrInvalidTokenHandler     = errorsmod.Register(ModuleName, 16, "invalid token handler")
+	ErrInvalidChannelParams    = errorsmod.Register(ModuleName, 17, "invalid channel params")
+	ErrRefundAddressNotFound   = errorsmod.Register(ModuleName, 18, "refund address not found")
+	ErrInvalidProtocolFee      = errorsmod.Register(ModuleName, 19, "invalid protocol fee")
 )
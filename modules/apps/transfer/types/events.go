@@ -2,21 +2,33 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeTimeout           = "timeout"
+	EventTypePacket            = "fungible_token_packet"
+	EventTypeTransfer          = "ibc_transfer"
+	EventTypeChannelClose      = "channel_closed"
+	EventTypeDenomTrace        = "denomination_trace"
+	EventTypeReconcileEscrow   = "reconcile_escrow"
+	EventTypeProtocolFee       = "protocol_fee"
+	EventTypeProtocolFeeRefund = "protocol_fee_refund"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyDenom          = "denom"
 	AttributeKeyAmount         = "amount"
+	AttributeKeyTokens         = "tokens"
 	AttributeKeyRefundReceiver = "refund_receiver"
 	AttributeKeyRefundDenom    = "refund_denom"
 	AttributeKeyRefundAmount   = "refund_amount"
+	AttributeKeyRefundTokens   = "refund_tokens"
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAck            = "acknowledgement"
 	AttributeKeyAckError       = "error"
 	AttributeKeyTraceHash      = "trace_hash"
 	AttributeKeyMemo           = "memo"
+	AttributeKeyTotalEscrow    = "total_escrow"
+	AttributeKeyRecovered      = "recovered"
+	AttributeKeyRecipient      = "recipient"
+	AttributeKeyEscrowAddress  = "escrow_address"
+	AttributeKeyRefundAddress  = "refund_address"
+	AttributeKeyFees           = "fees"
+	AttributeKeyFeeCollector   = "fee_collector"
 )
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+const (
+	// MaximumNumberOfForwardingHops denotes the maximum number of hops tokens may be forwarded through.
+	MaximumNumberOfForwardingHops = 8
+
+	// DefaultForwardingPacketTimeout is the timeout, relative to the current block time, of packets
+	// sent to the next hop when forwarding tokens if no relative timeout is specified.
+	DefaultForwardingPacketTimeout = time.Hour
+
+	// MaximumForwardingPacketTimeout is the maximum relative timeout of packets sent to the next hop
+	// when forwarding tokens.
+	MaximumForwardingPacketTimeout = 24 * time.Hour
+)
+
+// NewForwarding creates a new Forwarding instance given the ordered list of hops.
+func NewForwarding(hops ...Hop) *Forwarding {
+	return &Forwarding{
+		Hops: hops,
+	}
+}
+
+// Validate performs a basic validation of the Forwarding fields.
+func (f Forwarding) Validate() error {
+	if err := validateRelativeTimeout(f.RelativeTimeout); err != nil {
+		return err
+	}
+
+	return validateHops(f.Hops)
+}
+
+// NewForwardingPacketData creates a new ForwardingPacketData instance given the
+// destination memo and the remaining hops.
+func NewForwardingPacketData(destinationMemo string, hops ...Hop) *ForwardingPacketData {
+	return &ForwardingPacketData{
+		DestinationMemo: destinationMemo,
+		Hops:            hops,
+	}
+}
+
+// PacketTimeout returns the timeout, relative to the current block time, of the packet sent to the
+// next hop. The default forwarding packet timeout is returned if no relative timeout is specified.
+func (fpd ForwardingPacketData) PacketTimeout() time.Duration {
+	if fpd.RelativeTimeout == 0 {
+		return DefaultForwardingPacketTimeout
+	}
+
+	return time.Duration(fpd.RelativeTimeout)
+}
+
+// Validate performs a basic validation of the ForwardingPacketData fields.
+func (fpd ForwardingPacketData) Validate() error {
+	if len(fpd.Hops) == 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "forwarding hops must not be empty")
+	}
+
+	if len(fpd.DestinationMemo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "destination memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if err := validateRelativeTimeout(fpd.RelativeTimeout); err != nil {
+		return err
+	}
+
+	return validateHops(fpd.Hops)
+}
+
+// NewHop creates a new Hop instance given the port ID and channel ID.
+func NewHop(portID, channelID string) Hop {
+	return Hop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the Hop fields.
+func (h Hop) Validate() error {
+	if err := host.PortIdentifierValidator(h.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source port ID %s", h.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid hop source channel ID %s", h.ChannelId)
+	}
+
+	return nil
+}
+
+// validateHops performs a basic validation of the provided hops.
+func validateHops(hops []Hop) error {
+	if len(hops) > MaximumNumberOfForwardingHops {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of hops must not exceed %d", MaximumNumberOfForwardingHops)
+	}
+
+	for _, hop := range hops {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidForwarding, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// validateRelativeTimeout returns an error if the provided forwarding relative timeout exceeds the
+// maximum forwarding packet timeout.
+func validateRelativeTimeout(relativeTimeout uint64) error {
+	if relativeTimeout > uint64(MaximumForwardingPacketTimeout) {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "relative timeout must not exceed %s", MaximumForwardingPacketTimeout)
+	}
+
+	return nil
+}
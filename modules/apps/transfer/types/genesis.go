@@ -1,9 +1,13 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
@@ -35,5 +39,63 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, forwardedPacket := range gs.ForwardedPackets {
+		if err := forwardedPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, refundAddress := range gs.RefundAddresses {
+		if err := refundAddress.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, protocolFee := range gs.ProtocolFees {
+		if err := protocolFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateChannelParams(gs.ChannelParams)
+}
+
+// Validate performs a basic validation of the ForwardedPacket fields.
+func (fp ForwardedPacket) Validate() error {
+	if err := host.PortIdentifierValidator(fp.ForwardKey.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(fp.ForwardKey.ChannelId); err != nil {
+		return err
+	}
+	if fp.ForwardKey.Sequence == 0 {
+		return errorsmod.Wrap(channeltypes.ErrInvalidPacket, "forwarded packet sequence cannot be 0")
+	}
+
+	return fp.Packet.ValidateBasic()
+}
+
+// Validate performs a basic validation of the PacketRefundAddress fields.
+func (ra PacketRefundAddress) Validate() error {
+	if err := host.PortIdentifierValidator(ra.PacketId.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(ra.PacketId.ChannelId); err != nil {
+		return err
+	}
+	if ra.PacketId.Sequence == 0 {
+		return errorsmod.Wrap(channeltypes.ErrInvalidPacket, "refund address packet sequence cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(ra.RefundAddress); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
 }
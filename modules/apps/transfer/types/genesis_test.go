@@ -6,9 +6,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	forwardedPacket := channeltypes.NewPacket(
+		ibctesting.MockPacketData, 1, types.PortID, ibctesting.FirstChannelID, types.PortID, ibctesting.FirstChannelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -33,6 +41,137 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid forwarded packet",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{
+					{
+						ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+						Packet:     forwardedPacket,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid forwarded packet key",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{
+					{
+						ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 0),
+						Packet:     forwardedPacket,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid forwarded packet",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ForwardedPackets: []types.ForwardedPacket{
+					{
+						ForwardKey: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+						Packet:     channeltypes.Packet{},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"valid refund address",
+			&types.GenesisState{
+				PortId: types.PortID,
+				RefundAddresses: []types.PacketRefundAddress{
+					{
+						PacketId:      channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+						RefundAddress: ibctesting.TestAccAddress,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid refund address packet sequence",
+			&types.GenesisState{
+				PortId: types.PortID,
+				RefundAddresses: []types.PacketRefundAddress{
+					{
+						PacketId:      channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 0),
+						RefundAddress: ibctesting.TestAccAddress,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			&types.GenesisState{
+				PortId: types.PortID,
+				RefundAddresses: []types.PacketRefundAddress{
+					{
+						PacketId:      channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+						RefundAddress: "invalid",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid params",
+			&types.GenesisState{
+				PortId: types.PortID,
+				Params: types.Params{ProtocolFee: types.NewProtocolFee(types.MaxProtocolFeeBasisPoints, "fee_collector", false)},
+			},
+			false,
+		},
+		{
+			"invalid protocol fee",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ProtocolFees: []types.PacketProtocolFee{
+					{
+						PacketId:     channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1),
+						FeeCollector: "fee_collector",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"valid channel params",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ChannelParams: []types.ChannelParams{
+					types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, "", false, true),
+					types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, "uatom", true, false),
+				},
+			},
+			true,
+		},
+		{
+			"invalid channel params",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ChannelParams: []types.ChannelParams{
+					types.NewChannelParams(types.PortID, "", "", false, true),
+				},
+			},
+			false,
+		},
+		{
+			"duplicate channel params",
+			&types.GenesisState{
+				PortId: types.PortID,
+				ChannelParams: []types.ChannelParams{
+					types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, "uatom", false, true),
+					types.NewChannelParams(types.PortID, ibctesting.FirstChannelID, "uatom", true, false),
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
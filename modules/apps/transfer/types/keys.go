@@ -3,17 +3,27 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
 	// ModuleName defines the IBC transfer name
 	ModuleName = "transfer"
 
-	// Version defines the current version the IBC transfer
-	// module supports
-	Version = "ics20-1"
+	// V1 defines first version of the IBC transfer module
+	V1 = "ics20-1"
+
+	// V2 defines the transfer version which introduces multi-denom support
+	// through the FungibleTokenPacketDataV2 packet data type
+	V2 = "ics20-2"
+
+	// Version defines the default version the IBC transfer module proposes
+	// when opening a new channel. Existing channels may opt in to V2
+	// through the channel upgrade handshake.
+	Version = V1
 
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
@@ -41,10 +51,29 @@ const (
 var (
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
-	// DenomTraceKey defines the key to store the denomination trace info in store
+	// DenomTraceKey defines the key under which the denomination trace info was stored
+	// prior to the migration to structured denominations
 	DenomTraceKey = []byte{0x02}
+	// ForwardedPacketKey defines the key to store the packets which have been forwarded to the next hop
+	ForwardedPacketKey = []byte{0x03}
+	// DenomKey defines the key to store the denominations in store
+	DenomKey = []byte{0x04}
+	// ChannelParamsKey defines the key to store the parameters enabling or disabling transfers over channels
+	ChannelParamsKey = []byte{0x05}
+	// RefundAddressKey defines the key to store the alternate refund addresses of sent packets
+	RefundAddressKey = []byte{0x06}
+	// ProtocolFeeKey defines the key to store the refundable protocol fees of sent packets
+	ProtocolFeeKey = []byte{0x07}
+
+	// SupportedVersions defines all versions that are supported by the module
+	SupportedVersions = []string{V1, V2}
 )
 
+// IsSupportedVersion returns true if the provided version is supported by the transfer module.
+func IsSupportedVersion(version string) bool {
+	return slices.Contains(SupportedVersions, version)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
@@ -54,15 +83,57 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	contents := fmt.Sprintf("%s/%s", portID, channelID)
 
 	// ADR 028 AddressHash construction
-	preImage := []byte(Version)
+	//
+	// NOTE: the escrow address is always derived with the V1 version string to ensure
+	// escrow addresses do not change for channels which are upgraded to a later version.
+	preImage := []byte(V1)
 	preImage = append(preImage, 0)
 	preImage = append(preImage, contents...)
 	hash := sha256.Sum256(preImage)
 	return hash[:20]
 }
 
+// GetForwardAddress returns the address which holds the tokens received over the
+// specified channel while they are forwarded to the next hop. The address is derived
+// as a module account address as outlined in ADR 028.
+func GetForwardAddress(portID, channelID string) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("forward"), []byte(portID), []byte(channelID))
+}
+
+// PacketForwardKey returns the store key under which the packet received and
+// forwarded to the next hop is stored. The key is composed of the identifiers
+// of the packet sent to the next hop.
+func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
+	return append(ForwardedPacketKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// PacketRefundAddressKey returns the store key under which the alternate refund address
+// of the packet identified by the provided port ID, channel ID and sequence is stored.
+func PacketRefundAddressKey(portID, channelID string, sequence uint64) []byte {
+	return append(RefundAddressKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
+// PacketProtocolFeeKey returns the store key under which the refundable protocol fees of the
+// packet identified by the provided port ID, channel ID and sequence are stored.
+func PacketProtocolFeeKey(portID, channelID string, sequence uint64) []byte {
+	return append(ProtocolFeeKey, []byte(fmt.Sprintf("%s/%s/%d", portID, channelID, sequence))...)
+}
+
 // TotalEscrowForDenomKey returns the store key of under which the total amount of
 // source chain tokens in escrow is stored.
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// ChannelParamsPrefix returns the key prefix, relative to ChannelParamsKey, of the parameters
+// of the provided channel.
+func ChannelParamsPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", portID, channelID))
+}
+
+// ChannelParamsStoreKey returns the key, relative to ChannelParamsKey, under which the parameters
+// of the provided channel and denomination are stored. An empty denomination is used for the
+// parameters applying to the whole channel.
+func ChannelParamsStoreKey(portID, channelID, denom string) []byte {
+	return append(ChannelParamsPrefix(portID, channelID), []byte(denom)...)
+}
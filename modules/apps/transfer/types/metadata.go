@@ -0,0 +1,38 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewVoucherDenomMetadata returns the bank metadata of the voucher denomination described by the
+// provided denomination, derived from the metadata of the token on the sending chain. The
+// base denomination of the source metadata, as well as the denomination unit and display
+// denomination referring to it, are rewritten to the IBC denomination of the voucher. All other
+// fields (e.g. additional denomination units, name, symbol and URI) are preserved.
+func NewVoucherDenomMetadata(denom Denom, sourceMetadata banktypes.Metadata) (banktypes.Metadata, error) {
+	voucherDenom := denom.IBCDenom()
+
+	metadata := sourceMetadata
+	metadata.Base = voucherDenom
+	if sourceMetadata.Display == sourceMetadata.Base {
+		metadata.Display = voucherDenom
+	}
+
+	metadata.DenomUnits = make([]*banktypes.DenomUnit, len(sourceMetadata.DenomUnits))
+	for i, denomUnit := range sourceMetadata.DenomUnits {
+		unit := *denomUnit
+		if unit.Denom == sourceMetadata.Base {
+			unit.Denom = voucherDenom
+		}
+
+		metadata.DenomUnits[i] = &unit
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrapf(ErrInvalidDenomMetadata, "invalid voucher metadata for %s: %s", denom.Path(), err)
+	}
+
+	return metadata, nil
+}
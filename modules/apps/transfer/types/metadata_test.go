@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// newAtomMetadata returns valid bank metadata for the atom token with the provided base denomination.
+func newAtomMetadata(base string) banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: base, Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    base,
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		URI:     "https://cosmos.network/atom.svg",
+	}
+}
+
+func TestNewVoucherDenomMetadata(t *testing.T) {
+	denom := types.ExtractDenomFromPath("transfer/channel-0/uatom")
+	voucherDenom := denom.IBCDenom()
+
+	var sourceMetadata banktypes.Metadata
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expMetadata func() banktypes.Metadata
+		expError    error
+	}{
+		{
+			"success",
+			func() {},
+			func() banktypes.Metadata {
+				return newAtomMetadata(voucherDenom)
+			},
+			nil,
+		},
+		{
+			"success: display denomination is the base denomination",
+			func() {
+				sourceMetadata.Display = "uatom"
+			},
+			func() banktypes.Metadata {
+				metadata := newAtomMetadata(voucherDenom)
+				metadata.Display = voucherDenom
+				return metadata
+			},
+			nil,
+		},
+		{
+			"success: source token is an IBC voucher",
+			func() {
+				sourceMetadata = newAtomMetadata("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+			},
+			func() banktypes.Metadata {
+				return newAtomMetadata(voucherDenom)
+			},
+			nil,
+		},
+		{
+			"failure: base denomination unit is missing",
+			func() {
+				sourceMetadata.DenomUnits = sourceMetadata.DenomUnits[1:]
+			},
+			nil,
+			types.ErrInvalidDenomMetadata,
+		},
+		{
+			"failure: empty name",
+			func() {
+				sourceMetadata.Name = ""
+			},
+			nil,
+			types.ErrInvalidDenomMetadata,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			sourceMetadata = newAtomMetadata("uatom")
+
+			tc.malleate()
+
+			metadata, err := types.NewVoucherDenomMetadata(denom, sourceMetadata)
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expMetadata(), metadata)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestNewVoucherDenomMetadataDoesNotModifySource tests that the metadata received from the source chain is not modified.
+func TestNewVoucherDenomMetadataDoesNotModifySource(t *testing.T) {
+	sourceMetadata := newAtomMetadata("uatom")
+
+	_, err := types.NewVoucherDenomMetadata(types.ExtractDenomFromPath("transfer/channel-0/uatom"), sourceMetadata)
+	require.NoError(t, err)
+	require.Equal(t, newAtomMetadata("uatom"), sourceMetadata)
+}
@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -15,13 +16,20 @@ import (
 const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
+	MaximumTokensLength   = 64    // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
 )
 
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.Msg              = (*MsgUpdateChannelParams)(nil)
+	_ sdk.Msg              = (*MsgReconcileEscrow)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateDenomMetadata)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateChannelParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgReconcileEscrow)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -39,6 +47,98 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
+	return msg.Params.Validate()
+}
+
+// NewMsgUpdateDenomMetadata creates a new MsgUpdateDenomMetadata instance
+func NewMsgUpdateDenomMetadata(signer string, metadata banktypes.Metadata) *MsgUpdateDenomMetadata {
+	return &MsgUpdateDenomMetadata{
+		Signer:   signer,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if !strings.HasPrefix(msg.Metadata.Base, DenomPrefix+"/") {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "base denomination %s is not an IBC denomination", msg.Metadata.Base)
+	}
+
+	if err := ValidateIBCDenom(msg.Metadata.Base); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomMetadata, err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgUpdateChannelParams creates a new MsgUpdateChannelParams instance
+func NewMsgUpdateChannelParams(signer string, channelParams ChannelParams) *MsgUpdateChannelParams {
+	return &MsgUpdateChannelParams{
+		Signer:        signer,
+		ChannelParams: channelParams,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateChannelParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.ChannelParams.Validate()
+}
+
+// NewMsgReconcileEscrow creates a new MsgReconcileEscrow instance. If the recipient is empty,
+// the port and channel identifiers must be empty as well.
+func NewMsgReconcileEscrow(signer, denom, portID, channelID, recipient string) *MsgReconcileEscrow {
+	return &MsgReconcileEscrow{
+		Signer:    signer,
+		Denom:     denom,
+		PortId:    portID,
+		ChannelId: channelID,
+		Recipient: recipient,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReconcileEscrow) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+
+	if msg.Recipient == "" {
+		if msg.PortId != "" || msg.ChannelId != "" {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "port and channel identifiers must only be set together with the recipient")
+		}
+
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid port ID %s", msg.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel ID %s", msg.ChannelId)
+	}
+
 	return nil
 }
 
@@ -72,11 +172,19 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
 		return errorsmod.Wrap(err, "invalid source channel ID")
 	}
-	if !msg.Token.IsValid() {
-		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
+	if isEmptyToken(msg.Token) && len(msg.Tokens) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "either token or tokens must be set")
+	}
+	if !isEmptyToken(msg.Token) && len(msg.Tokens) != 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "cannot set both token and tokens")
 	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
+	if len(msg.Tokens) > MaximumTokensLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "number of tokens must not exceed %d", MaximumTokensLength)
+	}
+
+	coins := msg.GetCoins()
+	if !coins.IsValid() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, coins.String())
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -92,5 +200,39 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
-	return ValidateIBCDenom(msg.Token.Denom)
+	if msg.Forwarding != nil {
+		if err := msg.Forwarding.Validate(); err != nil {
+			return err
+		}
+	}
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
+
+	for _, coin := range coins {
+		if err := ValidateIBCDenom(coin.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCoins returns the tokens which will be transferred.
+// If MsgTransfer is populated in the Token field, only that field
+// will be returned in the coin array.
+func (msg MsgTransfer) GetCoins() sdk.Coins {
+	if !isEmptyToken(msg.Token) {
+		return sdk.Coins{msg.Token}
+	}
+
+	return msg.Tokens
+}
+
+// isEmptyToken returns true if the provided coin is unset. A coin decoded from an
+// unset non-nullable protobuf field has an empty denom and a zero amount.
+func isEmptyToken(token sdk.Coin) bool {
+	return token.Denom == "" && (token.Amount.IsNil() || token.Amount.IsZero())
 }
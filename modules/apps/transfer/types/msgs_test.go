@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +16,8 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -70,6 +74,21 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, ""), false},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid msg with multiple tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.NewCoins(coin, ibcCoin)), true},
+		{"token and tokens both set", newMsgTransferWithTokens(coin, sdk.NewCoins(ibcCoin)), false},
+		{"unsorted tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{ibcCoin, coin}), false},
+		{"duplicate tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, coin}), false},
+		{"invalid ibc denom in tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, invalidIBCCoin}), false},
+		{"zero coin in tokens", newMsgTransferWithTokens(sdk.Coin{}, sdk.Coins{coin, zeroCoin}), false},
+		{"too many tokens", newMsgTransferWithTokens(sdk.Coin{}, generateCoins(types.MaximumTokensLength+1)), false},
+		{"valid msg with forwarding", newMsgTransferWithForwarding(types.NewHop(validPort, validChannel)), true},
+		{"valid msg with empty forwarding", newMsgTransferWithForwarding(), true},
+		{"invalid forwarding hop", newMsgTransferWithForwarding(types.NewHop(validPort, invalidChannel)), false},
+		{"too many forwarding hops", newMsgTransferWithForwarding(make([]types.Hop, types.MaximumNumberOfForwardingHops+1)...), false},
+		{"valid forwarding relative timeout", newMsgTransferWithForwardingTimeout(types.MaximumForwardingPacketTimeout), true},
+		{"forwarding relative timeout exceeds maximum", newMsgTransferWithForwardingTimeout(types.MaximumForwardingPacketTimeout + 1), false},
+		{"valid msg with refund address", newMsgTransferWithRefundAddress(sender), true},
+		{"invalid refund address", newMsgTransferWithRefundAddress("invalid"), false},
 	}
 
 	for i, tc := range testCases {
@@ -84,6 +103,43 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// newMsgTransferWithTokens returns a valid MsgTransfer populated with the provided token and tokens.
+func newMsgTransferWithTokens(token sdk.Coin, tokens sdk.Coins) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(validPort, validChannel, token, sender, receiver, timeoutHeight, 0, "")
+	msg.Tokens = tokens
+	return msg
+}
+
+// newMsgTransferWithForwardingTimeout returns a valid MsgTransfer forwarded through a single hop with the provided relative timeout.
+func newMsgTransferWithForwardingTimeout(relativeTimeout time.Duration) *types.MsgTransfer {
+	msg := newMsgTransferWithForwarding(types.NewHop(validPort, validChannel))
+	msg.Forwarding.RelativeTimeout = uint64(relativeTimeout)
+	return msg
+}
+
+// newMsgTransferWithForwarding returns a valid MsgTransfer forwarded through the provided hops.
+func newMsgTransferWithForwarding(hops ...types.Hop) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	msg.Forwarding = types.NewForwarding(hops...)
+	return msg
+}
+
+// newMsgTransferWithRefundAddress returns a valid MsgTransfer refunded to the provided address.
+func newMsgTransferWithRefundAddress(refundAddress string) *types.MsgTransfer {
+	msg := types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, "")
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
+// generateCoins returns a sorted set of n valid coins with distinct denominations.
+func generateCoins(n int) sdk.Coins {
+	coins := make([]sdk.Coin, n)
+	for i := range coins {
+		coins[i] = sdk.NewCoin(fmt.Sprintf("denom%d", i), sdkmath.NewInt(100))
+	}
+	return sdk.NewCoins(coins...)
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -105,6 +161,7 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), true},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), false},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), false},
+		{"failure: valid signer with invalid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{ProtocolFee: types.NewProtocolFee(10, "", false)}), false},
 	}
 
 	for i, tc := range testCases {
@@ -119,6 +176,103 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateDenomMetadataValidateBasic(t *testing.T) {
+	voucherDenom := types.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	invalidMetadata := newAtomMetadata(voucherDenom)
+	invalidMetadata.Symbol = ""
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateDenomMetadata
+		expError error
+	}{
+		{"success: valid signer and valid metadata", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, newAtomMetadata(voucherDenom)), nil},
+		{"failure: invalid signer", types.NewMsgUpdateDenomMetadata(invalidAddress, newAtomMetadata(voucherDenom)), ibcerrors.ErrInvalidAddress},
+		{"failure: empty signer", types.NewMsgUpdateDenomMetadata(emptyAddr, newAtomMetadata(voucherDenom)), ibcerrors.ErrInvalidAddress},
+		{"failure: base denomination is not an IBC denomination", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, newAtomMetadata("uatom")), types.ErrInvalidDenomMetadata},
+		{"failure: invalid IBC denomination hash", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, newAtomMetadata("ibc/xyz")), types.ErrInvalidDenomForTransfer},
+		{"failure: invalid metadata", types.NewMsgUpdateDenomMetadata(ibctesting.TestAccAddress, invalidMetadata), types.ErrInvalidDenomMetadata},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateChannelParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateChannelParams
+		expError error
+	}{
+		{"success: channel params", types.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, types.NewChannelParams(validPort, validChannel, "", false, false)), nil},
+		{"success: denom channel params", types.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, types.NewChannelParams(validPort, validChannel, "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", true, false)), nil},
+		{"failure: invalid signer", types.NewMsgUpdateChannelParams(invalidAddress, types.NewChannelParams(validPort, validChannel, "", false, false)), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid port", types.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, types.NewChannelParams(invalidPort, validChannel, "", false, false)), types.ErrInvalidChannelParams},
+		{"failure: invalid channel", types.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, types.NewChannelParams(validPort, invalidChannel, "", false, false)), types.ErrInvalidChannelParams},
+		{"failure: invalid denom", types.NewMsgUpdateChannelParams(ibctesting.TestAccAddress, types.NewChannelParams(validPort, validChannel, "0atom", false, false)), types.ErrInvalidChannelParams},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgReconcileEscrowValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msg      *types.MsgReconcileEscrow
+		expError error
+	}{
+		{"success: reconcile total escrow", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", "", "", ""), nil},
+		{"success: recover excess escrow", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", validPort, validChannel, receiver), nil},
+		{"failure: invalid signer", types.NewMsgReconcileEscrow(invalidAddress, "uatom", "", "", ""), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid denom", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "0atom", "", "", ""), types.ErrInvalidDenomForTransfer},
+		{"failure: channel set without recipient", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", validPort, validChannel, ""), ibcerrors.ErrInvalidRequest},
+		{"failure: invalid recipient", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", validPort, validChannel, invalidAddress), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid port", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", invalidPort, validChannel, receiver), host.ErrInvalidID},
+		{"failure: invalid channel", types.NewMsgReconcileEscrow(ibctesting.TestAccAddress, "uatom", validPort, invalidChannel, receiver), host.ErrInvalidID},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestMsgUpdateParamsGetSigners tests GetSigners for MsgUpdateParams
 func TestMsgUpdateParamsGetSigners(t *testing.T) {
 	testCases := []struct {
@@ -16,6 +16,8 @@ import (
 var (
 	_ ibcexported.PacketData         = (*FungibleTokenPacketData)(nil)
 	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketData)(nil)
+	_ ibcexported.PacketData         = (*FungibleTokenPacketDataV2)(nil)
+	_ ibcexported.PacketDataProvider = (*FungibleTokenPacketDataV2)(nil)
 )
 
 var (
@@ -93,12 +95,161 @@ func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string
 // and returns the value associated with the given key.
 // If the key is missing or the memo is not properly formatted, then nil is returned.
 func (ftpd FungibleTokenPacketData) GetCustomPacketData(key string) interface{} {
-	if len(ftpd.Memo) == 0 {
+	return getCustomPacketData(ftpd.Memo, key)
+}
+
+// NewFungibleTokenPacketDataV2 constructs a new FungibleTokenPacketDataV2 instance
+func NewFungibleTokenPacketDataV2(
+	tokens []Token,
+	sender, receiver string,
+	memo string,
+) FungibleTokenPacketDataV2 {
+	return FungibleTokenPacketDataV2{
+		Tokens:   tokens,
+		Sender:   sender,
+		Receiver: receiver,
+		Memo:     memo,
+	}
+}
+
+// ValidateBasic is used for validating the token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (ftpd FungibleTokenPacketDataV2) ValidateBasic() error {
+	if len(ftpd.Tokens) == 0 {
+		return errorsmod.Wrap(ErrInvalidAmount, "tokens cannot be empty")
+	}
+
+	for _, token := range ftpd.Tokens {
+		if err := token.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if strings.TrimSpace(ftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(ftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	if ftpd.Forwarding != nil {
+		if err := ftpd.Forwarding.Validate(); err != nil {
+			return err
+		}
+
+		// the memo is delivered to the final destination through the destination memo
+		if ftpd.Memo != "" {
+			return errorsmod.Wrap(ErrInvalidMemo, "memo must be empty if forwarding hops are set")
+		}
+	}
+
+	return nil
+}
+
+// HasForwarding returns true if the tokens must be forwarded to a next hop after being received.
+func (ftpd FungibleTokenPacketDataV2) HasForwarding() bool {
+	return ftpd.Forwarding != nil && len(ftpd.Forwarding.Hops) > 0
+}
+
+// GetBytes is a helper for serialising the packet to bytes.
+// The memo field of FungibleTokenPacketDataV2 is marked with the JSON omitempty tag
+// ensuring that the memo field is not included in the marshalled bytes if one is not specified.
+func (ftpd FungibleTokenPacketDataV2) GetBytes() []byte {
+	bz, err := json.Marshal(ftpd)
+	if err != nil {
+		panic(errors.New("cannot marshal FungibleTokenPacketDataV2 into bytes"))
+	}
+
+	return bz
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (ftpd FungibleTokenPacketDataV2) GetPacketSender(sourcePortID string) string {
+	return ftpd.Sender
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value associated with the given key.
+// If the key is missing or the memo is not properly formatted, then nil is returned.
+func (ftpd FungibleTokenPacketDataV2) GetCustomPacketData(key string) interface{} {
+	return getCustomPacketData(ftpd.Memo, key)
+}
+
+// ValidateBasic performs a basic validation of the token denomination, amount and
+// optional denomination metadata.
+func (t Token) ValidateBasic() error {
+	amount, ok := sdkmath.NewIntFromString(t.Amount)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", t.Amount)
+	}
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	if t.Metadata != nil {
+		if err := t.Metadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidDenomMetadata, "invalid metadata for denomination %s: %s", t.Denom, err)
+		}
+	}
+
+	return ValidatePrefixedDenom(t.Denom)
+}
+
+// PacketDataV1ToV2 converts a FungibleTokenPacketData into a FungibleTokenPacketDataV2
+// holding a single token.
+func PacketDataV1ToV2(packetData FungibleTokenPacketData) FungibleTokenPacketDataV2 {
+	return NewFungibleTokenPacketDataV2(
+		[]Token{
+			{
+				Denom:  packetData.Denom,
+				Amount: packetData.Amount,
+			},
+		},
+		packetData.Sender,
+		packetData.Receiver,
+		packetData.Memo,
+	)
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes according to the
+// provided ics20 channel version. Packet data sent over ics20-1 channels is converted into a
+// FungibleTokenPacketDataV2 so that the application logic only operates on a single type.
+func UnmarshalPacketData(bz []byte, ics20Version string) (FungibleTokenPacketDataV2, error) {
+	switch ics20Version {
+	case V1:
+		var packetData FungibleTokenPacketData
+		if err := json.Unmarshal(bz, &packetData); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return PacketDataV1ToV2(packetData), nil
+	case V2:
+		var packetData FungibleTokenPacketDataV2
+		if err := json.Unmarshal(bz, &packetData); err != nil {
+			return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+		}
+
+		return packetData, nil
+	default:
+		return FungibleTokenPacketDataV2{}, errorsmod.Wrapf(ErrInvalidVersion, "unsupported ics20 version: %s", ics20Version)
+	}
+}
+
+// getCustomPacketData interprets the provided memo as a JSON object and returns
+// the value associated with the given key.
+func getCustomPacketData(memo, key string) interface{} {
+	if len(memo) == 0 {
 		return nil
 	}
 
 	jsonObject := make(map[string]interface{})
-	err := json.Unmarshal([]byte(ftpd.Memo), &jsonObject)
+	err := json.Unmarshal([]byte(memo), &jsonObject)
 	if err != nil {
 		return nil
 	}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
 const (
@@ -48,6 +51,86 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 	}
 }
 
+// TestFungibleTokenPacketDataV2ValidateBasic tests ValidateBasic for FungibleTokenPacketDataV2
+func TestFungibleTokenPacketDataV2ValidateBasic(t *testing.T) {
+	validToken := types.Token{Denom: denom, Amount: amount}
+	validMetadata := newAtomMetadata("uatom")
+
+	testCases := []struct {
+		name       string
+		packetData types.FungibleTokenPacketDataV2
+		expPass    bool
+	}{
+		{"valid packet", types.NewFungibleTokenPacketDataV2([]types.Token{validToken}, sender, receiver, ""), true},
+		{"valid packet with multiple tokens", types.NewFungibleTokenPacketDataV2([]types.Token{validToken, {Denom: "atom", Amount: largeAmount}}, sender, receiver, "memo"), true},
+		{"invalid empty tokens", types.NewFungibleTokenPacketDataV2(nil, sender, receiver, ""), false},
+		{"invalid denom", types.NewFungibleTokenPacketDataV2([]types.Token{validToken, {Denom: "", Amount: amount}}, sender, receiver, ""), false},
+		{"invalid empty amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: ""}}, sender, receiver, ""), false},
+		{"invalid zero amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: "0"}}, sender, receiver, ""), false},
+		{"invalid negative amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: "-1"}}, sender, receiver, ""), false},
+		{"invalid large amount", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: invalidLargeAmount}}, sender, receiver, ""), false},
+		{"missing sender address", types.NewFungibleTokenPacketDataV2([]types.Token{validToken}, emptyAddr, receiver, ""), false},
+		{"missing recipient address", types.NewFungibleTokenPacketDataV2([]types.Token{validToken}, sender, emptyAddr, ""), false},
+		{"valid packet with forwarding", newPacketDataV2WithForwarding("", types.NewForwardingPacketData("memo", types.NewHop(types.PortID, "channel-1"))), true},
+		{"invalid memo with forwarding", newPacketDataV2WithForwarding("memo", types.NewForwardingPacketData("", types.NewHop(types.PortID, "channel-1"))), false},
+		{"invalid empty forwarding hops", newPacketDataV2WithForwarding("", types.NewForwardingPacketData("memo")), false},
+		{"invalid forwarding hop", newPacketDataV2WithForwarding("", types.NewForwardingPacketData("", types.NewHop(types.PortID, "(channel)"))), false},
+		{"valid forwarding relative timeout", newPacketDataV2WithForwarding("", &types.ForwardingPacketData{Hops: []types.Hop{types.NewHop(types.PortID, "channel-1")}, RelativeTimeout: uint64(types.MaximumForwardingPacketTimeout)}), true},
+		{"invalid forwarding relative timeout", newPacketDataV2WithForwarding("", &types.ForwardingPacketData{Hops: []types.Hop{types.NewHop(types.PortID, "channel-1")}, RelativeTimeout: uint64(types.MaximumForwardingPacketTimeout) + 1}), false},
+		{"valid packet with metadata", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: "uatom", Amount: amount, Metadata: &validMetadata}}, sender, receiver, ""), true},
+		{"invalid metadata", types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: "uatom", Amount: amount, Metadata: &banktypes.Metadata{Base: "uatom"}}}, sender, receiver, ""), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// newPacketDataV2WithForwarding returns a valid FungibleTokenPacketDataV2 with the provided memo and forwarding information.
+func newPacketDataV2WithForwarding(memo string, forwarding *types.ForwardingPacketData) types.FungibleTokenPacketDataV2 {
+	packetData := types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}}, sender, receiver, memo)
+	packetData.Forwarding = forwarding
+	return packetData
+}
+
+// TestUnmarshalPacketData tests decoding packet data bytes for each supported ics20 version
+func TestUnmarshalPacketData(t *testing.T) {
+	packetDataV1 := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, "memo")
+	packetDataV2 := types.NewFungibleTokenPacketDataV2([]types.Token{{Denom: denom, Amount: amount}, {Denom: "atom", Amount: amount}}, sender, receiver, "memo")
+
+	testCases := []struct {
+		name     string
+		bz       []byte
+		version  string
+		expData  types.FungibleTokenPacketDataV2
+		expError error
+	}{
+		{"success: ics20-1 packet data", packetDataV1.GetBytes(), types.V1, types.PacketDataV1ToV2(packetDataV1), nil},
+		{"success: ics20-2 packet data", packetDataV2.GetBytes(), types.V2, packetDataV2, nil},
+		{"failure: invalid bytes", []byte("invalid"), types.V2, types.FungibleTokenPacketDataV2{}, ibcerrors.ErrInvalidType},
+		{"failure: unsupported version", packetDataV1.GetBytes(), "ics20-100", types.FungibleTokenPacketDataV2{}, types.ErrInvalidVersion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetData, err := types.UnmarshalPacketData(tc.bz, tc.version)
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expData, packetData, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestGetPacketSender() {
 	packetData := types.FungibleTokenPacketData{
 		Denom:    denom,
@@ -15,6 +15,11 @@ func NewParams(enableSend, enableReceive bool) Params {
 	}
 }
 
+// Validate performs a basic validation of the ibc transfer module parameters.
+func (p Params) Validate() error {
+	return p.ProtocolFee.Validate()
+}
+
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxProtocolFeeBasisPoints is the exclusive upper bound of protocol fees, in basis points.
+const MaxProtocolFeeBasisPoints = 10_000
+
+// NewProtocolFee creates a new ProtocolFee instance.
+func NewProtocolFee(basisPoints uint32, feeCollector string, refundOnFailure bool, rates ...ProtocolFeeRate) ProtocolFee {
+	return ProtocolFee{
+		BasisPoints:     basisPoints,
+		FeeCollector:    feeCollector,
+		RefundOnFailure: refundOnFailure,
+		Rates:           rates,
+	}
+}
+
+// NewProtocolFeeRate creates a new ProtocolFeeRate instance. Empty port and channel
+// identifiers apply the rate to the denomination over all channels, and an empty
+// denomination applies the rate to all transfers over the channel.
+func NewProtocolFeeRate(portID, channelID, denom string, basisPoints uint32) ProtocolFeeRate {
+	return ProtocolFeeRate{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Denom:       denom,
+		BasisPoints: basisPoints,
+	}
+}
+
+// Validate performs a basic validation of the ProtocolFee fields and checks that no
+// rate is set more than once.
+func (pf ProtocolFee) Validate() error {
+	if err := validateBasisPoints(pf.BasisPoints); err != nil {
+		return err
+	}
+
+	chargesFees := pf.BasisPoints != 0
+	seen := make(map[string]bool)
+	for _, rate := range pf.Rates {
+		if err := rate.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", rate.PortId, rate.ChannelId, rate.Denom)
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate rate for port ID %s, channel ID %s and denomination %s", rate.PortId, rate.ChannelId, rate.Denom)
+		}
+		seen[key] = true
+
+		chargesFees = chargesFees || rate.BasisPoints != 0
+	}
+
+	if chargesFees && pf.FeeCollector == "" {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "fee collector must be set if fees are charged")
+	}
+
+	return nil
+}
+
+// EffectiveBasisPoints returns the fee, in basis points, charged on the transfers of the
+// provided denomination over the provided channel. The rate of the denomination
+// over the channel applies first, then the rate of the channel, then the rate of
+// the denomination and finally the default fee.
+func (pf ProtocolFee) EffectiveBasisPoints(portID, channelID, denom string) uint32 {
+	basisPoints, specificity := pf.BasisPoints, 0
+	for _, rate := range pf.Rates {
+		var rateSpecificity int
+		switch {
+		case rate.PortId == portID && rate.ChannelId == channelID && rate.Denom == denom:
+			rateSpecificity = 3
+		case rate.PortId == portID && rate.ChannelId == channelID && rate.Denom == "":
+			rateSpecificity = 2
+		case rate.PortId == "" && rate.ChannelId == "" && rate.Denom == denom:
+			rateSpecificity = 1
+		default:
+			continue
+		}
+
+		if rateSpecificity > specificity {
+			basisPoints, specificity = rate.BasisPoints, rateSpecificity
+		}
+	}
+
+	return basisPoints
+}
+
+// GetFees returns the fees charged on the transfer of the provided coins over the
+// provided channel. Fee amounts are rounded down, so that coins whose fee is lower
+// than one unit are not charged.
+func (pf ProtocolFee) GetFees(portID, channelID string, coins sdk.Coins) sdk.Coins {
+	fees := sdk.NewCoins()
+	for _, coin := range coins {
+		basisPoints := pf.EffectiveBasisPoints(portID, channelID, coin.Denom)
+		amount := coin.Amount.MulRaw(int64(basisPoints)).QuoRaw(MaxProtocolFeeBasisPoints)
+		if amount.IsPositive() {
+			fees = fees.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return fees
+}
+
+// Validate performs a basic validation of the ProtocolFeeRate fields.
+func (r ProtocolFeeRate) Validate() error {
+	if r.PortId == "" && r.ChannelId == "" && r.Denom == "" {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "rate must set a channel, a denomination or both")
+	}
+	if r.PortId != "" || r.ChannelId != "" {
+		if err := host.PortIdentifierValidator(r.PortId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid port ID %s: %s", r.PortId, err)
+		}
+		if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid channel ID %s: %s", r.ChannelId, err)
+		}
+	}
+	if r.Denom != "" {
+		if err := sdk.ValidateDenom(r.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid denomination %s: %s", r.Denom, err)
+		}
+	}
+
+	return validateBasisPoints(r.BasisPoints)
+}
+
+// validateBasisPoints checks that the provided fee is lower than the transferred amount.
+func validateBasisPoints(basisPoints uint32) error {
+	if basisPoints >= MaxProtocolFeeBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "basis points must be lower than %d, got %d", MaxProtocolFeeBasisPoints, basisPoints)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the PacketProtocolFee fields.
+func (pf PacketProtocolFee) Validate() error {
+	if err := host.PortIdentifierValidator(pf.PacketId.PortId); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(pf.PacketId.ChannelId); err != nil {
+		return err
+	}
+	if pf.PacketId.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "protocol fee packet sequence cannot be 0")
+	}
+	if pf.FeeCollector == "" {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "fee collector cannot be empty")
+	}
+	if pf.Fees.Empty() || !pf.Fees.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid fees %s", pf.Fees)
+	}
+
+	return nil
+}
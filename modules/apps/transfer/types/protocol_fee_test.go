@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestProtocolFeeValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		protocolFee types.ProtocolFee
+		expErr      error
+	}{
+		{"success: no fee", types.ProtocolFee{}, nil},
+		{"success: default fee", types.NewProtocolFee(10, authtypes.FeeCollectorName, false), nil},
+		{
+			"success: rates",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, true,
+				types.NewProtocolFeeRate(types.PortID, ibctesting.FirstChannelID, "", 0),
+				types.NewProtocolFeeRate(types.PortID, ibctesting.FirstChannelID, "uatom", 20),
+				types.NewProtocolFeeRate("", "", "uatom", 30),
+			),
+			nil,
+		},
+		{"failure: fee equal to the transferred amount", types.NewProtocolFee(types.MaxProtocolFeeBasisPoints, authtypes.FeeCollectorName, false), types.ErrInvalidProtocolFee},
+		{"failure: missing fee collector", types.NewProtocolFee(10, "", false), types.ErrInvalidProtocolFee},
+		{
+			"failure: missing fee collector with rate",
+			types.NewProtocolFee(0, "", false, types.NewProtocolFeeRate("", "", "uatom", 30)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: empty rate",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, false, types.NewProtocolFeeRate("", "", "", 30)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: rate with port ID only",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, false, types.NewProtocolFeeRate(types.PortID, "", "", 30)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: rate with invalid denomination",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, false, types.NewProtocolFeeRate("", "", "u", 30)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: rate too high",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, false, types.NewProtocolFeeRate("", "", "uatom", types.MaxProtocolFeeBasisPoints)),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"failure: duplicate rate",
+			types.NewProtocolFee(10, authtypes.FeeCollectorName, false,
+				types.NewProtocolFeeRate("", "", "uatom", 30),
+				types.NewProtocolFeeRate("", "", "uatom", 40),
+			),
+			types.ErrInvalidProtocolFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.protocolFee.Validate()
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestProtocolFeeGetFees(t *testing.T) {
+	protocolFee := types.NewProtocolFee(10, authtypes.FeeCollectorName, false,
+		types.NewProtocolFeeRate(types.PortID, "channel-1", "", 0),
+		types.NewProtocolFeeRate(types.PortID, "channel-2", "", 50),
+		types.NewProtocolFeeRate(types.PortID, "channel-2", "uatom", 100),
+		types.NewProtocolFeeRate("", "", "uatom", 20),
+	)
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1000)), sdk.NewCoin("uatom", sdkmath.NewInt(1000)))
+
+	testCases := []struct {
+		name      string
+		channelID string
+		coins     sdk.Coins
+		expFees   sdk.Coins
+	}{
+		{"default and denomination rates", ibctesting.FirstChannelID, coins, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1)), sdk.NewCoin("uatom", sdkmath.NewInt(2)))},
+		{"channel rate overrides denomination rate", "channel-1", coins, sdk.NewCoins()},
+		{"denomination over channel rate overrides channel rate", "channel-2", coins, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(5)), sdk.NewCoin("uatom", sdkmath.NewInt(10)))},
+		{"fees lower than one unit are not charged", ibctesting.FirstChannelID, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(999))), sdk.NewCoins()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFees, protocolFee.GetFees(types.PortID, tc.channelID, tc.coins))
+		})
+	}
+}
+
+func TestPacketProtocolFeeValidate(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(10)))
+
+	testCases := []struct {
+		name      string
+		packetFee types.PacketProtocolFee
+		expPass   bool
+	}{
+		{"success", types.PacketProtocolFee{PacketId: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1), FeeCollector: authtypes.FeeCollectorName, Fees: fees}, true},
+		{"failure: invalid channel ID", types.PacketProtocolFee{PacketId: channeltypes.NewPacketID(types.PortID, "", 1), FeeCollector: authtypes.FeeCollectorName, Fees: fees}, false},
+		{"failure: zero sequence", types.PacketProtocolFee{PacketId: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 0), FeeCollector: authtypes.FeeCollectorName, Fees: fees}, false},
+		{"failure: missing fee collector", types.PacketProtocolFee{PacketId: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1), Fees: fees}, false},
+		{"failure: empty fees", types.PacketProtocolFee{PacketId: channeltypes.NewPacketID(types.PortID, ibctesting.FirstChannelID, 1), FeeCollector: authtypes.FeeCollectorName}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.packetFee.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
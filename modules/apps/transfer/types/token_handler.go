@@ -0,0 +1,90 @@
+package types
+
+import (
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenHandler defines the interface used by the transfer keeper to move the tokens of a
+// denomination. The escrow address is owned by the transfer module, so that implementations
+// must allow it to hold the tokens of the denominations they handle. IBC vouchers are minted
+// and burned by the default handler, as no handler can be registered for their prefix.
+type TokenHandler interface {
+	// EscrowTokens moves the tokens from the sender to the escrow address.
+	EscrowTokens(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error
+	// UnescrowTokens moves the tokens from the escrow address to the receiver.
+	UnescrowTokens(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error
+	// MintTokens creates the tokens and credits them to the receiver.
+	MintTokens(ctx sdk.Context, receiver sdk.AccAddress, token sdk.Coin) error
+	// BurnTokens destroys the tokens held by the sender.
+	BurnTokens(ctx sdk.Context, sender sdk.AccAddress, token sdk.Coin) error
+	// GetBalance returns the balance of the denomination held by the address.
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// TokenHandlerRegistry selects the TokenHandler of a denomination by its prefix. Denominations
+// which do not match any registered prefix are handled by the default handler.
+type TokenHandlerRegistry struct {
+	defaultHandler TokenHandler
+	handlers       map[string]TokenHandler
+	// prefixes are sorted by decreasing length, so that the longest matching prefix is selected
+	prefixes []string
+}
+
+// NewTokenHandlerRegistry creates a new TokenHandlerRegistry with the given default handler.
+func NewTokenHandlerRegistry(defaultHandler TokenHandler) *TokenHandlerRegistry {
+	if defaultHandler == nil {
+		panic(errorsmod.Wrap(ErrInvalidTokenHandler, "default token handler cannot be nil"))
+	}
+
+	return &TokenHandlerRegistry{
+		defaultHandler: defaultHandler,
+		handlers:       make(map[string]TokenHandler),
+	}
+}
+
+// Register registers the handler for all denominations starting with the given prefix.
+// Prefixes overlapping with the prefix of IBC vouchers cannot be registered, as vouchers
+// are always handled by the default handler.
+func (r *TokenHandlerRegistry) Register(prefix string, handler TokenHandler) error {
+	if strings.TrimSpace(prefix) == "" {
+		return errorsmod.Wrap(ErrInvalidTokenHandler, "denomination prefix cannot be blank")
+	}
+
+	if handler == nil {
+		return errorsmod.Wrapf(ErrInvalidTokenHandler, "token handler for prefix %s cannot be nil", prefix)
+	}
+
+	voucherPrefix := DenomPrefix + "/"
+	if strings.HasPrefix(prefix, voucherPrefix) || strings.HasPrefix(voucherPrefix, prefix) {
+		return errorsmod.Wrapf(ErrInvalidTokenHandler, "denomination prefix %s overlaps with the IBC voucher prefix %s", prefix, voucherPrefix)
+	}
+
+	if _, found := r.handlers[prefix]; found {
+		return errorsmod.Wrapf(ErrInvalidTokenHandler, "token handler already registered for prefix %s", prefix)
+	}
+
+	r.handlers[prefix] = handler
+	r.prefixes = append(r.prefixes, prefix)
+	sort.SliceStable(r.prefixes, func(i, j int) bool {
+		return len(r.prefixes[i]) > len(r.prefixes[j])
+	})
+
+	return nil
+}
+
+// GetHandler returns the handler registered for the longest prefix of the denomination,
+// or the default handler if no prefix matches.
+func (r *TokenHandlerRegistry) GetHandler(denom string) TokenHandler {
+	for _, prefix := range r.prefixes {
+		if strings.HasPrefix(denom, prefix) {
+			return r.handlers[prefix]
+		}
+	}
+
+	return r.defaultHandler
+}
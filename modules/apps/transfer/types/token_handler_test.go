@@ -0,0 +1,105 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// mockTokenHandler is a no-op TokenHandler identified by its name.
+type mockTokenHandler struct {
+	name string
+}
+
+func (mockTokenHandler) EscrowTokens(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (mockTokenHandler) UnescrowTokens(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (mockTokenHandler) MintTokens(sdk.Context, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (mockTokenHandler) BurnTokens(sdk.Context, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (mockTokenHandler) GetBalance(_ sdk.Context, _ sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.Coin{Denom: denom}
+}
+
+func TestTokenHandlerRegistryRegister(t *testing.T) {
+	testCases := []struct {
+		name    string
+		prefix  string
+		handler types.TokenHandler
+		expErr  error
+	}{
+		{"success", "factory/", mockTokenHandler{"factory"}, nil},
+		{"success: prefix of registered prefix", "gam", mockTokenHandler{"gam"}, nil},
+		{"failure: blank prefix", "  ", mockTokenHandler{"blank"}, types.ErrInvalidTokenHandler},
+		{"failure: nil handler", "cw20:", nil, types.ErrInvalidTokenHandler},
+		{"failure: voucher prefix", "ibc/", mockTokenHandler{"ibc"}, types.ErrInvalidTokenHandler},
+		{"failure: prefix of voucher prefix", "ib", mockTokenHandler{"ib"}, types.ErrInvalidTokenHandler},
+		{"failure: prefix extending voucher prefix", "ibc/ABC", mockTokenHandler{"ibc"}, types.ErrInvalidTokenHandler},
+		{"failure: prefix already registered", "gamm/", mockTokenHandler{"gamm"}, types.ErrInvalidTokenHandler},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			registry := types.NewTokenHandlerRegistry(mockTokenHandler{"bank"})
+			require.NoError(t, registry.Register("gamm/", mockTokenHandler{"gamm"}))
+
+			err := registry.Register(tc.prefix, tc.handler)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.handler, registry.GetHandler(tc.prefix))
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestTokenHandlerRegistryGetHandler(t *testing.T) {
+	registry := types.NewTokenHandlerRegistry(mockTokenHandler{"bank"})
+	require.NoError(t, registry.Register("factory/", mockTokenHandler{"factory"}))
+	require.NoError(t, registry.Register("factory/special/", mockTokenHandler{"special"}))
+	require.NoError(t, registry.Register("cw20:", mockTokenHandler{"cw20"}))
+
+	testCases := []struct {
+		name       string
+		denom      string
+		expHandler types.TokenHandler
+	}{
+		{"native denom uses default handler", "uatom", mockTokenHandler{"bank"}},
+		{"voucher uses default handler", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", mockTokenHandler{"bank"}},
+		{"registered prefix", "factory/cosmos1abc/token", mockTokenHandler{"factory"}},
+		{"longest registered prefix", "factory/special/token", mockTokenHandler{"special"}},
+		{"other registered prefix", "cw20:cosmos1contract", mockTokenHandler{"cw20"}},
+		{"denom equal to prefix without separator", "factory", mockTokenHandler{"bank"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expHandler, registry.GetHandler(tc.denom))
+		})
+	}
+}
+
+func TestNewTokenHandlerRegistryNilDefault(t *testing.T) {
+	require.Panics(t, func() {
+		types.NewTokenHandlerRegistry(nil)
+	})
+}
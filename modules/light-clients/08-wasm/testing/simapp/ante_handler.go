@@ -6,16 +6,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
+	ibcfeepost "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/post"
 	ibcante "github.com/cosmos/ibc-go/v8/modules/core/ante"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/keeper"
 )
 
-// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC keeper.
+// HandlerOptions extend the SDK's AnteHandler options by requiring the IBC and IBC fee keepers.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper *keeper.Keeper
+	IBCKeeper    *keeper.Keeper
+	IBCFeeKeeper ibcfeekeeper.Keeper
 }
 
 // NewAnteHandler creates a new ante handler
@@ -44,6 +47,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
+		ibcfeepost.NewIncentivizedMsgsDecorator(options.IBCFeeKeeper, options.IBCKeeper.ClientKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
@@ -503,13 +503,20 @@ func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, pac
 
 // AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
 func (endpoint *Endpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	_, err := endpoint.AcknowledgePacketWithResult(packet, ack)
+	return err
+}
+
+// AcknowledgePacketWithResult sends a MsgAcknowledgement to the channel associated with the endpoint
+// and the result of the transaction is returned.
+func (endpoint *Endpoint) AcknowledgePacketWithResult(packet channeltypes.Packet, ack []byte) (*abci.ExecTxResult, error) {
 	// get proof of acknowledgement on counterparty
 	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
 
 	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
 
-	return endpoint.Chain.sendMsgs(ackMsg)
+	return endpoint.Chain.SendMsgs(ackMsg)
 }
 
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.